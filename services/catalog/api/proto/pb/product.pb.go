@@ -27,6 +27,11 @@ type CreateProductRequest struct {
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	CategoryId    int32                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Sizes         []*ProductOption       `protobuf:"bytes,5,rep,name=sizes,proto3" json:"sizes,omitempty"`
+	Crusts        []*ProductOption       `protobuf:"bytes,6,rep,name=crusts,proto3" json:"crusts,omitempty"`
+	HalvesAllowed bool                   `protobuf:"varint,7,opt,name=halves_allowed,json=halvesAllowed,proto3" json:"halves_allowed,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Ingredients   []*ProductIngredient   `protobuf:"bytes,9,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +94,231 @@ func (x *CreateProductRequest) GetPrice() float64 {
 	return 0
 }
 
+func (x *CreateProductRequest) GetSizes() []*ProductOption {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetCrusts() []*ProductOption {
+	if x != nil {
+		return x.Crusts
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetHalvesAllowed() bool {
+	if x != nil {
+		return x.HalvesAllowed
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetIngredients() []*ProductIngredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+// ProductIngredient - ингредиент в составе товара, removable - клиент может его убрать.
+type ProductIngredient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IngredientId  string                 `protobuf:"bytes,1,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Removable     bool                   `protobuf:"varint,3,opt,name=removable,proto3" json:"removable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductIngredient) Reset() {
+	*x = ProductIngredient{}
+	mi := &file_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductIngredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductIngredient) ProtoMessage() {}
+
+func (x *ProductIngredient) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductIngredient.ProtoReflect.Descriptor instead.
+func (*ProductIngredient) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProductIngredient) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *ProductIngredient) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductIngredient) GetRemovable() bool {
+	if x != nil {
+		return x.Removable
+	}
+	return false
+}
+
+// ProductOption - размер или тип теста, multiplier умножает базовую цену.
+type ProductOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductOption) Reset() {
+	*x = ProductOption{}
+	mi := &file_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductOption) ProtoMessage() {}
+
+func (x *ProductOption) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductOption.ProtoReflect.Descriptor instead.
+func (*ProductOption) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductOption) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProductOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductOption) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type SetProductOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sizes         []*ProductOption       `protobuf:"bytes,2,rep,name=sizes,proto3" json:"sizes,omitempty"`
+	Crusts        []*ProductOption       `protobuf:"bytes,3,rep,name=crusts,proto3" json:"crusts,omitempty"`
+	HalvesAllowed bool                   `protobuf:"varint,4,opt,name=halves_allowed,json=halvesAllowed,proto3" json:"halves_allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductOptionsRequest) Reset() {
+	*x = SetProductOptionsRequest{}
+	mi := &file_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductOptionsRequest) ProtoMessage() {}
+
+func (x *SetProductOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetProductOptionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductOptionsRequest) GetSizes() []*ProductOption {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *SetProductOptionsRequest) GetCrusts() []*ProductOption {
+	if x != nil {
+		return x.Crusts
+	}
+	return nil
+}
+
+func (x *SetProductOptionsRequest) GetHalvesAllowed() bool {
+	if x != nil {
+		return x.HalvesAllowed
+	}
+	return false
+}
+
 type GetProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -98,7 +328,7 @@ type GetProductRequest struct {
 
 func (x *GetProductRequest) Reset() {
 	*x = GetProductRequest{}
-	mi := &file_product_proto_msgTypes[1]
+	mi := &file_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -110,7 +340,7 @@ func (x *GetProductRequest) String() string {
 func (*GetProductRequest) ProtoMessage() {}
 
 func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[1]
+	mi := &file_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +353,7 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{1}
+	return file_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProductRequest) GetId() string {
@@ -134,14 +364,23 @@ func (x *GetProductRequest) GetId() string {
 }
 
 type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пусто - все категории.
+	CategoryIds   []int32 `protobuf:"varint,1,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	AvailableOnly bool    `protobuf:"varint,2,opt,name=available_only,json=availableOnly,proto3" json:"available_only,omitempty"`
+	// Подстрока названия или описания.
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// name, price_asc, price_desc, newest; пусто - name.
+	Sort          string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	PageSize      int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -153,7 +392,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[2]
+	mi := &file_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +405,49 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{2}
+	return file_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListProductsRequest) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetAvailableOnly() bool {
+	if x != nil {
+		return x.AvailableOnly
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
 type ProductResponse struct {
@@ -175,13 +456,25 @@ type ProductResponse struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	IsAvailable   bool                   `protobuf:"varint,5,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Sizes         []*ProductOption       `protobuf:"bytes,7,rep,name=sizes,proto3" json:"sizes,omitempty"`
+	Crusts        []*ProductOption       `protobuf:"bytes,8,rep,name=crusts,proto3" json:"crusts,omitempty"`
+	HalvesAllowed bool                   `protobuf:"varint,9,opt,name=halves_allowed,json=halvesAllowed,proto3" json:"halves_allowed,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,10,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Ingredients   []*ProductIngredient   `protobuf:"bytes,11,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	// Название категории: classic, premium, vegetarian, spicy, drinks, desserts.
+	Category string                `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	Toppings []*ProductToppingRule `protobuf:"bytes,13,rep,name=toppings,proto3" json:"toppings,omitempty"`
+	// Всего порций топпингов в позиции, 0 - без ограничения.
+	MaxToppings   int32 `protobuf:"varint,14,opt,name=max_toppings,json=maxToppings,proto3" json:"max_toppings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProductResponse) Reset() {
 	*x = ProductResponse{}
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -193,7 +486,7 @@ func (x *ProductResponse) String() string {
 func (*ProductResponse) ProtoMessage() {}
 
 func (x *ProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[3]
+	mi := &file_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,7 +499,7 @@ func (x *ProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductResponse.ProtoReflect.Descriptor instead.
 func (*ProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{3}
+	return file_product_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ProductResponse) GetId() string {
@@ -237,16 +530,88 @@ func (x *ProductResponse) GetPrice() float64 {
 	return 0
 }
 
+func (x *ProductResponse) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *ProductResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ProductResponse) GetSizes() []*ProductOption {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *ProductResponse) GetCrusts() []*ProductOption {
+	if x != nil {
+		return x.Crusts
+	}
+	return nil
+}
+
+func (x *ProductResponse) GetHalvesAllowed() bool {
+	if x != nil {
+		return x.HalvesAllowed
+	}
+	return false
+}
+
+func (x *ProductResponse) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ProductResponse) GetIngredients() []*ProductIngredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *ProductResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductResponse) GetToppings() []*ProductToppingRule {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+func (x *ProductResponse) GetMaxToppings() int32 {
+	if x != nil {
+		return x.MaxToppings
+	}
+	return 0
+}
+
 type ListProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*ProductResponse     `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Products []*ProductResponse     `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	// Пусто - страница последняя.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsResponse) Reset() {
 	*x = ListProductsResponse{}
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -258,7 +623,7 @@ func (x *ListProductsResponse) String() string {
 func (*ListProductsResponse) ProtoMessage() {}
 
 func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[4]
+	mi := &file_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +636,7 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{4}
+	return file_product_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListProductsResponse) GetProducts() []*ProductResponse {
@@ -281,33 +646,1785 @@ func (x *ListProductsResponse) GetProducts() []*ProductResponse {
 	return nil
 }
 
-var File_product_proto protoreflect.FileDescriptor
-
-const file_product_proto_rawDesc = "" +
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateIngredientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Единица измерения количества в составе: g, ml, pcs.
+	Unit          string  `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	Cost          float64 `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIngredientRequest) Reset() {
+	*x = CreateIngredientRequest{}
+	mi := &file_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIngredientRequest) ProtoMessage() {}
+
+func (x *CreateIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIngredientRequest.ProtoReflect.Descriptor instead.
+func (*CreateIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateIngredientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIngredientRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CreateIngredientRequest) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type GetIngredientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngredientRequest) Reset() {
+	*x = GetIngredientRequest{}
+	mi := &file_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngredientRequest) ProtoMessage() {}
+
+func (x *GetIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngredientRequest.ProtoReflect.Descriptor instead.
+func (*GetIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetIngredientRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListIngredientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsRequest) Reset() {
+	*x = ListIngredientsRequest{}
+	mi := &file_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsRequest) ProtoMessage() {}
+
+func (x *ListIngredientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsRequest.ProtoReflect.Descriptor instead.
+func (*ListIngredientsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{10}
+}
+
+type UpdateIngredientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIngredientRequest) Reset() {
+	*x = UpdateIngredientRequest{}
+	mi := &file_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIngredientRequest) ProtoMessage() {}
+
+func (x *UpdateIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIngredientRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateIngredientRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIngredientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateIngredientRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *UpdateIngredientRequest) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type DeleteIngredientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIngredientRequest) Reset() {
+	*x = DeleteIngredientRequest{}
+	mi := &file_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIngredientRequest) ProtoMessage() {}
+
+func (x *DeleteIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIngredientRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteIngredientRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteIngredientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIngredientResponse) Reset() {
+	*x = DeleteIngredientResponse{}
+	mi := &file_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIngredientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIngredientResponse) ProtoMessage() {}
+
+func (x *DeleteIngredientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIngredientResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIngredientResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{13}
+}
+
+type SetIngredientStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InStock       bool                   `protobuf:"varint,2,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIngredientStockRequest) Reset() {
+	*x = SetIngredientStockRequest{}
+	mi := &file_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIngredientStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIngredientStockRequest) ProtoMessage() {}
+
+func (x *SetIngredientStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIngredientStockRequest.ProtoReflect.Descriptor instead.
+func (*SetIngredientStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetIngredientStockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetIngredientStockRequest) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+type IngredientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	InStock       bool                   `protobuf:"varint,5,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientResponse) Reset() {
+	*x = IngredientResponse{}
+	mi := &file_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientResponse) ProtoMessage() {}
+
+func (x *IngredientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientResponse.ProtoReflect.Descriptor instead.
+func (*IngredientResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *IngredientResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IngredientResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngredientResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *IngredientResponse) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *IngredientResponse) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+type ListIngredientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredients   []*IngredientResponse  `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsResponse) Reset() {
+	*x = ListIngredientsResponse{}
+	mi := &file_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsResponse) ProtoMessage() {}
+
+func (x *ListIngredientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsResponse.ProtoReflect.Descriptor instead.
+func (*ListIngredientsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListIngredientsResponse) GetIngredients() []*IngredientResponse {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type SetIngredientStockResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient *IngredientResponse    `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	// Товары с этим ингредиентом после пересчета доступности.
+	Products      []*ProductResponse `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIngredientStockResponse) Reset() {
+	*x = SetIngredientStockResponse{}
+	mi := &file_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIngredientStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIngredientStockResponse) ProtoMessage() {}
+
+func (x *SetIngredientStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIngredientStockResponse.ProtoReflect.Descriptor instead.
+func (*SetIngredientStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetIngredientStockResponse) GetIngredient() *IngredientResponse {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *SetIngredientStockResponse) GetProducts() []*ProductResponse {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type GetProductEconomicsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пусто - весь каталог.
+	ProductIds []string `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	// Целевая маржа в процентах от цены, 0 - из настроек сервиса.
+	TargetMargin float64 `protobuf:"fixed64,2,opt,name=target_margin,json=targetMargin,proto3" json:"target_margin,omitempty"`
+	// Только товары, у которых хотя бы одна цена ниже целевой маржи.
+	BelowTargetOnly bool `protobuf:"varint,3,opt,name=below_target_only,json=belowTargetOnly,proto3" json:"below_target_only,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetProductEconomicsRequest) Reset() {
+	*x = GetProductEconomicsRequest{}
+	mi := &file_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductEconomicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductEconomicsRequest) ProtoMessage() {}
+
+func (x *GetProductEconomicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductEconomicsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductEconomicsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetProductEconomicsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *GetProductEconomicsRequest) GetTargetMargin() float64 {
+	if x != nil {
+		return x.TargetMargin
+	}
+	return 0
+}
+
+func (x *GetProductEconomicsRequest) GetBelowTargetOnly() bool {
+	if x != nil {
+		return x.BelowTargetOnly
+	}
+	return false
+}
+
+// Economics - себестоимость и маржа по одной цене.
+type Economics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cost          float64                `protobuf:"fixed64,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Profit        float64                `protobuf:"fixed64,3,opt,name=profit,proto3" json:"profit,omitempty"`
+	MarginPercent float64                `protobuf:"fixed64,4,opt,name=margin_percent,json=marginPercent,proto3" json:"margin_percent,omitempty"`
+	BelowTarget   bool                   `protobuf:"varint,5,opt,name=below_target,json=belowTarget,proto3" json:"below_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Economics) Reset() {
+	*x = Economics{}
+	mi := &file_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Economics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Economics) ProtoMessage() {}
+
+func (x *Economics) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Economics.ProtoReflect.Descriptor instead.
+func (*Economics) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Economics) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *Economics) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Economics) GetProfit() float64 {
+	if x != nil {
+		return x.Profit
+	}
+	return 0
+}
+
+func (x *Economics) GetMarginPercent() float64 {
+	if x != nil {
+		return x.MarginPercent
+	}
+	return 0
+}
+
+func (x *Economics) GetBelowTarget() bool {
+	if x != nil {
+		return x.BelowTarget
+	}
+	return false
+}
+
+type SizeEconomics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Size          *ProductOption         `protobuf:"bytes,1,opt,name=size,proto3" json:"size,omitempty"`
+	Economics     *Economics             `protobuf:"bytes,2,opt,name=economics,proto3" json:"economics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SizeEconomics) Reset() {
+	*x = SizeEconomics{}
+	mi := &file_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SizeEconomics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeEconomics) ProtoMessage() {}
+
+func (x *SizeEconomics) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeEconomics.ProtoReflect.Descriptor instead.
+func (*SizeEconomics) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SizeEconomics) GetSize() *ProductOption {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *SizeEconomics) GetEconomics() *Economics {
+	if x != nil {
+		return x.Economics
+	}
+	return nil
+}
+
+type ProductEconomics struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// По базовой цене.
+	Base          *Economics       `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Sizes         []*SizeEconomics `protobuf:"bytes,4,rep,name=sizes,proto3" json:"sizes,omitempty"`
+	BelowTarget   bool             `protobuf:"varint,5,opt,name=below_target,json=belowTarget,proto3" json:"below_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductEconomics) Reset() {
+	*x = ProductEconomics{}
+	mi := &file_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductEconomics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductEconomics) ProtoMessage() {}
+
+func (x *ProductEconomics) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductEconomics.ProtoReflect.Descriptor instead.
+func (*ProductEconomics) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProductEconomics) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductEconomics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductEconomics) GetBase() *Economics {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ProductEconomics) GetSizes() []*SizeEconomics {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+func (x *ProductEconomics) GetBelowTarget() bool {
+	if x != nil {
+		return x.BelowTarget
+	}
+	return false
+}
+
+type GetProductEconomicsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductEconomics    `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	TargetMargin  float64                `protobuf:"fixed64,2,opt,name=target_margin,json=targetMargin,proto3" json:"target_margin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductEconomicsResponse) Reset() {
+	*x = GetProductEconomicsResponse{}
+	mi := &file_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductEconomicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductEconomicsResponse) ProtoMessage() {}
+
+func (x *GetProductEconomicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductEconomicsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductEconomicsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetProductEconomicsResponse) GetProducts() []*ProductEconomics {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetProductEconomicsResponse) GetTargetMargin() float64 {
+	if x != nil {
+		return x.TargetMargin
+	}
+	return 0
+}
+
+// ToppingSizePrice - цена порции топпинга для размера товара.
+type ToppingSizePrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SizeCode      string                 `protobuf:"bytes,1,opt,name=size_code,json=sizeCode,proto3" json:"size_code,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToppingSizePrice) Reset() {
+	*x = ToppingSizePrice{}
+	mi := &file_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToppingSizePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToppingSizePrice) ProtoMessage() {}
+
+func (x *ToppingSizePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToppingSizePrice.ProtoReflect.Descriptor instead.
+func (*ToppingSizePrice) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ToppingSizePrice) GetSizeCode() string {
+	if x != nil {
+		return x.SizeCode
+	}
+	return ""
+}
+
+func (x *ToppingSizePrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type CreateToppingRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IngredientId string                 `protobuf:"bytes,2,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"`
+	// Расход ингредиента на порцию, в единицах ингредиента.
+	Quantity float64 `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Цена порции для размеров без своей цены в size_prices.
+	Price         float64             `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	SizePrices    []*ToppingSizePrice `protobuf:"bytes,5,rep,name=size_prices,json=sizePrices,proto3" json:"size_prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateToppingRequest) Reset() {
+	*x = CreateToppingRequest{}
+	mi := &file_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateToppingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateToppingRequest) ProtoMessage() {}
+
+func (x *CreateToppingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateToppingRequest.ProtoReflect.Descriptor instead.
+func (*CreateToppingRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateToppingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateToppingRequest) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *CreateToppingRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateToppingRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateToppingRequest) GetSizePrices() []*ToppingSizePrice {
+	if x != nil {
+		return x.SizePrices
+	}
+	return nil
+}
+
+type UpdateToppingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IngredientId  string                 `protobuf:"bytes,3,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	SizePrices    []*ToppingSizePrice    `protobuf:"bytes,6,rep,name=size_prices,json=sizePrices,proto3" json:"size_prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateToppingRequest) Reset() {
+	*x = UpdateToppingRequest{}
+	mi := &file_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateToppingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToppingRequest) ProtoMessage() {}
+
+func (x *UpdateToppingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToppingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateToppingRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateToppingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateToppingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateToppingRequest) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *UpdateToppingRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateToppingRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateToppingRequest) GetSizePrices() []*ToppingSizePrice {
+	if x != nil {
+		return x.SizePrices
+	}
+	return nil
+}
+
+type ToppingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IngredientId  string                 `protobuf:"bytes,3,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	SizePrices    []*ToppingSizePrice    `protobuf:"bytes,6,rep,name=size_prices,json=sizePrices,proto3" json:"size_prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToppingResponse) Reset() {
+	*x = ToppingResponse{}
+	mi := &file_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToppingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToppingResponse) ProtoMessage() {}
+
+func (x *ToppingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToppingResponse.ProtoReflect.Descriptor instead.
+func (*ToppingResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ToppingResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToppingResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToppingResponse) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *ToppingResponse) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ToppingResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ToppingResponse) GetSizePrices() []*ToppingSizePrice {
+	if x != nil {
+		return x.SizePrices
+	}
+	return nil
+}
+
+type ListToppingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToppingsRequest) Reset() {
+	*x = ListToppingsRequest{}
+	mi := &file_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToppingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToppingsRequest) ProtoMessage() {}
+
+func (x *ListToppingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToppingsRequest.ProtoReflect.Descriptor instead.
+func (*ListToppingsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{27}
+}
+
+type ListToppingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Toppings      []*ToppingResponse     `protobuf:"bytes,1,rep,name=toppings,proto3" json:"toppings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListToppingsResponse) Reset() {
+	*x = ListToppingsResponse{}
+	mi := &file_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListToppingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListToppingsResponse) ProtoMessage() {}
+
+func (x *ListToppingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListToppingsResponse.ProtoReflect.Descriptor instead.
+func (*ListToppingsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListToppingsResponse) GetToppings() []*ToppingResponse {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+type DeleteToppingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteToppingRequest) Reset() {
+	*x = DeleteToppingRequest{}
+	mi := &file_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteToppingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteToppingRequest) ProtoMessage() {}
+
+func (x *DeleteToppingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteToppingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteToppingRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteToppingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteToppingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteToppingResponse) Reset() {
+	*x = DeleteToppingResponse{}
+	mi := &file_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteToppingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteToppingResponse) ProtoMessage() {}
+
+func (x *DeleteToppingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteToppingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteToppingResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{30}
+}
+
+// ProductToppingRule - топпинг, разрешенный для товара.
+type ProductToppingRule struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ToppingId string                 `protobuf:"bytes,1,opt,name=topping_id,json=toppingId,proto3" json:"topping_id,omitempty"`
+	// Порций в позиции, 0 - без ограничения.
+	MaxCount      int32 `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductToppingRule) Reset() {
+	*x = ProductToppingRule{}
+	mi := &file_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductToppingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductToppingRule) ProtoMessage() {}
+
+func (x *ProductToppingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductToppingRule.ProtoReflect.Descriptor instead.
+func (*ProductToppingRule) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProductToppingRule) GetToppingId() string {
+	if x != nil {
+		return x.ToppingId
+	}
+	return ""
+}
+
+func (x *ProductToppingRule) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+type SetProductToppingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Toppings      []*ProductToppingRule  `protobuf:"bytes,2,rep,name=toppings,proto3" json:"toppings,omitempty"`
+	MaxToppings   int32                  `protobuf:"varint,3,opt,name=max_toppings,json=maxToppings,proto3" json:"max_toppings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductToppingsRequest) Reset() {
+	*x = SetProductToppingsRequest{}
+	mi := &file_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductToppingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductToppingsRequest) ProtoMessage() {}
+
+func (x *SetProductToppingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductToppingsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductToppingsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetProductToppingsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductToppingsRequest) GetToppings() []*ProductToppingRule {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+func (x *SetProductToppingsRequest) GetMaxToppings() int32 {
+	if x != nil {
+		return x.MaxToppings
+	}
+	return 0
+}
+
+type ListProductToppingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductToppingsRequest) Reset() {
+	*x = ListProductToppingsRequest{}
+	mi := &file_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductToppingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductToppingsRequest) ProtoMessage() {}
+
+func (x *ListProductToppingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductToppingsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductToppingsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListProductToppingsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ProductTopping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToppingId     string                 `protobuf:"bytes,1,opt,name=topping_id,json=toppingId,proto3" json:"topping_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	SizePrices    []*ToppingSizePrice    `protobuf:"bytes,4,rep,name=size_prices,json=sizePrices,proto3" json:"size_prices,omitempty"`
+	MaxCount      int32                  `protobuf:"varint,5,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductTopping) Reset() {
+	*x = ProductTopping{}
+	mi := &file_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductTopping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductTopping) ProtoMessage() {}
+
+func (x *ProductTopping) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductTopping.ProtoReflect.Descriptor instead.
+func (*ProductTopping) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProductTopping) GetToppingId() string {
+	if x != nil {
+		return x.ToppingId
+	}
+	return ""
+}
+
+func (x *ProductTopping) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductTopping) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductTopping) GetSizePrices() []*ToppingSizePrice {
+	if x != nil {
+		return x.SizePrices
+	}
+	return nil
+}
+
+func (x *ProductTopping) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+type ListProductToppingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Toppings      []*ProductTopping      `protobuf:"bytes,1,rep,name=toppings,proto3" json:"toppings,omitempty"`
+	MaxToppings   int32                  `protobuf:"varint,2,opt,name=max_toppings,json=maxToppings,proto3" json:"max_toppings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductToppingsResponse) Reset() {
+	*x = ListProductToppingsResponse{}
+	mi := &file_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductToppingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductToppingsResponse) ProtoMessage() {}
+
+func (x *ListProductToppingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductToppingsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductToppingsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListProductToppingsResponse) GetToppings() []*ProductTopping {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+func (x *ListProductToppingsResponse) GetMaxToppings() int32 {
+	if x != nil {
+		return x.MaxToppings
+	}
+	return 0
+}
+
+var File_product_proto protoreflect.FileDescriptor
+
+const file_product_proto_rawDesc = "" +
 	"\n" +
 	"\rproduct.proto\x12\n" +
-	"catalog.v1\"\x83\x01\n" +
+	"catalog.v1\"\xec\x02\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\x05R\n" +
 	"categoryId\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\"#\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12/\n" +
+	"\x05sizes\x18\x05 \x03(\v2\x19.catalog.v1.ProductOptionR\x05sizes\x121\n" +
+	"\x06crusts\x18\x06 \x03(\v2\x19.catalog.v1.ProductOptionR\x06crusts\x12%\n" +
+	"\x0ehalves_allowed\x18\a \x01(\bR\rhalvesAllowed\x12\x1b\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\x12?\n" +
+	"\vingredients\x18\t \x03(\v2\x1d.catalog.v1.ProductIngredientR\vingredients\"r\n" +
+	"\x11ProductIngredient\x12#\n" +
+	"\ringredient_id\x18\x01 \x01(\tR\fingredientId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x1c\n" +
+	"\tremovable\x18\x03 \x01(\bR\tremovable\"W\n" +
+	"\rProductOption\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x01R\n" +
+	"multiplier\"\xc4\x01\n" +
+	"\x18SetProductOptionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12/\n" +
+	"\x05sizes\x18\x02 \x03(\v2\x19.catalog.v1.ProductOptionR\x05sizes\x121\n" +
+	"\x06crusts\x18\x03 \x03(\v2\x19.catalog.v1.ProductOptionR\x06crusts\x12%\n" +
+	"\x0ehalves_allowed\x18\x04 \x01(\bR\rhalvesAllowed\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13ListProductsRequest\"m\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x01\n" +
+	"\x13ListProductsRequest\x12!\n" +
+	"\fcategory_ids\x18\x01 \x03(\x05R\vcategoryIds\x12%\n" +
+	"\x0eavailable_only\x18\x02 \x01(\bR\ravailableOnly\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x95\x04\n" +
 	"\x0fProductResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\"O\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12!\n" +
+	"\fis_available\x18\x05 \x01(\bR\visAvailable\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\x05R\n" +
+	"categoryId\x12/\n" +
+	"\x05sizes\x18\a \x03(\v2\x19.catalog.v1.ProductOptionR\x05sizes\x121\n" +
+	"\x06crusts\x18\b \x03(\v2\x19.catalog.v1.ProductOptionR\x06crusts\x12%\n" +
+	"\x0ehalves_allowed\x18\t \x01(\bR\rhalvesAllowed\x12\x1b\n" +
+	"\timage_url\x18\n" +
+	" \x01(\tR\bimageUrl\x12?\n" +
+	"\vingredients\x18\v \x03(\v2\x1d.catalog.v1.ProductIngredientR\vingredients\x12\x1a\n" +
+	"\bcategory\x18\f \x01(\tR\bcategory\x12:\n" +
+	"\btoppings\x18\r \x03(\v2\x1e.catalog.v1.ProductToppingRuleR\btoppings\x12!\n" +
+	"\fmax_toppings\x18\x0e \x01(\x05R\vmaxToppings\"w\n" +
 	"\x14ListProductsResponse\x127\n" +
-	"\bproducts\x18\x01 \x03(\v2\x1b.catalog.v1.ProductResponseR\bproducts2\xfd\x01\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1b.catalog.v1.ProductResponseR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"U\n" +
+	"\x17CreateIngredientRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\"&\n" +
+	"\x14GetIngredientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16ListIngredientsRequest\"e\n" +
+	"\x17UpdateIngredientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\")\n" +
+	"\x17DeleteIngredientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1a\n" +
+	"\x18DeleteIngredientResponse\"F\n" +
+	"\x19SetIngredientStockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bin_stock\x18\x02 \x01(\bR\ainStock\"{\n" +
+	"\x12IngredientResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12\x19\n" +
+	"\bin_stock\x18\x05 \x01(\bR\ainStock\"[\n" +
+	"\x17ListIngredientsResponse\x12@\n" +
+	"\vingredients\x18\x01 \x03(\v2\x1e.catalog.v1.IngredientResponseR\vingredients\"\x95\x01\n" +
+	"\x1aSetIngredientStockResponse\x12>\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x1e.catalog.v1.IngredientResponseR\n" +
+	"ingredient\x127\n" +
+	"\bproducts\x18\x02 \x03(\v2\x1b.catalog.v1.ProductResponseR\bproducts\"\x8e\x01\n" +
+	"\x1aGetProductEconomicsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12#\n" +
+	"\rtarget_margin\x18\x02 \x01(\x01R\ftargetMargin\x12*\n" +
+	"\x11below_target_only\x18\x03 \x01(\bR\x0fbelowTargetOnly\"\x97\x01\n" +
+	"\tEconomics\x12\x12\n" +
+	"\x04cost\x18\x01 \x01(\x01R\x04cost\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06profit\x18\x03 \x01(\x01R\x06profit\x12%\n" +
+	"\x0emargin_percent\x18\x04 \x01(\x01R\rmarginPercent\x12!\n" +
+	"\fbelow_target\x18\x05 \x01(\bR\vbelowTarget\"s\n" +
+	"\rSizeEconomics\x12-\n" +
+	"\x04size\x18\x01 \x01(\v2\x19.catalog.v1.ProductOptionR\x04size\x123\n" +
+	"\teconomics\x18\x02 \x01(\v2\x15.catalog.v1.EconomicsR\teconomics\"\xc4\x01\n" +
+	"\x10ProductEconomics\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x04base\x18\x03 \x01(\v2\x15.catalog.v1.EconomicsR\x04base\x12/\n" +
+	"\x05sizes\x18\x04 \x03(\v2\x19.catalog.v1.SizeEconomicsR\x05sizes\x12!\n" +
+	"\fbelow_target\x18\x05 \x01(\bR\vbelowTarget\"|\n" +
+	"\x1bGetProductEconomicsResponse\x128\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1c.catalog.v1.ProductEconomicsR\bproducts\x12#\n" +
+	"\rtarget_margin\x18\x02 \x01(\x01R\ftargetMargin\"E\n" +
+	"\x10ToppingSizePrice\x12\x1b\n" +
+	"\tsize_code\x18\x01 \x01(\tR\bsizeCode\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\"\xc0\x01\n" +
+	"\x14CreateToppingRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\ringredient_id\x18\x02 \x01(\tR\fingredientId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12=\n" +
+	"\vsize_prices\x18\x05 \x03(\v2\x1c.catalog.v1.ToppingSizePriceR\n" +
+	"sizePrices\"\xd0\x01\n" +
+	"\x14UpdateToppingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\ringredient_id\x18\x03 \x01(\tR\fingredientId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12=\n" +
+	"\vsize_prices\x18\x06 \x03(\v2\x1c.catalog.v1.ToppingSizePriceR\n" +
+	"sizePrices\"\xcb\x01\n" +
+	"\x0fToppingResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\ringredient_id\x18\x03 \x01(\tR\fingredientId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x01R\bquantity\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12=\n" +
+	"\vsize_prices\x18\x06 \x03(\v2\x1c.catalog.v1.ToppingSizePriceR\n" +
+	"sizePrices\"\x15\n" +
+	"\x13ListToppingsRequest\"O\n" +
+	"\x14ListToppingsResponse\x127\n" +
+	"\btoppings\x18\x01 \x03(\v2\x1b.catalog.v1.ToppingResponseR\btoppings\"&\n" +
+	"\x14DeleteToppingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteToppingResponse\"P\n" +
+	"\x12ProductToppingRule\x12\x1d\n" +
+	"\n" +
+	"topping_id\x18\x01 \x01(\tR\ttoppingId\x12\x1b\n" +
+	"\tmax_count\x18\x02 \x01(\x05R\bmaxCount\"\x99\x01\n" +
+	"\x19SetProductToppingsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12:\n" +
+	"\btoppings\x18\x02 \x03(\v2\x1e.catalog.v1.ProductToppingRuleR\btoppings\x12!\n" +
+	"\fmax_toppings\x18\x03 \x01(\x05R\vmaxToppings\";\n" +
+	"\x1aListProductToppingsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\xb5\x01\n" +
+	"\x0eProductTopping\x12\x1d\n" +
+	"\n" +
+	"topping_id\x18\x01 \x01(\tR\ttoppingId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12=\n" +
+	"\vsize_prices\x18\x04 \x03(\v2\x1c.catalog.v1.ToppingSizePriceR\n" +
+	"sizePrices\x12\x1b\n" +
+	"\tmax_count\x18\x05 \x01(\x05R\bmaxCount\"x\n" +
+	"\x1bListProductToppingsResponse\x126\n" +
+	"\btoppings\x18\x01 \x03(\v2\x1a.catalog.v1.ProductToppingR\btoppings\x12!\n" +
+	"\fmax_toppings\x18\x02 \x01(\x05R\vmaxToppings2\xed\v\n" +
 	"\x0eProductService\x12N\n" +
 	"\rCreateProduct\x12 .catalog.v1.CreateProductRequest\x1a\x1b.catalog.v1.ProductResponse\x12H\n" +
 	"\n" +
 	"GetProduct\x12\x1d.catalog.v1.GetProductRequest\x1a\x1b.catalog.v1.ProductResponse\x12Q\n" +
-	"\fListProducts\x12\x1f.catalog.v1.ListProductsRequest\x1a .catalog.v1.ListProductsResponseB\x11Z\x0f./pb;catalog_pbb\x06proto3"
+	"\fListProducts\x12\x1f.catalog.v1.ListProductsRequest\x1a .catalog.v1.ListProductsResponse\x12V\n" +
+	"\x11SetProductOptions\x12$.catalog.v1.SetProductOptionsRequest\x1a\x1b.catalog.v1.ProductResponse\x12W\n" +
+	"\x10CreateIngredient\x12#.catalog.v1.CreateIngredientRequest\x1a\x1e.catalog.v1.IngredientResponse\x12Q\n" +
+	"\rGetIngredient\x12 .catalog.v1.GetIngredientRequest\x1a\x1e.catalog.v1.IngredientResponse\x12Z\n" +
+	"\x0fListIngredients\x12\".catalog.v1.ListIngredientsRequest\x1a#.catalog.v1.ListIngredientsResponse\x12W\n" +
+	"\x10UpdateIngredient\x12#.catalog.v1.UpdateIngredientRequest\x1a\x1e.catalog.v1.IngredientResponse\x12]\n" +
+	"\x10DeleteIngredient\x12#.catalog.v1.DeleteIngredientRequest\x1a$.catalog.v1.DeleteIngredientResponse\x12c\n" +
+	"\x12SetIngredientStock\x12%.catalog.v1.SetIngredientStockRequest\x1a&.catalog.v1.SetIngredientStockResponse\x12f\n" +
+	"\x13GetProductEconomics\x12&.catalog.v1.GetProductEconomicsRequest\x1a'.catalog.v1.GetProductEconomicsResponse\x12N\n" +
+	"\rCreateTopping\x12 .catalog.v1.CreateToppingRequest\x1a\x1b.catalog.v1.ToppingResponse\x12N\n" +
+	"\rUpdateTopping\x12 .catalog.v1.UpdateToppingRequest\x1a\x1b.catalog.v1.ToppingResponse\x12Q\n" +
+	"\fListToppings\x12\x1f.catalog.v1.ListToppingsRequest\x1a .catalog.v1.ListToppingsResponse\x12T\n" +
+	"\rDeleteTopping\x12 .catalog.v1.DeleteToppingRequest\x1a!.catalog.v1.DeleteToppingResponse\x12X\n" +
+	"\x12SetProductToppings\x12%.catalog.v1.SetProductToppingsRequest\x1a\x1b.catalog.v1.ProductResponse\x12f\n" +
+	"\x13ListProductToppings\x12&.catalog.v1.ListProductToppingsRequest\x1a'.catalog.v1.ListProductToppingsResponseB\x11Z\x0f./pb;catalog_pbb\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -321,27 +2438,110 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_product_proto_goTypes = []any{
-	(*CreateProductRequest)(nil), // 0: catalog.v1.CreateProductRequest
-	(*GetProductRequest)(nil),    // 1: catalog.v1.GetProductRequest
-	(*ListProductsRequest)(nil),  // 2: catalog.v1.ListProductsRequest
-	(*ProductResponse)(nil),      // 3: catalog.v1.ProductResponse
-	(*ListProductsResponse)(nil), // 4: catalog.v1.ListProductsResponse
+	(*CreateProductRequest)(nil),        // 0: catalog.v1.CreateProductRequest
+	(*ProductIngredient)(nil),           // 1: catalog.v1.ProductIngredient
+	(*ProductOption)(nil),               // 2: catalog.v1.ProductOption
+	(*SetProductOptionsRequest)(nil),    // 3: catalog.v1.SetProductOptionsRequest
+	(*GetProductRequest)(nil),           // 4: catalog.v1.GetProductRequest
+	(*ListProductsRequest)(nil),         // 5: catalog.v1.ListProductsRequest
+	(*ProductResponse)(nil),             // 6: catalog.v1.ProductResponse
+	(*ListProductsResponse)(nil),        // 7: catalog.v1.ListProductsResponse
+	(*CreateIngredientRequest)(nil),     // 8: catalog.v1.CreateIngredientRequest
+	(*GetIngredientRequest)(nil),        // 9: catalog.v1.GetIngredientRequest
+	(*ListIngredientsRequest)(nil),      // 10: catalog.v1.ListIngredientsRequest
+	(*UpdateIngredientRequest)(nil),     // 11: catalog.v1.UpdateIngredientRequest
+	(*DeleteIngredientRequest)(nil),     // 12: catalog.v1.DeleteIngredientRequest
+	(*DeleteIngredientResponse)(nil),    // 13: catalog.v1.DeleteIngredientResponse
+	(*SetIngredientStockRequest)(nil),   // 14: catalog.v1.SetIngredientStockRequest
+	(*IngredientResponse)(nil),          // 15: catalog.v1.IngredientResponse
+	(*ListIngredientsResponse)(nil),     // 16: catalog.v1.ListIngredientsResponse
+	(*SetIngredientStockResponse)(nil),  // 17: catalog.v1.SetIngredientStockResponse
+	(*GetProductEconomicsRequest)(nil),  // 18: catalog.v1.GetProductEconomicsRequest
+	(*Economics)(nil),                   // 19: catalog.v1.Economics
+	(*SizeEconomics)(nil),               // 20: catalog.v1.SizeEconomics
+	(*ProductEconomics)(nil),            // 21: catalog.v1.ProductEconomics
+	(*GetProductEconomicsResponse)(nil), // 22: catalog.v1.GetProductEconomicsResponse
+	(*ToppingSizePrice)(nil),            // 23: catalog.v1.ToppingSizePrice
+	(*CreateToppingRequest)(nil),        // 24: catalog.v1.CreateToppingRequest
+	(*UpdateToppingRequest)(nil),        // 25: catalog.v1.UpdateToppingRequest
+	(*ToppingResponse)(nil),             // 26: catalog.v1.ToppingResponse
+	(*ListToppingsRequest)(nil),         // 27: catalog.v1.ListToppingsRequest
+	(*ListToppingsResponse)(nil),        // 28: catalog.v1.ListToppingsResponse
+	(*DeleteToppingRequest)(nil),        // 29: catalog.v1.DeleteToppingRequest
+	(*DeleteToppingResponse)(nil),       // 30: catalog.v1.DeleteToppingResponse
+	(*ProductToppingRule)(nil),          // 31: catalog.v1.ProductToppingRule
+	(*SetProductToppingsRequest)(nil),   // 32: catalog.v1.SetProductToppingsRequest
+	(*ListProductToppingsRequest)(nil),  // 33: catalog.v1.ListProductToppingsRequest
+	(*ProductTopping)(nil),              // 34: catalog.v1.ProductTopping
+	(*ListProductToppingsResponse)(nil), // 35: catalog.v1.ListProductToppingsResponse
 }
 var file_product_proto_depIdxs = []int32{
-	3, // 0: catalog.v1.ListProductsResponse.products:type_name -> catalog.v1.ProductResponse
-	0, // 1: catalog.v1.ProductService.CreateProduct:input_type -> catalog.v1.CreateProductRequest
-	1, // 2: catalog.v1.ProductService.GetProduct:input_type -> catalog.v1.GetProductRequest
-	2, // 3: catalog.v1.ProductService.ListProducts:input_type -> catalog.v1.ListProductsRequest
-	3, // 4: catalog.v1.ProductService.CreateProduct:output_type -> catalog.v1.ProductResponse
-	3, // 5: catalog.v1.ProductService.GetProduct:output_type -> catalog.v1.ProductResponse
-	4, // 6: catalog.v1.ProductService.ListProducts:output_type -> catalog.v1.ListProductsResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: catalog.v1.CreateProductRequest.sizes:type_name -> catalog.v1.ProductOption
+	2,  // 1: catalog.v1.CreateProductRequest.crusts:type_name -> catalog.v1.ProductOption
+	1,  // 2: catalog.v1.CreateProductRequest.ingredients:type_name -> catalog.v1.ProductIngredient
+	2,  // 3: catalog.v1.SetProductOptionsRequest.sizes:type_name -> catalog.v1.ProductOption
+	2,  // 4: catalog.v1.SetProductOptionsRequest.crusts:type_name -> catalog.v1.ProductOption
+	2,  // 5: catalog.v1.ProductResponse.sizes:type_name -> catalog.v1.ProductOption
+	2,  // 6: catalog.v1.ProductResponse.crusts:type_name -> catalog.v1.ProductOption
+	1,  // 7: catalog.v1.ProductResponse.ingredients:type_name -> catalog.v1.ProductIngredient
+	31, // 8: catalog.v1.ProductResponse.toppings:type_name -> catalog.v1.ProductToppingRule
+	6,  // 9: catalog.v1.ListProductsResponse.products:type_name -> catalog.v1.ProductResponse
+	15, // 10: catalog.v1.ListIngredientsResponse.ingredients:type_name -> catalog.v1.IngredientResponse
+	15, // 11: catalog.v1.SetIngredientStockResponse.ingredient:type_name -> catalog.v1.IngredientResponse
+	6,  // 12: catalog.v1.SetIngredientStockResponse.products:type_name -> catalog.v1.ProductResponse
+	2,  // 13: catalog.v1.SizeEconomics.size:type_name -> catalog.v1.ProductOption
+	19, // 14: catalog.v1.SizeEconomics.economics:type_name -> catalog.v1.Economics
+	19, // 15: catalog.v1.ProductEconomics.base:type_name -> catalog.v1.Economics
+	20, // 16: catalog.v1.ProductEconomics.sizes:type_name -> catalog.v1.SizeEconomics
+	21, // 17: catalog.v1.GetProductEconomicsResponse.products:type_name -> catalog.v1.ProductEconomics
+	23, // 18: catalog.v1.CreateToppingRequest.size_prices:type_name -> catalog.v1.ToppingSizePrice
+	23, // 19: catalog.v1.UpdateToppingRequest.size_prices:type_name -> catalog.v1.ToppingSizePrice
+	23, // 20: catalog.v1.ToppingResponse.size_prices:type_name -> catalog.v1.ToppingSizePrice
+	26, // 21: catalog.v1.ListToppingsResponse.toppings:type_name -> catalog.v1.ToppingResponse
+	31, // 22: catalog.v1.SetProductToppingsRequest.toppings:type_name -> catalog.v1.ProductToppingRule
+	23, // 23: catalog.v1.ProductTopping.size_prices:type_name -> catalog.v1.ToppingSizePrice
+	34, // 24: catalog.v1.ListProductToppingsResponse.toppings:type_name -> catalog.v1.ProductTopping
+	0,  // 25: catalog.v1.ProductService.CreateProduct:input_type -> catalog.v1.CreateProductRequest
+	4,  // 26: catalog.v1.ProductService.GetProduct:input_type -> catalog.v1.GetProductRequest
+	5,  // 27: catalog.v1.ProductService.ListProducts:input_type -> catalog.v1.ListProductsRequest
+	3,  // 28: catalog.v1.ProductService.SetProductOptions:input_type -> catalog.v1.SetProductOptionsRequest
+	8,  // 29: catalog.v1.ProductService.CreateIngredient:input_type -> catalog.v1.CreateIngredientRequest
+	9,  // 30: catalog.v1.ProductService.GetIngredient:input_type -> catalog.v1.GetIngredientRequest
+	10, // 31: catalog.v1.ProductService.ListIngredients:input_type -> catalog.v1.ListIngredientsRequest
+	11, // 32: catalog.v1.ProductService.UpdateIngredient:input_type -> catalog.v1.UpdateIngredientRequest
+	12, // 33: catalog.v1.ProductService.DeleteIngredient:input_type -> catalog.v1.DeleteIngredientRequest
+	14, // 34: catalog.v1.ProductService.SetIngredientStock:input_type -> catalog.v1.SetIngredientStockRequest
+	18, // 35: catalog.v1.ProductService.GetProductEconomics:input_type -> catalog.v1.GetProductEconomicsRequest
+	24, // 36: catalog.v1.ProductService.CreateTopping:input_type -> catalog.v1.CreateToppingRequest
+	25, // 37: catalog.v1.ProductService.UpdateTopping:input_type -> catalog.v1.UpdateToppingRequest
+	27, // 38: catalog.v1.ProductService.ListToppings:input_type -> catalog.v1.ListToppingsRequest
+	29, // 39: catalog.v1.ProductService.DeleteTopping:input_type -> catalog.v1.DeleteToppingRequest
+	32, // 40: catalog.v1.ProductService.SetProductToppings:input_type -> catalog.v1.SetProductToppingsRequest
+	33, // 41: catalog.v1.ProductService.ListProductToppings:input_type -> catalog.v1.ListProductToppingsRequest
+	6,  // 42: catalog.v1.ProductService.CreateProduct:output_type -> catalog.v1.ProductResponse
+	6,  // 43: catalog.v1.ProductService.GetProduct:output_type -> catalog.v1.ProductResponse
+	7,  // 44: catalog.v1.ProductService.ListProducts:output_type -> catalog.v1.ListProductsResponse
+	6,  // 45: catalog.v1.ProductService.SetProductOptions:output_type -> catalog.v1.ProductResponse
+	15, // 46: catalog.v1.ProductService.CreateIngredient:output_type -> catalog.v1.IngredientResponse
+	15, // 47: catalog.v1.ProductService.GetIngredient:output_type -> catalog.v1.IngredientResponse
+	16, // 48: catalog.v1.ProductService.ListIngredients:output_type -> catalog.v1.ListIngredientsResponse
+	15, // 49: catalog.v1.ProductService.UpdateIngredient:output_type -> catalog.v1.IngredientResponse
+	13, // 50: catalog.v1.ProductService.DeleteIngredient:output_type -> catalog.v1.DeleteIngredientResponse
+	17, // 51: catalog.v1.ProductService.SetIngredientStock:output_type -> catalog.v1.SetIngredientStockResponse
+	22, // 52: catalog.v1.ProductService.GetProductEconomics:output_type -> catalog.v1.GetProductEconomicsResponse
+	26, // 53: catalog.v1.ProductService.CreateTopping:output_type -> catalog.v1.ToppingResponse
+	26, // 54: catalog.v1.ProductService.UpdateTopping:output_type -> catalog.v1.ToppingResponse
+	28, // 55: catalog.v1.ProductService.ListToppings:output_type -> catalog.v1.ListToppingsResponse
+	30, // 56: catalog.v1.ProductService.DeleteTopping:output_type -> catalog.v1.DeleteToppingResponse
+	6,  // 57: catalog.v1.ProductService.SetProductToppings:output_type -> catalog.v1.ProductResponse
+	35, // 58: catalog.v1.ProductService.ListProductToppings:output_type -> catalog.v1.ListProductToppingsResponse
+	42, // [42:59] is the sub-list for method output_type
+	25, // [25:42] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -355,7 +2555,7 @@ func file_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
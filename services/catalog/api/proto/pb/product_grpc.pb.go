@@ -19,9 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName = "/catalog.v1.ProductService/CreateProduct"
-	ProductService_GetProduct_FullMethodName    = "/catalog.v1.ProductService/GetProduct"
-	ProductService_ListProducts_FullMethodName  = "/catalog.v1.ProductService/ListProducts"
+	ProductService_CreateProduct_FullMethodName       = "/catalog.v1.ProductService/CreateProduct"
+	ProductService_GetProduct_FullMethodName          = "/catalog.v1.ProductService/GetProduct"
+	ProductService_ListProducts_FullMethodName        = "/catalog.v1.ProductService/ListProducts"
+	ProductService_SetProductOptions_FullMethodName   = "/catalog.v1.ProductService/SetProductOptions"
+	ProductService_CreateIngredient_FullMethodName    = "/catalog.v1.ProductService/CreateIngredient"
+	ProductService_GetIngredient_FullMethodName       = "/catalog.v1.ProductService/GetIngredient"
+	ProductService_ListIngredients_FullMethodName     = "/catalog.v1.ProductService/ListIngredients"
+	ProductService_UpdateIngredient_FullMethodName    = "/catalog.v1.ProductService/UpdateIngredient"
+	ProductService_DeleteIngredient_FullMethodName    = "/catalog.v1.ProductService/DeleteIngredient"
+	ProductService_SetIngredientStock_FullMethodName  = "/catalog.v1.ProductService/SetIngredientStock"
+	ProductService_GetProductEconomics_FullMethodName = "/catalog.v1.ProductService/GetProductEconomics"
+	ProductService_CreateTopping_FullMethodName       = "/catalog.v1.ProductService/CreateTopping"
+	ProductService_UpdateTopping_FullMethodName       = "/catalog.v1.ProductService/UpdateTopping"
+	ProductService_ListToppings_FullMethodName        = "/catalog.v1.ProductService/ListToppings"
+	ProductService_DeleteTopping_FullMethodName       = "/catalog.v1.ProductService/DeleteTopping"
+	ProductService_SetProductToppings_FullMethodName  = "/catalog.v1.ProductService/SetProductToppings"
+	ProductService_ListProductToppings_FullMethodName = "/catalog.v1.ProductService/ListProductToppings"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -30,7 +44,28 @@ const (
 type ProductServiceClient interface {
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	// Выдача каталога с фильтрами, постранично: next_page_token передается в page_token.
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	SetProductOptions(ctx context.Context, in *SetProductOptionsRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	CreateIngredient(ctx context.Context, in *CreateIngredientRequest, opts ...grpc.CallOption) (*IngredientResponse, error)
+	GetIngredient(ctx context.Context, in *GetIngredientRequest, opts ...grpc.CallOption) (*IngredientResponse, error)
+	ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error)
+	UpdateIngredient(ctx context.Context, in *UpdateIngredientRequest, opts ...grpc.CallOption) (*IngredientResponse, error)
+	// Ингредиент, который входит в состав товаров, удалить нельзя.
+	DeleteIngredient(ctx context.Context, in *DeleteIngredientRequest, opts ...grpc.CallOption) (*DeleteIngredientResponse, error)
+	// Наличие ингредиента на складе. Товары без неубираемого ингредиента становятся недоступны.
+	SetIngredientStock(ctx context.Context, in *SetIngredientStockRequest, opts ...grpc.CallOption) (*SetIngredientStockResponse, error)
+	// Себестоимость товаров по составу и маржа относительно цены, для менеджеров.
+	GetProductEconomics(ctx context.Context, in *GetProductEconomicsRequest, opts ...grpc.CallOption) (*GetProductEconomicsResponse, error)
+	CreateTopping(ctx context.Context, in *CreateToppingRequest, opts ...grpc.CallOption) (*ToppingResponse, error)
+	UpdateTopping(ctx context.Context, in *UpdateToppingRequest, opts ...grpc.CallOption) (*ToppingResponse, error)
+	ListToppings(ctx context.Context, in *ListToppingsRequest, opts ...grpc.CallOption) (*ListToppingsResponse, error)
+	// Топпинг, разрешенный для товаров, удалить нельзя.
+	DeleteTopping(ctx context.Context, in *DeleteToppingRequest, opts ...grpc.CallOption) (*DeleteToppingResponse, error)
+	SetProductToppings(ctx context.Context, in *SetProductToppingsRequest, opts ...grpc.CallOption) (*ProductResponse, error)
+	// Топпинги, которые сейчас можно добавить к товару, с ценами по размерам и лимитами.
+	// По нему сервис заказов проверяет топпинги позиции.
+	ListProductToppings(ctx context.Context, in *ListProductToppingsRequest, opts ...grpc.CallOption) (*ListProductToppingsResponse, error)
 }
 
 type productServiceClient struct {
@@ -71,13 +106,174 @@ func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProduct
 	return out, nil
 }
 
+func (c *productServiceClient) SetProductOptions(ctx context.Context, in *SetProductOptionsRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateIngredient(ctx context.Context, in *CreateIngredientRequest, opts ...grpc.CallOption) (*IngredientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngredientResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetIngredient(ctx context.Context, in *GetIngredientRequest, opts ...grpc.CallOption) (*IngredientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngredientResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIngredientsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateIngredient(ctx context.Context, in *UpdateIngredientRequest, opts ...grpc.CallOption) (*IngredientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngredientResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteIngredient(ctx context.Context, in *DeleteIngredientRequest, opts ...grpc.CallOption) (*DeleteIngredientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteIngredientResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetIngredientStock(ctx context.Context, in *SetIngredientStockRequest, opts ...grpc.CallOption) (*SetIngredientStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetIngredientStockResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetIngredientStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductEconomics(ctx context.Context, in *GetProductEconomicsRequest, opts ...grpc.CallOption) (*GetProductEconomicsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductEconomicsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductEconomics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateTopping(ctx context.Context, in *CreateToppingRequest, opts ...grpc.CallOption) (*ToppingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToppingResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateTopping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateTopping(ctx context.Context, in *UpdateToppingRequest, opts ...grpc.CallOption) (*ToppingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToppingResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateTopping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListToppings(ctx context.Context, in *ListToppingsRequest, opts ...grpc.CallOption) (*ListToppingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListToppingsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListToppings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteTopping(ctx context.Context, in *DeleteToppingRequest, opts ...grpc.CallOption) (*DeleteToppingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteToppingResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteTopping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductToppings(ctx context.Context, in *SetProductToppingsRequest, opts ...grpc.CallOption) (*ProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductToppings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductToppings(ctx context.Context, in *ListProductToppingsRequest, opts ...grpc.CallOption) (*ListProductToppingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductToppingsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductToppings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
 type ProductServiceServer interface {
 	CreateProduct(context.Context, *CreateProductRequest) (*ProductResponse, error)
 	GetProduct(context.Context, *GetProductRequest) (*ProductResponse, error)
+	// Выдача каталога с фильтрами, постранично: next_page_token передается в page_token.
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	SetProductOptions(context.Context, *SetProductOptionsRequest) (*ProductResponse, error)
+	CreateIngredient(context.Context, *CreateIngredientRequest) (*IngredientResponse, error)
+	GetIngredient(context.Context, *GetIngredientRequest) (*IngredientResponse, error)
+	ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error)
+	UpdateIngredient(context.Context, *UpdateIngredientRequest) (*IngredientResponse, error)
+	// Ингредиент, который входит в состав товаров, удалить нельзя.
+	DeleteIngredient(context.Context, *DeleteIngredientRequest) (*DeleteIngredientResponse, error)
+	// Наличие ингредиента на складе. Товары без неубираемого ингредиента становятся недоступны.
+	SetIngredientStock(context.Context, *SetIngredientStockRequest) (*SetIngredientStockResponse, error)
+	// Себестоимость товаров по составу и маржа относительно цены, для менеджеров.
+	GetProductEconomics(context.Context, *GetProductEconomicsRequest) (*GetProductEconomicsResponse, error)
+	CreateTopping(context.Context, *CreateToppingRequest) (*ToppingResponse, error)
+	UpdateTopping(context.Context, *UpdateToppingRequest) (*ToppingResponse, error)
+	ListToppings(context.Context, *ListToppingsRequest) (*ListToppingsResponse, error)
+	// Топпинг, разрешенный для товаров, удалить нельзя.
+	DeleteTopping(context.Context, *DeleteToppingRequest) (*DeleteToppingResponse, error)
+	SetProductToppings(context.Context, *SetProductToppingsRequest) (*ProductResponse, error)
+	// Топпинги, которые сейчас можно добавить к товару, с ценами по размерам и лимитами.
+	// По нему сервис заказов проверяет топпинги позиции.
+	ListProductToppings(context.Context, *ListProductToppingsRequest) (*ListProductToppingsResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -97,6 +293,48 @@ func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProduct
 func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
 }
+func (UnimplementedProductServiceServer) SetProductOptions(context.Context, *SetProductOptionsRequest) (*ProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductOptions not implemented")
+}
+func (UnimplementedProductServiceServer) CreateIngredient(context.Context, *CreateIngredientRequest) (*IngredientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateIngredient not implemented")
+}
+func (UnimplementedProductServiceServer) GetIngredient(context.Context, *GetIngredientRequest) (*IngredientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIngredient not implemented")
+}
+func (UnimplementedProductServiceServer) ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIngredients not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateIngredient(context.Context, *UpdateIngredientRequest) (*IngredientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateIngredient not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteIngredient(context.Context, *DeleteIngredientRequest) (*DeleteIngredientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteIngredient not implemented")
+}
+func (UnimplementedProductServiceServer) SetIngredientStock(context.Context, *SetIngredientStockRequest) (*SetIngredientStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetIngredientStock not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductEconomics(context.Context, *GetProductEconomicsRequest) (*GetProductEconomicsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductEconomics not implemented")
+}
+func (UnimplementedProductServiceServer) CreateTopping(context.Context, *CreateToppingRequest) (*ToppingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTopping not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateTopping(context.Context, *UpdateToppingRequest) (*ToppingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTopping not implemented")
+}
+func (UnimplementedProductServiceServer) ListToppings(context.Context, *ListToppingsRequest) (*ListToppingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListToppings not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteTopping(context.Context, *DeleteToppingRequest) (*DeleteToppingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTopping not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductToppings(context.Context, *SetProductToppingsRequest) (*ProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductToppings not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductToppings(context.Context, *ListProductToppingsRequest) (*ListProductToppingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductToppings not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -172,6 +410,258 @@ func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_SetProductOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductOptions(ctx, req.(*SetProductOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateIngredient(ctx, req.(*CreateIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetIngredient(ctx, req.(*GetIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngredientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListIngredients(ctx, req.(*ListIngredientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateIngredient(ctx, req.(*UpdateIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteIngredient(ctx, req.(*DeleteIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetIngredientStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIngredientStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetIngredientStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetIngredientStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetIngredientStock(ctx, req.(*SetIngredientStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductEconomics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductEconomicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductEconomics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductEconomics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductEconomics(ctx, req.(*GetProductEconomicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateTopping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateToppingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateTopping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateTopping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateTopping(ctx, req.(*CreateToppingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateTopping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateToppingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateTopping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateTopping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateTopping(ctx, req.(*UpdateToppingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListToppings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListToppingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListToppings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListToppings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListToppings(ctx, req.(*ListToppingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteTopping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteToppingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteTopping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteTopping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteTopping(ctx, req.(*DeleteToppingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductToppings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductToppingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductToppings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductToppings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductToppings(ctx, req.(*SetProductToppingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductToppings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductToppingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductToppings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductToppings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductToppings(ctx, req.(*ListProductToppingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +681,62 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProducts",
 			Handler:    _ProductService_ListProducts_Handler,
 		},
+		{
+			MethodName: "SetProductOptions",
+			Handler:    _ProductService_SetProductOptions_Handler,
+		},
+		{
+			MethodName: "CreateIngredient",
+			Handler:    _ProductService_CreateIngredient_Handler,
+		},
+		{
+			MethodName: "GetIngredient",
+			Handler:    _ProductService_GetIngredient_Handler,
+		},
+		{
+			MethodName: "ListIngredients",
+			Handler:    _ProductService_ListIngredients_Handler,
+		},
+		{
+			MethodName: "UpdateIngredient",
+			Handler:    _ProductService_UpdateIngredient_Handler,
+		},
+		{
+			MethodName: "DeleteIngredient",
+			Handler:    _ProductService_DeleteIngredient_Handler,
+		},
+		{
+			MethodName: "SetIngredientStock",
+			Handler:    _ProductService_SetIngredientStock_Handler,
+		},
+		{
+			MethodName: "GetProductEconomics",
+			Handler:    _ProductService_GetProductEconomics_Handler,
+		},
+		{
+			MethodName: "CreateTopping",
+			Handler:    _ProductService_CreateTopping_Handler,
+		},
+		{
+			MethodName: "UpdateTopping",
+			Handler:    _ProductService_UpdateTopping_Handler,
+		},
+		{
+			MethodName: "ListToppings",
+			Handler:    _ProductService_ListToppings_Handler,
+		},
+		{
+			MethodName: "DeleteTopping",
+			Handler:    _ProductService_DeleteTopping_Handler,
+		},
+		{
+			MethodName: "SetProductToppings",
+			Handler:    _ProductService_SetProductToppings_Handler,
+		},
+		{
+			MethodName: "ListProductToppings",
+			Handler:    _ProductService_ListProductToppings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
@@ -3,6 +3,7 @@ package catalog
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,9 +21,29 @@ const (
 	CatDesserts   CategoryType = 5
 )
 
+func (c CategoryType) String() string {
+	switch c {
+	case CatClassic:
+		return "classic"
+	case CatPremium:
+		return "premium"
+	case CatVegetarian:
+		return "vegetarian"
+	case CatSpicy:
+		return "spicy"
+	case CatDrinks:
+		return "drinks"
+	case CatDesserts:
+		return "desserts"
+	default:
+		return "unknown"
+	}
+}
+
 type Ingredient struct {
 	ID      string
 	Name    string
+	// Cost - стоимость одной единицы Unit, количество в составе товара в тех же единицах.
 	Cost    common.Money
 	Unit    string
 	InStock bool
@@ -44,6 +65,14 @@ type Product struct {
 	imageUrl    string
 	isAvailable bool
 	createdAt   time.Time
+	sizes       []SizeOption
+	crusts      []CrustOption
+	// halves - товар может быть половиной пиццы "пополам".
+	halves      bool
+	// outOfStock - ингредиенты состава, которых нет на складе.
+	outOfStock  map[string]struct{}
+	toppings    []ToppingRule
+	maxToppings int
 }
 
 var (
@@ -51,6 +80,8 @@ var (
 	ErrNegativePrice   = errors.New("price cannot be negative")
 	ErrNegativeQty     = errors.New("quantity must be positive")
 	ErrProductNotFound = errors.New("product not found")
+
+	ErrIngredientNotFound = errors.New("ingredient not found")
 )
 
 func NewProduct(name, desc string, cat CategoryType, basePrice common.Money) (*Product, error) {
@@ -74,6 +105,77 @@ func NewProduct(name, desc string, cat CategoryType, basePrice common.Money) (*P
 	}, nil
 }
 
+// ProductSnapshot - состояние товара, сохраненное в хранилище.
+type ProductSnapshot struct {
+	ID          string
+	Name        string
+	Description string
+	Category    CategoryType
+	BasePrice   common.Money
+	Ingredients []IngredientRef
+	ImageURL    string
+	// IsAvailable - признак, выставленный вручную, без учета склада.
+	IsAvailable   bool
+	CreatedAt     time.Time
+	Sizes         []SizeOption
+	Crusts        []CrustOption
+	HalvesAllowed bool
+	// OutOfStock - ингредиенты состава, которых нет на складе.
+	OutOfStock  []string
+	Toppings    []ToppingRule
+	MaxToppings int
+}
+
+// RestoreProduct - восстанавливает товар из хранилища без проверок NewProduct.
+func RestoreProduct(s ProductSnapshot) *Product {
+	p := &Product{
+		id:          s.ID,
+		name:        s.Name,
+		description: s.Description,
+		category:    s.Category,
+		basePrice:   s.BasePrice,
+		ingredients: append(make([]IngredientRef, 0, len(s.Ingredients)), s.Ingredients...),
+		imageUrl:    s.ImageURL,
+		isAvailable: s.IsAvailable,
+		createdAt:   s.CreatedAt,
+		sizes:       append([]SizeOption(nil), s.Sizes...),
+		crusts:      append([]CrustOption(nil), s.Crusts...),
+		halves:      s.HalvesAllowed,
+		toppings:    append([]ToppingRule(nil), s.Toppings...),
+		maxToppings: s.MaxToppings,
+	}
+	for _, id := range s.OutOfStock {
+		p.SetIngredientStock(id, false)
+	}
+	return p
+}
+
+// Snapshot - состояние товара для сохранения в хранилище.
+func (p *Product) Snapshot() ProductSnapshot {
+	s := ProductSnapshot{
+		ID:            p.id,
+		Name:          p.name,
+		Description:   p.description,
+		Category:      p.category,
+		BasePrice:     p.basePrice,
+		Ingredients:   p.Ingredients(),
+		ImageURL:      p.imageUrl,
+		IsAvailable:   p.isAvailable,
+		CreatedAt:     p.createdAt,
+		Sizes:         p.Sizes(),
+		Crusts:        p.Crusts(),
+		HalvesAllowed: p.halves,
+		Toppings:      p.Toppings(),
+		MaxToppings:   p.maxToppings,
+	}
+	for _, ref := range p.ingredients {
+		if _, out := p.outOfStock[ref.IngredientID]; out {
+			s.OutOfStock = append(s.OutOfStock, ref.IngredientID)
+		}
+	}
+	return s
+}
+
 func (p *Product) AddIngredient(ingID string, qty float64, removable bool) error {
 	if qty <= 0 {
 		return ErrNegativeQty
@@ -94,17 +196,28 @@ func (p *Product) UpdatePrice(newPrice common.Money) error {
 	return nil
 }
 
+// SetAvailability - ручное снятие с продажи. Товар без нужных ингредиентов
+// недоступен, даже если выставлен в продажу.
 func (p *Product) SetAvailability(available bool) {
 	p.isAvailable = available
 }
 
+func (p *Product) SetImageURL(url string) {
+	p.imageUrl = strings.TrimSpace(url)
+}
+
 func (p *Product) ID() string               { return p.id }
 func (p *Product) Name() string             { return p.name }
 func (p *Product) Description() string      { return p.description }
 func (p *Product) Category() CategoryType   { return p.category }
 func (p *Product) BasePrice() common.Money  { return p.basePrice }
 func (p *Product) ImageURL() string         { return p.imageUrl }
-func (p *Product) IsAvailable() bool        { return p.isAvailable }
+func (p *Product) CreatedAt() time.Time     { return p.createdAt }
+
+// IsAvailable - товар в продаже и все неубираемые ингредиенты есть на складе.
+func (p *Product) IsAvailable() bool {
+	return p.isAvailable && len(p.MissingIngredients()) == 0
+}
 
 func (p *Product) Ingredients() []IngredientRef {
 	result := make([]IngredientRef, len(p.ingredients))
@@ -115,5 +228,14 @@ func (p *Product) Ingredients() []IngredientRef {
 type ProductRepository interface {
 	FindAll(ctx context.Context) ([]*Product, error)
 	FindByID(ctx context.Context, id string) (*Product, error)
+	// List - товары по фильтру в порядке filter.Sort.
+	List(ctx context.Context, filter ProductFilter) ([]*Product, error)
 	Save(ctx context.Context, p *Product) error
 }
+
+type IngredientRepository interface {
+	FindAll(ctx context.Context) ([]*Ingredient, error)
+	FindByID(ctx context.Context, id string) (*Ingredient, error)
+	Save(ctx context.Context, ing *Ingredient) error
+	Delete(ctx context.Context, id string) error
+}
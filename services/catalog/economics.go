@@ -0,0 +1,97 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var ErrInvalidMargin = errors.New("target margin must be between 0 and 100 percent")
+
+// MarginPolicy - целевая маржа в процентах от цены. Товары с меньшей маржой отмечаются.
+type MarginPolicy struct {
+	TargetPercent float64
+}
+
+func (p *MarginPolicy) Validate() error {
+	if p.TargetPercent < 0 || p.TargetPercent >= 100 {
+		return fmt.Errorf("%w: %v", ErrInvalidMargin, p.TargetPercent)
+	}
+	return nil
+}
+
+// Economics - себестоимость и маржа по одной цене.
+type Economics struct {
+	Cost   common.Money
+	Price  common.Money
+	Profit common.Money
+	// MarginPercent - доля прибыли в цене. У бесплатного товара 0.
+	MarginPercent float64
+	BelowTarget   bool
+}
+
+// SizeEconomics - экономика размера: расход ингредиентов растет вместе с ценой по Multiplier.
+type SizeEconomics struct {
+	Size SizeOption
+	Economics
+}
+
+// ProductEconomics - экономика товара по базовой цене и по каждому размеру.
+type ProductEconomics struct {
+	ProductID string
+	Name      string
+	// Base - по базовой цене.
+	Base  Economics
+	Sizes []SizeEconomics
+	// BelowTarget - хотя бы одна цена товара ниже целевой маржи.
+	BelowTarget bool
+}
+
+// CalculateProductCost - себестоимость базового размера по составу. Ингредиент, которого
+// нет в ingredients, - ошибка: посчитать стоимость без него нельзя.
+func CalculateProductCost(p *Product, ingredients map[string]*Ingredient) (common.Money, error) {
+	cost := common.ZeroMoney()
+	for _, ref := range p.ingredients {
+		ing, ok := ingredients[ref.IngredientID]
+		if !ok {
+			return common.Money{}, fmt.Errorf("%w: %s in product %s", ErrIngredientNotFound, ref.IngredientID, p.id)
+		}
+		cost = cost.Add(ing.Cost.Mul(common.NewMoney(ref.Quantity)))
+	}
+	return cost.Round(2), nil
+}
+
+// CalculateEconomics - себестоимость, прибыль и маржа товара относительно BasePrice и цен размеров.
+func CalculateEconomics(p *Product, ingredients map[string]*Ingredient, policy MarginPolicy) (*ProductEconomics, error) {
+	cost, err := CalculateProductCost(p, ingredients)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &ProductEconomics{
+		ProductID: p.id,
+		Name:      p.name,
+		Base:      policy.economics(cost, p.basePrice),
+	}
+	e.BelowTarget = e.Base.BelowTarget
+	for _, s := range p.sizes {
+		k := common.NewMoney(s.Multiplier)
+		size := SizeEconomics{
+			Size:      s,
+			Economics: policy.economics(cost.Mul(k).Round(2), p.basePrice.Mul(k).Round(2)),
+		}
+		e.BelowTarget = e.BelowTarget || size.BelowTarget
+		e.Sizes = append(e.Sizes, size)
+	}
+	return e, nil
+}
+
+func (p *MarginPolicy) economics(cost, price common.Money) Economics {
+	e := Economics{Cost: cost, Price: price, Profit: price.Sub(cost)}
+	if price.IsPositive() {
+		e.MarginPercent = e.Profit.Div(price).Mul(common.NewMoney(100)).Round(2).InexactFloat64()
+	}
+	e.BelowTarget = e.MarginPercent < p.TargetPercent
+	return e
+}
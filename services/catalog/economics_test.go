@@ -0,0 +1,67 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestCalculateEconomics(t *testing.T) {
+	ingredients := map[string]*Ingredient{
+		"dough":  {ID: "dough", Cost: common.NewMoney(0.2), Unit: "g"},
+		"cheese": {ID: "cheese", Cost: common.NewMoney(1.1), Unit: "g"},
+	}
+	p, _ := NewProduct("Margherita", "", CatClassic, common.NewMoney(500))
+	_ = p.AddIngredient("dough", 250, false)
+	_ = p.AddIngredient("cheese", 100, true)
+	_ = p.SetSizes([]SizeOption{{Code: "25", Name: "25 cm", Multiplier: 1}, {Code: "35", Name: "35 cm", Multiplier: 1.5}})
+
+	cost, err := CalculateProductCost(p, ingredients)
+	if err != nil || !cost.Equal(common.NewMoney(160)) {
+		t.Fatalf("expected cost 160, got %v, %v", cost, err)
+	}
+
+	e, err := CalculateEconomics(p, ingredients, MarginPolicy{TargetPercent: 65})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !e.Base.Profit.Equal(common.NewMoney(340)) || e.Base.MarginPercent != 68 || e.Base.BelowTarget || e.BelowTarget {
+		t.Errorf("unexpected base economics: %+v", e.Base)
+	}
+	if len(e.Sizes) != 2 {
+		t.Fatalf("expected 2 sizes, got %d", len(e.Sizes))
+	}
+	if large := e.Sizes[1]; !large.Cost.Equal(common.NewMoney(240)) || !large.Price.Equal(common.NewMoney(750)) {
+		t.Errorf("unexpected large size economics: %+v", large)
+	}
+
+	if e, _ = CalculateEconomics(p, ingredients, MarginPolicy{TargetPercent: 70}); !e.BelowTarget || !e.Sizes[0].BelowTarget {
+		t.Error("margin 68% must be below target 70%")
+	}
+
+	delete(ingredients, "cheese")
+	if _, err := CalculateProductCost(p, ingredients); !errors.Is(err, ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound, got %v", err)
+	}
+}
+
+func TestCalculateEconomics_FreeProduct(t *testing.T) {
+	p, _ := NewProduct("Sauce", "", CatClassic, common.ZeroMoney())
+	e, err := CalculateEconomics(p, nil, MarginPolicy{TargetPercent: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.Base.MarginPercent != 0 || !e.BelowTarget {
+		t.Errorf("free product must be below target: %+v", e.Base)
+	}
+}
+
+func TestMarginPolicy_Validate(t *testing.T) {
+	for _, target := range []float64{-1, 100} {
+		p := MarginPolicy{TargetPercent: target}
+		if err := p.Validate(); !errors.Is(err, ErrInvalidMargin) {
+			t.Errorf("expected ErrInvalidMargin for %v, got %v", target, err)
+		}
+	}
+}
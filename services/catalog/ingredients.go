@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var (
+	ErrInvalidIngredient = errors.New("invalid ingredient details")
+	ErrIngredientInUse   = errors.New("ingredient is used by products")
+)
+
+// NewIngredient - новый ингредиент считается имеющимся на складе.
+func NewIngredient(name, unit string, cost common.Money) (*Ingredient, error) {
+	ing := &Ingredient{
+		Name:    strings.TrimSpace(name),
+		Unit:    strings.TrimSpace(unit),
+		Cost:    cost,
+		InStock: true,
+	}
+	if err := ing.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, _ := uuid.NewV7()
+	ing.ID = id.String()
+	return ing, nil
+}
+
+func (i *Ingredient) Validate() error {
+	if i.Name == "" || i.Unit == "" {
+		return fmt.Errorf("%w: name and unit are required", ErrInvalidIngredient)
+	}
+	if i.Cost.IsNegative() {
+		return fmt.Errorf("%w: %w", ErrInvalidIngredient, ErrNegativePrice)
+	}
+	return nil
+}
+
+// SetIngredientStock - отмечает наличие ингредиента на складе. Возвращает false,
+// если ингредиента нет в составе товара.
+func (p *Product) SetIngredientStock(ingredientID string, inStock bool) bool {
+	if !p.usesIngredient(ingredientID) {
+		return false
+	}
+	if inStock {
+		delete(p.outOfStock, ingredientID)
+		return true
+	}
+	if p.outOfStock == nil {
+		p.outOfStock = make(map[string]struct{})
+	}
+	p.outOfStock[ingredientID] = struct{}{}
+	return true
+}
+
+// MissingIngredients - неубираемые ингредиенты, которых нет на складе. Пока список
+// не пуст, товар недоступен независимо от SetAvailability.
+func (p *Product) MissingIngredients() []string {
+	var missing []string
+	for _, ref := range p.ingredients {
+		if _, out := p.outOfStock[ref.IngredientID]; out && !ref.IsRemovable {
+			missing = append(missing, ref.IngredientID)
+		}
+	}
+	return missing
+}
+
+// MenuIngredients - состав, который видит клиент: убираемые ингредиенты, которых
+// нет на складе, скрыты.
+func (p *Product) MenuIngredients() []IngredientRef {
+	result := make([]IngredientRef, 0, len(p.ingredients))
+	for _, ref := range p.ingredients {
+		if _, out := p.outOfStock[ref.IngredientID]; out && ref.IsRemovable {
+			continue
+		}
+		result = append(result, ref)
+	}
+	return result
+}
+
+func (p *Product) usesIngredient(ingredientID string) bool {
+	for _, ref := range p.ingredients {
+		if ref.IngredientID == ingredientID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package catalog
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestNewIngredient(t *testing.T) {
+	ing, err := NewIngredient(" Mozzarella ", "g", common.NewMoney(1.2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ing.ID == "" || ing.Name != "Mozzarella" || !ing.InStock {
+		t.Errorf("unexpected ingredient: %+v", ing)
+	}
+
+	if _, err := NewIngredient("Salt", "", common.NewMoney(1)); !errors.Is(err, ErrInvalidIngredient) {
+		t.Errorf("expected ErrInvalidIngredient, got %v", err)
+	}
+	if _, err := NewIngredient("Salt", "g", common.NewMoney(-1)); !errors.Is(err, ErrNegativePrice) {
+		t.Errorf("expected ErrNegativePrice, got %v", err)
+	}
+}
+
+func TestProduct_IngredientStock(t *testing.T) {
+	p, _ := NewProduct("Pizza", "", CatClassic, common.NewMoney(500))
+	_ = p.AddIngredient("dough", 200, false)
+	_ = p.AddIngredient("olives", 30, true)
+
+	if p.SetIngredientStock("ham", false) {
+		t.Error("product does not use ham")
+	}
+
+	p.SetIngredientStock("olives", false)
+	if !p.IsAvailable() || len(p.MissingIngredients()) != 0 {
+		t.Error("missing removable ingredient must not make the product unavailable")
+	}
+	if menu := p.MenuIngredients(); len(menu) != 1 || menu[0].IngredientID != "dough" {
+		t.Errorf("expected olives to be hidden, got %v", menu)
+	}
+
+	p.SetIngredientStock("dough", false)
+	if p.IsAvailable() || !reflect.DeepEqual(p.MissingIngredients(), []string{"dough"}) {
+		t.Errorf("product without dough must be unavailable, missing %v", p.MissingIngredients())
+	}
+
+	// Снимок хранит ручной признак отдельно от наличия ингредиентов
+	s := p.Snapshot()
+	if !s.IsAvailable || len(s.OutOfStock) != 2 {
+		t.Errorf("unexpected snapshot: %+v", s)
+	}
+	restored := RestoreProduct(s)
+	restored.SetIngredientStock("dough", true)
+	if !restored.IsAvailable() || len(restored.MenuIngredients()) != 1 {
+		t.Error("restored product must keep olives out of stock and become available with dough")
+	}
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/versoit/diploma/services/catalog"
+	catalog_pb "github.com/versoit/diploma/services/catalog/api/proto/pb"
+	"github.com/versoit/diploma/services/catalog/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *CatalogHandler) GetProductEconomics(ctx context.Context, req *catalog_pb.GetProductEconomicsRequest) (*catalog_pb.GetProductEconomicsResponse, error) {
+	report, err := h.uc.GetProductEconomics(ctx, usecase.EconomicsInput{
+		ProductIDs:      req.ProductIds,
+		TargetPercent:   req.TargetMargin,
+		BelowTargetOnly: req.BelowTargetOnly,
+	})
+	switch {
+	case errors.Is(err, catalog.ErrProductNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrInvalidInput):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, catalog.ErrIngredientNotFound):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &catalog_pb.GetProductEconomicsResponse{TargetMargin: report.TargetPercent}
+	for _, e := range report.Products {
+		item := &catalog_pb.ProductEconomics{
+			ProductId:   e.ProductID,
+			Name:        e.Name,
+			Base:        toProtoEconomics(e.Base),
+			BelowTarget: e.BelowTarget,
+		}
+		for _, s := range e.Sizes {
+			item.Sizes = append(item.Sizes, &catalog_pb.SizeEconomics{
+				Size:      &catalog_pb.ProductOption{Code: s.Size.Code, Name: s.Size.Name, Multiplier: s.Size.Multiplier},
+				Economics: toProtoEconomics(s.Economics),
+			})
+		}
+		resp.Products = append(resp.Products, item)
+	}
+	return resp, nil
+}
+
+func toProtoEconomics(e catalog.Economics) *catalog_pb.Economics {
+	return &catalog_pb.Economics{
+		Cost:          e.Cost.InexactFloat64(),
+		Price:         e.Price.InexactFloat64(),
+		Profit:        e.Profit.InexactFloat64(),
+		MarginPercent: e.MarginPercent,
+		BelowTarget:   e.BelowTarget,
+	}
+}
@@ -2,12 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/versoit/diploma/pkg/common"
 	"github.com/versoit/diploma/services/catalog"
 	catalog_pb "github.com/versoit/diploma/services/catalog/api/proto/pb"
 	"github.com/versoit/diploma/services/catalog/usecase"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CatalogHandler struct {
@@ -24,23 +27,139 @@ func (h *CatalogHandler) Register(server *grpc.Server) {
 }
 
 func (h *CatalogHandler) CreateProduct(ctx context.Context, req *catalog_pb.CreateProductRequest) (*catalog_pb.ProductResponse, error) {
-	p, err := h.uc.CreateProduct(ctx, req.Name, req.Description, catalog.CategoryType(req.CategoryId), common.NewMoney(req.Price))
+	input := usecase.CreateProductInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Category:    catalog.CategoryType(req.CategoryId),
+		Price:       common.NewMoney(req.Price),
+		ImageURL:    req.ImageUrl,
+		Options:     toDomainOptions(req.Sizes, req.Crusts, req.HalvesAllowed),
+	}
+	for _, ing := range req.Ingredients {
+		input.Ingredients = append(input.Ingredients, catalog.IngredientRef{
+			IngredientID: ing.IngredientId,
+			Quantity:     ing.Quantity,
+			IsRemovable:  ing.Removable,
+		})
+	}
+
+	p, err := h.uc.CreateProduct(ctx, input)
+	if errors.Is(err, catalog.ErrInvalidOption) || errors.Is(err, catalog.ErrNegativeQty) ||
+		errors.Is(err, catalog.ErrIngredientNotFound) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &catalog_pb.ProductResponse{
-		Id:          p.ID(),
-		Name:        p.Name(),
-		Description: p.Description(),
-		Price:       p.BasePrice().InexactFloat64(),
-	}, nil
+	return toProductResponse(p), nil
 }
 
 func (h *CatalogHandler) GetProduct(ctx context.Context, req *catalog_pb.GetProductRequest) (*catalog_pb.ProductResponse, error) {
-	return &catalog_pb.ProductResponse{Id: req.Id}, nil
+	p, err := h.uc.GetProduct(ctx, req.Id)
+	if errors.Is(err, catalog.ErrProductNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductResponse(p), nil
 }
 
 func (h *CatalogHandler) ListProducts(ctx context.Context, req *catalog_pb.ListProductsRequest) (*catalog_pb.ListProductsResponse, error) {
-	return &catalog_pb.ListProductsResponse{}, nil
-}
\ No newline at end of file
+	sort, err := catalog.ParseProductSort(req.Sort)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := catalog.ProductFilter{
+		AvailableOnly: req.AvailableOnly,
+		Query:         req.Query,
+		Sort:          sort,
+		Limit:         int(req.PageSize),
+	}
+	for _, c := range req.CategoryIds {
+		filter.Categories = append(filter.Categories, catalog.CategoryType(c))
+	}
+	if req.PageToken != "" {
+		if filter.After, err = decodePageToken(req.PageToken, sort); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	page, err := h.uc.ListProducts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &catalog_pb.ListProductsResponse{}
+	for _, p := range page.Products {
+		resp.Products = append(resp.Products, toProductResponse(p))
+	}
+	if page.NextCursor != nil {
+		if resp.NextPageToken, err = encodePageToken(*page.NextCursor, sort); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (h *CatalogHandler) SetProductOptions(ctx context.Context, req *catalog_pb.SetProductOptionsRequest) (*catalog_pb.ProductResponse, error) {
+	p, err := h.uc.SetProductOptions(ctx, req.ProductId, toDomainOptions(req.Sizes, req.Crusts, req.HalvesAllowed))
+	if errors.Is(err, catalog.ErrProductNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, catalog.ErrInvalidOption) || errors.Is(err, usecase.ErrInvalidInput) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toProductResponse(p), nil
+}
+
+func toProductResponse(p *catalog.Product) *catalog_pb.ProductResponse {
+	resp := &catalog_pb.ProductResponse{
+		Id:            p.ID(),
+		Name:          p.Name(),
+		Description:   p.Description(),
+		Price:         p.BasePrice().InexactFloat64(),
+		IsAvailable:   p.IsAvailable(),
+		CategoryId:    int32(p.Category()),
+		Category:      p.Category().String(),
+		HalvesAllowed: p.HalvesAllowed(),
+		ImageUrl:      p.ImageURL(),
+		MaxToppings:   int32(p.MaxToppings()), // #nosec G115
+	}
+	// Клиент не видит убираемые ингредиенты, которых нет на складе
+	for _, ing := range p.MenuIngredients() {
+		resp.Ingredients = append(resp.Ingredients, &catalog_pb.ProductIngredient{
+			IngredientId: ing.IngredientID,
+			Quantity:     ing.Quantity,
+			Removable:    ing.IsRemovable,
+		})
+	}
+	for _, t := range p.Toppings() {
+		resp.Toppings = append(resp.Toppings, &catalog_pb.ProductToppingRule{ToppingId: t.ToppingID, MaxCount: int32(t.MaxCount)}) // #nosec G115
+	}
+	for _, s := range p.Sizes() {
+		resp.Sizes = append(resp.Sizes, &catalog_pb.ProductOption{Code: s.Code, Name: s.Name, Multiplier: s.Multiplier})
+	}
+	for _, c := range p.Crusts() {
+		resp.Crusts = append(resp.Crusts, &catalog_pb.ProductOption{Code: c.Code, Name: c.Name, Multiplier: c.Multiplier})
+	}
+	return resp
+}
+
+func toDomainOptions(sizes, crusts []*catalog_pb.ProductOption, halvesAllowed bool) usecase.ProductOptions {
+	opts := usecase.ProductOptions{HalvesAllowed: halvesAllowed}
+	for _, s := range sizes {
+		opts.Sizes = append(opts.Sizes, catalog.SizeOption{Code: s.Code, Name: s.Name, Multiplier: s.Multiplier})
+	}
+	for _, c := range crusts {
+		opts.Crusts = append(opts.Crusts, catalog.CrustOption{Code: c.Code, Name: c.Name, Multiplier: c.Multiplier})
+	}
+	return opts
+}
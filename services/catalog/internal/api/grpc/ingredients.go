@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/catalog"
+	catalog_pb "github.com/versoit/diploma/services/catalog/api/proto/pb"
+	"github.com/versoit/diploma/services/catalog/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *CatalogHandler) CreateIngredient(ctx context.Context, req *catalog_pb.CreateIngredientRequest) (*catalog_pb.IngredientResponse, error) {
+	ing, err := h.uc.CreateIngredient(ctx, usecase.IngredientInput{Name: req.Name, Unit: req.Unit, Cost: common.NewMoney(req.Cost)})
+	if err != nil {
+		return nil, ingredientStatus(err)
+	}
+	return toIngredientResponse(ing), nil
+}
+
+func (h *CatalogHandler) GetIngredient(ctx context.Context, req *catalog_pb.GetIngredientRequest) (*catalog_pb.IngredientResponse, error) {
+	ing, err := h.uc.GetIngredient(ctx, req.Id)
+	if err != nil {
+		return nil, ingredientStatus(err)
+	}
+	return toIngredientResponse(ing), nil
+}
+
+func (h *CatalogHandler) ListIngredients(ctx context.Context, _ *catalog_pb.ListIngredientsRequest) (*catalog_pb.ListIngredientsResponse, error) {
+	list, err := h.uc.ListIngredients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &catalog_pb.ListIngredientsResponse{}
+	for _, ing := range list {
+		resp.Ingredients = append(resp.Ingredients, toIngredientResponse(ing))
+	}
+	return resp, nil
+}
+
+func (h *CatalogHandler) UpdateIngredient(ctx context.Context, req *catalog_pb.UpdateIngredientRequest) (*catalog_pb.IngredientResponse, error) {
+	ing, err := h.uc.UpdateIngredient(ctx, req.Id, usecase.IngredientInput{Name: req.Name, Unit: req.Unit, Cost: common.NewMoney(req.Cost)})
+	if err != nil {
+		return nil, ingredientStatus(err)
+	}
+	return toIngredientResponse(ing), nil
+}
+
+func (h *CatalogHandler) DeleteIngredient(ctx context.Context, req *catalog_pb.DeleteIngredientRequest) (*catalog_pb.DeleteIngredientResponse, error) {
+	if err := h.uc.DeleteIngredient(ctx, req.Id); err != nil {
+		return nil, ingredientStatus(err)
+	}
+	return &catalog_pb.DeleteIngredientResponse{}, nil
+}
+
+func (h *CatalogHandler) SetIngredientStock(ctx context.Context, req *catalog_pb.SetIngredientStockRequest) (*catalog_pb.SetIngredientStockResponse, error) {
+	update, err := h.uc.SetIngredientStock(ctx, req.Id, req.InStock)
+	if err != nil {
+		return nil, ingredientStatus(err)
+	}
+
+	resp := &catalog_pb.SetIngredientStockResponse{Ingredient: toIngredientResponse(update.Ingredient)}
+	for _, p := range update.Products {
+		resp.Products = append(resp.Products, toProductResponse(p))
+	}
+	return resp, nil
+}
+
+// ingredientStatus - ошибки операций со справочником ингредиентов как статусы gRPC.
+func ingredientStatus(err error) error {
+	switch {
+	case errors.Is(err, catalog.ErrIngredientNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, catalog.ErrIngredientInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, catalog.ErrInvalidIngredient), errors.Is(err, usecase.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+func toIngredientResponse(ing *catalog.Ingredient) *catalog_pb.IngredientResponse {
+	return &catalog_pb.IngredientResponse{
+		Id:      ing.ID,
+		Name:    ing.Name,
+		Unit:    ing.Unit,
+		Cost:    ing.Cost.InexactFloat64(),
+		InStock: ing.InStock,
+	}
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/services/catalog"
+)
+
+var errMalformedPageToken = errors.New("malformed page token")
+
+// pageToken - курсор выдачи вместе с сортировкой, чтобы токен нельзя было применить к другому порядку.
+type pageToken struct {
+	Sort   catalog.ProductSort   `json:"sort"`
+	Cursor catalog.ProductCursor `json:"cursor"`
+}
+
+func encodePageToken(cursor catalog.ProductCursor, sort catalog.ProductSort) (string, error) {
+	raw, err := json.Marshal(pageToken{Sort: sort, Cursor: cursor})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodePageToken(token string, sort catalog.ProductSort) (*catalog.ProductCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errMalformedPageToken
+	}
+	var t pageToken
+	if err := json.Unmarshal(raw, &t); err != nil || t.Cursor.ID == "" {
+		return nil, errMalformedPageToken
+	}
+	if t.Sort != sort {
+		return nil, fmt.Errorf("%w: issued for sort %q", errMalformedPageToken, t.Sort)
+	}
+	return &t.Cursor, nil
+}
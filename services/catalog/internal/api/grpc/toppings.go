@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/catalog"
+	catalog_pb "github.com/versoit/diploma/services/catalog/api/proto/pb"
+	"github.com/versoit/diploma/services/catalog/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *CatalogHandler) CreateTopping(ctx context.Context, req *catalog_pb.CreateToppingRequest) (*catalog_pb.ToppingResponse, error) {
+	t, err := h.uc.CreateTopping(ctx, usecase.ToppingInput{
+		Name:         req.Name,
+		IngredientID: req.IngredientId,
+		Quantity:     req.Quantity,
+		Price:        common.NewMoney(req.Price),
+		SizePrices:   toDomainSizePrices(req.SizePrices),
+	})
+	if err != nil {
+		return nil, toppingStatus(err)
+	}
+	return toToppingResponse(t), nil
+}
+
+func (h *CatalogHandler) UpdateTopping(ctx context.Context, req *catalog_pb.UpdateToppingRequest) (*catalog_pb.ToppingResponse, error) {
+	t, err := h.uc.UpdateTopping(ctx, req.Id, usecase.ToppingInput{
+		Name:         req.Name,
+		IngredientID: req.IngredientId,
+		Quantity:     req.Quantity,
+		Price:        common.NewMoney(req.Price),
+		SizePrices:   toDomainSizePrices(req.SizePrices),
+	})
+	if err != nil {
+		return nil, toppingStatus(err)
+	}
+	return toToppingResponse(t), nil
+}
+
+func (h *CatalogHandler) ListToppings(ctx context.Context, _ *catalog_pb.ListToppingsRequest) (*catalog_pb.ListToppingsResponse, error) {
+	list, err := h.uc.ListToppings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &catalog_pb.ListToppingsResponse{}
+	for _, t := range list {
+		resp.Toppings = append(resp.Toppings, toToppingResponse(t))
+	}
+	return resp, nil
+}
+
+func (h *CatalogHandler) DeleteTopping(ctx context.Context, req *catalog_pb.DeleteToppingRequest) (*catalog_pb.DeleteToppingResponse, error) {
+	if err := h.uc.DeleteTopping(ctx, req.Id); err != nil {
+		return nil, toppingStatus(err)
+	}
+	return &catalog_pb.DeleteToppingResponse{}, nil
+}
+
+func (h *CatalogHandler) SetProductToppings(ctx context.Context, req *catalog_pb.SetProductToppingsRequest) (*catalog_pb.ProductResponse, error) {
+	rules := make([]catalog.ToppingRule, 0, len(req.Toppings))
+	for _, r := range req.Toppings {
+		rules = append(rules, catalog.ToppingRule{ToppingID: r.ToppingId, MaxCount: int(r.MaxCount)})
+	}
+
+	p, err := h.uc.SetProductToppings(ctx, req.ProductId, rules, int(req.MaxToppings))
+	if err != nil {
+		return nil, toppingStatus(err)
+	}
+	return toProductResponse(p), nil
+}
+
+func (h *CatalogHandler) ListProductToppings(ctx context.Context, req *catalog_pb.ListProductToppingsRequest) (*catalog_pb.ListProductToppingsResponse, error) {
+	menu, err := h.uc.ListProductToppings(ctx, req.ProductId)
+	if err != nil {
+		return nil, toppingStatus(err)
+	}
+
+	resp := &catalog_pb.ListProductToppingsResponse{MaxToppings: int32(menu.MaxToppings)} // #nosec G115
+	for _, pt := range menu.Toppings {
+		resp.Toppings = append(resp.Toppings, &catalog_pb.ProductTopping{
+			ToppingId:  pt.Topping.ID,
+			Name:       pt.Topping.Name,
+			Price:      pt.Topping.Price.InexactFloat64(),
+			SizePrices: toProtoSizePrices(pt.Topping.SizePrices),
+			MaxCount:   int32(pt.MaxCount), // #nosec G115
+		})
+	}
+	return resp, nil
+}
+
+// toppingStatus - ошибки операций с топпингами как статусы gRPC.
+func toppingStatus(err error) error {
+	switch {
+	case errors.Is(err, catalog.ErrToppingNotFound), errors.Is(err, catalog.ErrProductNotFound),
+		errors.Is(err, catalog.ErrIngredientNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, catalog.ErrToppingInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, catalog.ErrInvalidTopping), errors.Is(err, usecase.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
+
+func toToppingResponse(t *catalog.Topping) *catalog_pb.ToppingResponse {
+	return &catalog_pb.ToppingResponse{
+		Id:           t.ID,
+		Name:         t.Name,
+		IngredientId: t.IngredientID,
+		Quantity:     t.Quantity,
+		Price:        t.Price.InexactFloat64(),
+		SizePrices:   toProtoSizePrices(t.SizePrices),
+	}
+}
+
+func toDomainSizePrices(prices []*catalog_pb.ToppingSizePrice) map[string]common.Money {
+	if len(prices) == 0 {
+		return nil
+	}
+	result := make(map[string]common.Money, len(prices))
+	for _, p := range prices {
+		result[p.SizeCode] = common.NewMoney(p.Price)
+	}
+	return result
+}
+
+// toProtoSizePrices - по коду размера, чтобы ответ не зависел от порядка обхода map.
+func toProtoSizePrices(prices map[string]common.Money) []*catalog_pb.ToppingSizePrice {
+	result := make([]*catalog_pb.ToppingSizePrice, 0, len(prices))
+	for size, price := range prices {
+		result = append(result, &catalog_pb.ToppingSizePrice{SizeCode: size, Price: price.InexactFloat64()})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SizeCode < result[j].SizeCode })
+	return result
+}
@@ -1,7 +1,11 @@
 package app
 
 import (
+	"context"
+
+	"github.com/versoit/diploma/services/catalog"
 	"github.com/versoit/diploma/services/catalog/internal/api/grpc"
+	"github.com/versoit/diploma/services/catalog/internal/config"
 	"github.com/versoit/diploma/services/catalog/internal/repository"
 	"github.com/versoit/diploma/services/catalog/usecase"
 	"go.uber.org/fx"
@@ -9,8 +13,54 @@ import (
 
 var Module = fx.Options(
 	fx.Provide(
-		repository.NewInMemoryProductRepository,
+		config.Load,
+		NewRepositories,
+		NewMarginPolicy,
 		usecase.NewCatalogUseCase,
 		grpc.NewCatalogHandler,
 	),
 )
+
+// Repositories - хранилища сервиса, все на одном бэкенде.
+type Repositories struct {
+	fx.Out
+
+	Products    catalog.ProductRepository
+	Ingredients catalog.IngredientRepository
+	Toppings    catalog.ToppingRepository
+}
+
+// NewRepositories - выбирает реализацию хранилища по конфигурации.
+func NewRepositories(lc fx.Lifecycle, cfg config.Config) (Repositories, error) {
+	if cfg.Storage != config.StoragePostgres {
+		return Repositories{
+			Products:    repository.NewInMemoryProductRepository(),
+			Ingredients: repository.NewInMemoryIngredientRepository(),
+			Toppings:    repository.NewInMemoryToppingRepository(),
+		}, nil
+	}
+
+	db, err := repository.OpenPostgres(context.Background(), cfg.DatabaseDSN)
+	if err != nil {
+		return Repositories{}, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return db.Close()
+		},
+	})
+
+	return Repositories{
+		Products:    repository.NewPostgresProductRepository(db),
+		Ingredients: repository.NewPostgresIngredientRepository(db),
+		Toppings:    repository.NewPostgresToppingRepository(db),
+	}, nil
+}
+
+func NewMarginPolicy(cfg config.Config) (*catalog.MarginPolicy, error) {
+	policy := &catalog.MarginPolicy{TargetPercent: cfg.TargetMargin}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
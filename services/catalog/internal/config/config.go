@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+type StorageType string
+
+const (
+	StorageMemory   StorageType = "memory"
+	StoragePostgres StorageType = "postgres"
+)
+
+// Config - настройки сервиса каталога, читаются из переменных окружения.
+type Config struct {
+	Storage     StorageType
+	DatabaseDSN string
+	// TargetMargin - целевая маржа товаров в процентах от цены.
+	TargetMargin float64
+}
+
+func Load() (Config, error) {
+	cfg := Config{
+		Storage:     StorageType(getEnv("CATALOG_STORAGE", string(StorageMemory))),
+		DatabaseDSN: os.Getenv("CATALOG_DATABASE_DSN"),
+	}
+
+	var err error
+	if cfg.TargetMargin, err = strconv.ParseFloat(getEnv("CATALOG_TARGET_MARGIN", "70"), 64); err != nil {
+		return Config{}, fmt.Errorf("invalid CATALOG_TARGET_MARGIN: %w", err)
+	}
+
+	switch cfg.Storage {
+	case StorageMemory:
+	case StoragePostgres:
+		if cfg.DatabaseDSN == "" {
+			return Config{}, fmt.Errorf("CATALOG_DATABASE_DSN is required for %s storage", cfg.Storage)
+		}
+	default:
+		return Config{}, fmt.Errorf("unknown storage type %q", cfg.Storage)
+	}
+
+	return cfg, nil
+}
+
+func getEnv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/versoit/diploma/services/catalog"
+)
+
+type InMemoryIngredientRepository struct {
+	mu    sync.RWMutex
+	store map[string]catalog.Ingredient
+}
+
+func NewInMemoryIngredientRepository() catalog.IngredientRepository {
+	return &InMemoryIngredientRepository{
+		store: make(map[string]catalog.Ingredient),
+	}
+}
+
+// Save - хранится копия, чтобы изменения вызывающего не попадали в хранилище без сохранения.
+func (r *InMemoryIngredientRepository) Save(ctx context.Context, ing *catalog.Ingredient) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.store[ing.ID] = *ing
+	return nil
+}
+
+func (r *InMemoryIngredientRepository) FindByID(ctx context.Context, id string) (*catalog.Ingredient, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ing, ok := r.store[id]
+	if !ok {
+		return nil, catalog.ErrIngredientNotFound
+	}
+	return &ing, nil
+}
+
+func (r *InMemoryIngredientRepository) FindAll(ctx context.Context) ([]*catalog.Ingredient, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]*catalog.Ingredient, 0, len(r.store))
+	for _, ing := range r.store {
+		list = append(list, &ing)
+	}
+	return list, nil
+}
+
+func (r *InMemoryIngredientRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.store[id]; !ok {
+		return catalog.ErrIngredientNotFound
+	}
+	delete(r.store, id)
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/versoit/diploma/services/catalog"
+)
+
+type PostgresIngredientRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresIngredientRepository(db *sql.DB) catalog.IngredientRepository {
+	return &PostgresIngredientRepository{db: db}
+}
+
+func (r *PostgresIngredientRepository) Save(ctx context.Context, ing *catalog.Ingredient) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO ingredients (id, name, cost, unit, in_stock)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			cost = EXCLUDED.cost,
+			unit = EXCLUDED.unit,
+			in_stock = EXCLUDED.in_stock`,
+		ing.ID, ing.Name, ing.Cost, ing.Unit, ing.InStock,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save ingredient %s: %w", ing.ID, err)
+	}
+	return nil
+}
+
+func (r *PostgresIngredientRepository) FindByID(ctx context.Context, id string) (*catalog.Ingredient, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, catalog.ErrIngredientNotFound
+	}
+
+	ing, err := scanIngredient(r.db.QueryRowContext(ctx, `SELECT `+ingredientColumns+` FROM ingredients WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, catalog.ErrIngredientNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ingredient %s: %w", id, err)
+	}
+	return ing, nil
+}
+
+func (r *PostgresIngredientRepository) FindAll(ctx context.Context) ([]*catalog.Ingredient, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+ingredientColumns+` FROM ingredients ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingredients: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]*catalog.Ingredient, 0)
+	for rows.Next() {
+		ing, err := scanIngredient(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ingredient: %w", err)
+		}
+		list = append(list, ing)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list ingredients: %w", err)
+	}
+	return list, nil
+}
+
+func (r *PostgresIngredientRepository) Delete(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return catalog.ErrIngredientNotFound
+	}
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM ingredients WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete ingredient %s: %w", id, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete ingredient %s: %w", id, err)
+	}
+	if n == 0 {
+		return catalog.ErrIngredientNotFound
+	}
+	return nil
+}
+
+const ingredientColumns = `id, name, cost, unit, in_stock`
+
+func scanIngredient(row rowScanner) (*catalog.Ingredient, error) {
+	var ing catalog.Ingredient
+	if err := row.Scan(&ing.ID, &ing.Name, &ing.Cost, &ing.Unit, &ing.InStock); err != nil {
+		return nil, err
+	}
+	return &ing, nil
+}
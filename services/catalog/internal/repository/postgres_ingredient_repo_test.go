@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/catalog"
+)
+
+func newTestIngredient(t *testing.T, repo catalog.IngredientRepository, name string) *catalog.Ingredient {
+	t.Helper()
+
+	ing := &catalog.Ingredient{ID: uuid.NewString(), Name: name, Cost: common.NewMoney(12.5), Unit: "g", InStock: true}
+	if err := repo.Save(context.Background(), ing); err != nil {
+		t.Fatalf("failed to save ingredient: %v", err)
+	}
+	return ing
+}
+
+func TestPostgresIngredientRepository_SaveAndFind(t *testing.T) {
+	repo := NewPostgresIngredientRepository(openTestDB(t))
+	ctx := context.Background()
+
+	mozzarella := newTestIngredient(t, repo, "Mozzarella")
+	basil := newTestIngredient(t, repo, "Basil")
+
+	mozzarella.InStock = false
+	mozzarella.Cost = common.NewMoney(15)
+	if err := repo.Save(ctx, mozzarella); err != nil {
+		t.Fatalf("failed to update ingredient: %v", err)
+	}
+
+	loaded, err := repo.FindByID(ctx, mozzarella.ID)
+	if err != nil {
+		t.Fatalf("failed to find: %v", err)
+	}
+	if loaded.InStock || !loaded.Cost.Equal(common.NewMoney(15)) || loaded.Unit != "g" {
+		t.Errorf("unexpected ingredient: %+v", loaded)
+	}
+
+	all, err := repo.FindAll(ctx)
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(all) != 2 || all[0].ID != basil.ID || all[1].ID != mozzarella.ID {
+		t.Errorf("expected ingredients ordered by name, got %+v", all)
+	}
+
+	for _, id := range []string{uuid.NewString(), "not-a-uuid"} {
+		if _, err := repo.FindByID(ctx, id); !errors.Is(err, catalog.ErrIngredientNotFound) {
+			t.Errorf("expected ErrIngredientNotFound for %q, got %v", id, err)
+		}
+	}
+}
+
+func TestPostgresIngredientRepository_Delete(t *testing.T) {
+	repo := NewPostgresIngredientRepository(openTestDB(t))
+	ctx := context.Background()
+
+	ing := newTestIngredient(t, repo, "Basil")
+	if err := repo.Delete(ctx, ing.ID); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if _, err := repo.FindByID(ctx, ing.ID); !errors.Is(err, catalog.ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound, got %v", err)
+	}
+	if err := repo.Delete(ctx, ing.ID); !errors.Is(err, catalog.ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound on second delete, got %v", err)
+	}
+}
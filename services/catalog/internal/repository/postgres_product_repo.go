@@ -0,0 +1,412 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/versoit/diploma/services/catalog"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+const (
+	optionKindSize  = "size"
+	optionKindCrust = "crust"
+
+	// pgForeignKeyViolation - состав ссылается на ингредиент, которого нет в справочнике.
+	pgForeignKeyViolation = "23503"
+)
+
+type PostgresProductRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresProductRepository(db *sql.DB) catalog.ProductRepository {
+	return &PostgresProductRepository{db: db}
+}
+
+// OpenPostgres - открывает пул соединений и проверяет доступность базы.
+func OpenPostgres(ctx context.Context, dsn string) (*sql.DB, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	return db, nil
+}
+
+func (r *PostgresProductRepository) Save(ctx context.Context, p *catalog.Product) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Ручной признак доступности, наличие ингредиентов читается из справочника
+	s := p.Snapshot()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO products (id, name, description, category, base_price, image_url, is_available, created_at, halves_allowed, max_toppings)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			category = EXCLUDED.category,
+			base_price = EXCLUDED.base_price,
+			image_url = EXCLUDED.image_url,
+			is_available = EXCLUDED.is_available,
+			halves_allowed = EXCLUDED.halves_allowed,
+			max_toppings = EXCLUDED.max_toppings`,
+		s.ID, s.Name, nullString(s.Description), int(s.Category), s.BasePrice,
+		nullString(s.ImageURL), s.IsAvailable, s.CreatedAt, s.HalvesAllowed, s.MaxToppings,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save product %s: %w", p.ID(), err)
+	}
+
+	// Состав и опции перезаписываются целиком
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_ingredients WHERE product_id = $1`, p.ID()); err != nil {
+		return fmt.Errorf("failed to clear ingredients of product %s: %w", p.ID(), err)
+	}
+	for i, ref := range s.Ingredients {
+		if _, err := uuid.Parse(ref.IngredientID); err != nil {
+			return fmt.Errorf("%w: %s", catalog.ErrIngredientNotFound, ref.IngredientID)
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO product_ingredients (product_id, ingredient_id, quantity, is_removable, position)
+			VALUES ($1, $2, $3, $4, $5)`,
+			p.ID(), ref.IngredientID, ref.Quantity, ref.IsRemovable, i,
+		)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return fmt.Errorf("%w: %s", catalog.ErrIngredientNotFound, ref.IngredientID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to insert ingredient %s: %w", ref.IngredientID, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_options WHERE product_id = $1`, p.ID()); err != nil {
+		return fmt.Errorf("failed to clear options of product %s: %w", p.ID(), err)
+	}
+	for i, size := range s.Sizes {
+		if err := insertOption(ctx, tx, p.ID(), optionKindSize, i, size.Code, size.Name, size.Multiplier); err != nil {
+			return err
+		}
+	}
+	for i, c := range s.Crusts {
+		if err := insertOption(ctx, tx, p.ID(), optionKindCrust, i, c.Code, c.Name, c.Multiplier); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_toppings WHERE product_id = $1`, p.ID()); err != nil {
+		return fmt.Errorf("failed to clear toppings of product %s: %w", p.ID(), err)
+	}
+	for i, rule := range s.Toppings {
+		if _, err := uuid.Parse(rule.ToppingID); err != nil {
+			return fmt.Errorf("%w: %s", catalog.ErrToppingNotFound, rule.ToppingID)
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO product_toppings (product_id, topping_id, max_count, position)
+			VALUES ($1, $2, $3, $4)`,
+			p.ID(), rule.ToppingID, rule.MaxCount, i,
+		)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return fmt.Errorf("%w: %s", catalog.ErrToppingNotFound, rule.ToppingID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to insert topping %s: %w", rule.ToppingID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product %s: %w", p.ID(), err)
+	}
+	return nil
+}
+
+func insertOption(ctx context.Context, tx *sql.Tx, productID, kind string, position int, code, name string, multiplier float64) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO product_options (product_id, kind, code, name, multiplier, position)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		productID, kind, code, name, multiplier, position,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert %s option %s: %w", kind, code, err)
+	}
+	return nil
+}
+
+func (r *PostgresProductRepository) FindByID(ctx context.Context, id string) (*catalog.Product, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, catalog.ErrProductNotFound
+	}
+
+	s, err := scanProduct(r.db.QueryRowContext(ctx, `SELECT `+productColumns+` FROM products WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, catalog.ErrProductNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load product %s: %w", id, err)
+	}
+
+	if err := r.loadDetails(ctx, &s); err != nil {
+		return nil, err
+	}
+	return catalog.RestoreProduct(s), nil
+}
+
+func (r *PostgresProductRepository) FindAll(ctx context.Context) ([]*catalog.Product, error) {
+	return r.query(ctx, `SELECT `+productColumns+` FROM products ORDER BY created_at, id`)
+}
+
+// List - порядок и условие курсора повторяют catalog.ProductSort.Compare: название сравнивается
+// в нижнем регистре побайтно (COLLATE "C"), при равном ключе товары упорядочены по id.
+func (r *PostgresProductRepository) List(ctx context.Context, filter catalog.ProductFilter) ([]*catalog.Product, error) {
+	if filter.After != nil {
+		if _, err := uuid.Parse(filter.After.ID); err != nil {
+			return []*catalog.Product{}, nil
+		}
+	}
+
+	conds := make([]string, 0, 4)
+	args := make([]any, 0, 6)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.AvailableOnly {
+		conds = append(conds, `is_available AND NOT EXISTS (
+			SELECT 1 FROM product_ingredients pi JOIN ingredients i ON i.id = pi.ingredient_id
+			WHERE pi.product_id = products.id AND NOT pi.is_removable AND NOT i.in_stock)`)
+	}
+	if filter.Ingredient != "" {
+		if _, err := uuid.Parse(filter.Ingredient); err != nil {
+			return []*catalog.Product{}, nil
+		}
+		conds = append(conds, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM product_ingredients WHERE product_id = products.id AND ingredient_id = %s)", arg(filter.Ingredient)))
+	}
+	if filter.Topping != "" {
+		if _, err := uuid.Parse(filter.Topping); err != nil {
+			return []*catalog.Product{}, nil
+		}
+		conds = append(conds, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM product_toppings WHERE product_id = products.id AND topping_id = %s)", arg(filter.Topping)))
+	}
+	if len(filter.Categories) > 0 {
+		placeholders := make([]string, 0, len(filter.Categories))
+		for _, c := range filter.Categories {
+			placeholders = append(placeholders, arg(int(c)))
+		}
+		conds = append(conds, "category IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if q := strings.TrimSpace(filter.Query); q != "" {
+		pattern := arg("%" + escapeLike(strings.ToLower(q)) + "%")
+		conds = append(conds, fmt.Sprintf("(lower(name) LIKE %[1]s OR lower(coalesce(description, '')) LIKE %[1]s)", pattern))
+	}
+
+	var order string
+	switch filter.Sort {
+	case catalog.SortByPriceAsc:
+		order = "base_price, id"
+		if c := filter.After; c != nil {
+			conds = append(conds, fmt.Sprintf("(base_price, id) > (%s, %s)", arg(c.Price), arg(c.ID)))
+		}
+	case catalog.SortByPriceDesc:
+		order = "base_price DESC, id"
+		if c := filter.After; c != nil {
+			price, id := arg(c.Price), arg(c.ID)
+			conds = append(conds, fmt.Sprintf("(base_price < %[1]s OR (base_price = %[1]s AND id > %[2]s))", price, id))
+		}
+	case catalog.SortByNewest:
+		order = "created_at DESC, id"
+		if c := filter.After; c != nil {
+			at, id := arg(c.CreatedAt), arg(c.ID)
+			conds = append(conds, fmt.Sprintf("(created_at < %[1]s OR (created_at = %[1]s AND id > %[2]s))", at, id))
+		}
+	default:
+		order = `lower(name) COLLATE "C", id`
+		if c := filter.After; c != nil {
+			conds = append(conds, fmt.Sprintf(`(lower(name) COLLATE "C", id) > (lower(%s) COLLATE "C", %s)`, arg(c.Name), arg(c.ID)))
+		}
+	}
+
+	query := `SELECT ` + productColumns + ` FROM products`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY ` + order
+	if filter.Limit > 0 {
+		query += ` LIMIT ` + arg(filter.Limit)
+	}
+	return r.query(ctx, query, args...)
+}
+
+func (r *PostgresProductRepository) query(ctx context.Context, query string, args ...any) ([]*catalog.Product, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list products: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	snapshots := make([]catalog.ProductSnapshot, 0)
+	for rows.Next() {
+		s, err := scanProduct(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan product: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list products: %w", err)
+	}
+	_ = rows.Close()
+
+	list := make([]*catalog.Product, 0, len(snapshots))
+	for i := range snapshots {
+		if err := r.loadDetails(ctx, &snapshots[i]); err != nil {
+			return nil, err
+		}
+		list = append(list, catalog.RestoreProduct(snapshots[i]))
+	}
+	return list, nil
+}
+
+const productColumns = `id, name, description, category, base_price, image_url, is_available, created_at, halves_allowed, max_toppings`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanProduct(row rowScanner) (catalog.ProductSnapshot, error) {
+	var (
+		s                  catalog.ProductSnapshot
+		category           int
+		description, image sql.NullString
+	)
+	if err := row.Scan(
+		&s.ID, &s.Name, &description, &category, &s.BasePrice, &image, &s.IsAvailable, &s.CreatedAt, &s.HalvesAllowed, &s.MaxToppings,
+	); err != nil {
+		return catalog.ProductSnapshot{}, err
+	}
+	s.Category = catalog.CategoryType(category)
+	s.Description = description.String
+	s.ImageURL = image.String
+	return s, nil
+}
+
+// loadDetails - состав, опции и топпинги товара.
+func (r *PostgresProductRepository) loadDetails(ctx context.Context, s *catalog.ProductSnapshot) error {
+	var err error
+	if s.Ingredients, s.OutOfStock, err = r.loadIngredients(ctx, s.ID); err != nil {
+		return err
+	}
+	if s.Toppings, err = r.loadToppings(ctx, s.ID); err != nil {
+		return err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT kind, code, name, multiplier
+		FROM product_options
+		WHERE product_id = $1
+		ORDER BY kind, position`, s.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load options of product %s: %w", s.ID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var kind, code, name string
+		var multiplier float64
+		if err := rows.Scan(&kind, &code, &name, &multiplier); err != nil {
+			return fmt.Errorf("failed to scan option of product %s: %w", s.ID, err)
+		}
+		switch kind {
+		case optionKindSize:
+			s.Sizes = append(s.Sizes, catalog.SizeOption{Code: code, Name: name, Multiplier: multiplier})
+		case optionKindCrust:
+			s.Crusts = append(s.Crusts, catalog.CrustOption{Code: code, Name: name, Multiplier: multiplier})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to load options of product %s: %w", s.ID, err)
+	}
+	return nil
+}
+
+// loadIngredients - состав товара и ингредиенты из него, которых нет на складе.
+func (r *PostgresProductRepository) loadIngredients(ctx context.Context, productID string) ([]catalog.IngredientRef, []string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT pi.ingredient_id, pi.quantity, pi.is_removable, i.in_stock
+		FROM product_ingredients pi
+		JOIN ingredients i ON i.id = pi.ingredient_id
+		WHERE pi.product_id = $1
+		ORDER BY pi.position`, productID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load ingredients of product %s: %w", productID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	refs := make([]catalog.IngredientRef, 0)
+	var outOfStock []string
+	for rows.Next() {
+		var ref catalog.IngredientRef
+		var inStock bool
+		if err := rows.Scan(&ref.IngredientID, &ref.Quantity, &ref.IsRemovable, &inStock); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan ingredient of product %s: %w", productID, err)
+		}
+		refs = append(refs, ref)
+		if !inStock {
+			outOfStock = append(outOfStock, ref.IngredientID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to load ingredients of product %s: %w", productID, err)
+	}
+	return refs, outOfStock, nil
+}
+
+func (r *PostgresProductRepository) loadToppings(ctx context.Context, productID string) ([]catalog.ToppingRule, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT topping_id, max_count
+		FROM product_toppings
+		WHERE product_id = $1
+		ORDER BY position`, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load toppings of product %s: %w", productID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var rules []catalog.ToppingRule
+	for rows.Next() {
+		var rule catalog.ToppingRule
+		if err := rows.Scan(&rule.ToppingID, &rule.MaxCount); err != nil {
+			return nil, fmt.Errorf("failed to scan topping of product %s: %w", productID, err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load toppings of product %s: %w", productID, err)
+	}
+	return rules, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// escapeLike - экранирует спецсимволы LIKE, чтобы запрос искал подстроку буквально.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
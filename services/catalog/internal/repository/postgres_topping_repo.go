@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/catalog"
+)
+
+type PostgresToppingRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresToppingRepository(db *sql.DB) catalog.ToppingRepository {
+	return &PostgresToppingRepository{db: db}
+}
+
+func (r *PostgresToppingRepository) Save(ctx context.Context, t *catalog.Topping) error {
+	if _, err := uuid.Parse(t.IngredientID); err != nil {
+		return fmt.Errorf("%w: %s", catalog.ErrIngredientNotFound, t.IngredientID)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO toppings (id, name, ingredient_id, quantity, price)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			ingredient_id = EXCLUDED.ingredient_id,
+			quantity = EXCLUDED.quantity,
+			price = EXCLUDED.price`,
+		t.ID, t.Name, t.IngredientID, t.Quantity, t.Price,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+		return fmt.Errorf("%w: %s", catalog.ErrIngredientNotFound, t.IngredientID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save topping %s: %w", t.ID, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM topping_prices WHERE topping_id = $1`, t.ID); err != nil {
+		return fmt.Errorf("failed to clear prices of topping %s: %w", t.ID, err)
+	}
+	for size, price := range t.SizePrices {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO topping_prices (topping_id, size_code, price)
+			VALUES ($1, $2, $3)`,
+			t.ID, size, price,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert price of topping %s for size %s: %w", t.ID, size, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit topping %s: %w", t.ID, err)
+	}
+	return nil
+}
+
+func (r *PostgresToppingRepository) FindByID(ctx context.Context, id string) (*catalog.Topping, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, catalog.ErrToppingNotFound
+	}
+
+	var t catalog.Topping
+	err := r.db.QueryRowContext(ctx, `SELECT `+toppingColumns+` FROM toppings WHERE id = $1`, id).
+		Scan(&t.ID, &t.Name, &t.IngredientID, &t.Quantity, &t.Price)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, catalog.ErrToppingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load topping %s: %w", id, err)
+	}
+
+	if t.SizePrices, err = r.loadPrices(ctx, id); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *PostgresToppingRepository) FindAll(ctx context.Context) ([]*catalog.Topping, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+toppingColumns+` FROM toppings ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list toppings: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	list := make([]*catalog.Topping, 0)
+	for rows.Next() {
+		var t catalog.Topping
+		if err := rows.Scan(&t.ID, &t.Name, &t.IngredientID, &t.Quantity, &t.Price); err != nil {
+			return nil, fmt.Errorf("failed to scan topping: %w", err)
+		}
+		list = append(list, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list toppings: %w", err)
+	}
+	_ = rows.Close()
+
+	for _, t := range list {
+		if t.SizePrices, err = r.loadPrices(ctx, t.ID); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (r *PostgresToppingRepository) Delete(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return catalog.ErrToppingNotFound
+	}
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM toppings WHERE id = $1`, id)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+		return fmt.Errorf("%w: %s", catalog.ErrToppingInUse, id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete topping %s: %w", id, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete topping %s: %w", id, err)
+	}
+	if n == 0 {
+		return catalog.ErrToppingNotFound
+	}
+	return nil
+}
+
+const toppingColumns = `id, name, ingredient_id, quantity, price`
+
+func (r *PostgresToppingRepository) loadPrices(ctx context.Context, toppingID string) (map[string]common.Money, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT size_code, price FROM topping_prices WHERE topping_id = $1`, toppingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load prices of topping %s: %w", toppingID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var prices map[string]common.Money
+	for rows.Next() {
+		var size string
+		var price common.Money
+		if err := rows.Scan(&size, &price); err != nil {
+			return nil, fmt.Errorf("failed to scan price of topping %s: %w", toppingID, err)
+		}
+		if prices == nil {
+			prices = make(map[string]common.Money)
+		}
+		prices[size] = price
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load prices of topping %s: %w", toppingID, err)
+	}
+	return prices, nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/catalog"
+)
+
+func TestPostgresToppingRepository_SaveAndFind(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewPostgresToppingRepository(db)
+	ctx := context.Background()
+
+	cheese := newTestIngredient(t, NewPostgresIngredientRepository(db), "Cheese")
+	extra, _ := catalog.NewTopping("Extra cheese", cheese.ID, 30, common.NewMoney(60), map[string]common.Money{
+		"25": common.NewMoney(50),
+		"35": common.NewMoney(90),
+	})
+	if err := repo.Save(ctx, extra); err != nil {
+		t.Fatalf("failed to save: %v", err)
+	}
+
+	extra.SizePrices = map[string]common.Money{"35": common.NewMoney(95)}
+	if err := repo.Save(ctx, extra); err != nil {
+		t.Fatalf("failed to update: %v", err)
+	}
+
+	loaded, err := repo.FindByID(ctx, extra.ID)
+	if err != nil {
+		t.Fatalf("failed to find: %v", err)
+	}
+	if loaded.Name != "Extra cheese" || loaded.IngredientID != cheese.ID || loaded.Quantity != 30 || len(loaded.SizePrices) != 1 ||
+		!loaded.PriceFor("35").Equal(common.NewMoney(95)) || !loaded.PriceFor("25").Equal(common.NewMoney(60)) {
+		t.Errorf("unexpected topping: %+v", loaded)
+	}
+
+	all, err := repo.FindAll(ctx)
+	if err != nil || len(all) != 1 || all[0].ID != extra.ID {
+		t.Errorf("unexpected list: %+v, %v", all, err)
+	}
+
+	ghost, _ := catalog.NewTopping("Ghost", uuid.NewString(), 1, common.NewMoney(1), nil)
+	if err := repo.Save(ctx, ghost); !errors.Is(err, catalog.ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound, got %v", err)
+	}
+	for _, id := range []string{uuid.NewString(), "not-a-uuid"} {
+		if _, err := repo.FindByID(ctx, id); !errors.Is(err, catalog.ErrToppingNotFound) {
+			t.Errorf("expected ErrToppingNotFound for %q, got %v", id, err)
+		}
+	}
+}
+
+func TestPostgresToppingRepository_ProductToppings(t *testing.T) {
+	db := openTestDB(t)
+	toppings := NewPostgresToppingRepository(db)
+	products := NewPostgresProductRepository(db)
+	ctx := context.Background()
+
+	cheese := newTestIngredient(t, NewPostgresIngredientRepository(db), "Cheese")
+	extra, _ := catalog.NewTopping("Extra cheese", cheese.ID, 30, common.NewMoney(60), nil)
+	if err := toppings.Save(ctx, extra); err != nil {
+		t.Fatalf("failed to save topping: %v", err)
+	}
+
+	p, _ := catalog.NewProduct("Margherita", "", catalog.CatClassic, common.NewMoney(450))
+	_ = p.SetToppings([]catalog.ToppingRule{{ToppingID: extra.ID, MaxCount: 2}}, 4)
+	if err := products.Save(ctx, p); err != nil {
+		t.Fatalf("failed to save product: %v", err)
+	}
+
+	loaded, err := products.FindByID(ctx, p.ID())
+	if err != nil {
+		t.Fatalf("failed to find product: %v", err)
+	}
+	if rules := loaded.Toppings(); len(rules) != 1 || rules[0] != (catalog.ToppingRule{ToppingID: extra.ID, MaxCount: 2}) || loaded.MaxToppings() != 4 {
+		t.Errorf("unexpected product toppings: %+v, max %d", rules, loaded.MaxToppings())
+	}
+	if list, _ := products.List(ctx, catalog.ProductFilter{Topping: extra.ID}); len(list) != 1 {
+		t.Errorf("expected product to match topping filter, got %d", len(list))
+	}
+
+	if err := toppings.Delete(ctx, extra.ID); !errors.Is(err, catalog.ErrToppingInUse) {
+		t.Errorf("expected ErrToppingInUse, got %v", err)
+	}
+
+	_ = p.SetToppings([]catalog.ToppingRule{{ToppingID: uuid.NewString()}}, 0)
+	if err := products.Save(ctx, p); !errors.Is(err, catalog.ErrToppingNotFound) {
+		t.Errorf("expected ErrToppingNotFound, got %v", err)
+	}
+
+	_ = p.SetToppings(nil, 0)
+	if err := products.Save(ctx, p); err != nil {
+		t.Fatalf("failed to clear toppings: %v", err)
+	}
+	if err := toppings.Delete(ctx, extra.ID); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if err := toppings.Delete(ctx, extra.ID); !errors.Is(err, catalog.ErrToppingNotFound) {
+		t.Errorf("expected ErrToppingNotFound on second delete, got %v", err)
+	}
+}
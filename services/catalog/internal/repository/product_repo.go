@@ -44,3 +44,13 @@ func (r *InMemoryProductRepository) FindAll(ctx context.Context) ([]*catalog.Pro
 	}
 	return list, nil
 }
+
+func (r *InMemoryProductRepository) List(ctx context.Context, filter catalog.ProductFilter) ([]*catalog.Product, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]*catalog.Product, 0, len(r.store))
+	for _, p := range r.store {
+		list = append(list, p)
+	}
+	return catalog.FilterProducts(list, filter), nil
+}
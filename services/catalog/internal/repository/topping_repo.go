@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	"github.com/versoit/diploma/services/catalog"
+)
+
+type InMemoryToppingRepository struct {
+	mu    sync.RWMutex
+	store map[string]catalog.Topping
+}
+
+func NewInMemoryToppingRepository() catalog.ToppingRepository {
+	return &InMemoryToppingRepository{
+		store: make(map[string]catalog.Topping),
+	}
+}
+
+func (r *InMemoryToppingRepository) Save(ctx context.Context, t *catalog.Topping) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := *t
+	saved.SizePrices = maps.Clone(t.SizePrices)
+	r.store[t.ID] = saved
+	return nil
+}
+
+func (r *InMemoryToppingRepository) FindByID(ctx context.Context, id string) (*catalog.Topping, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.store[id]
+	if !ok {
+		return nil, catalog.ErrToppingNotFound
+	}
+	t.SizePrices = maps.Clone(t.SizePrices)
+	return &t, nil
+}
+
+func (r *InMemoryToppingRepository) FindAll(ctx context.Context) ([]*catalog.Topping, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]*catalog.Topping, 0, len(r.store))
+	for _, t := range r.store {
+		t.SizePrices = maps.Clone(t.SizePrices)
+		list = append(list, &t)
+	}
+	return list, nil
+}
+
+func (r *InMemoryToppingRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.store[id]; !ok {
+		return catalog.ErrToppingNotFound
+	}
+	delete(r.store, id)
+	return nil
+}
@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var ErrUnknownSort = errors.New("unknown product sort order")
+
+// ProductSort - порядок товаров в выдаче. При равном ключе товары упорядочены по ID,
+// поэтому порядок полный и по нему работает постраничная выдача.
+type ProductSort string
+
+const (
+	SortByName      ProductSort = "name"
+	SortByPriceAsc  ProductSort = "price_asc"
+	SortByPriceDesc ProductSort = "price_desc"
+	// SortByNewest - сначала недавно добавленные.
+	SortByNewest ProductSort = "newest"
+)
+
+// ParseProductSort - пустая строка - сортировка по названию.
+func ParseProductSort(s string) (ProductSort, error) {
+	switch v := ProductSort(s); v {
+	case "":
+		return SortByName, nil
+	case SortByName, SortByPriceAsc, SortByPriceDesc, SortByNewest:
+		return v, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownSort, s)
+	}
+}
+
+// ProductCursor - ключ последнего товара страницы, следующая страница начинается после него.
+type ProductCursor struct {
+	ID        string
+	Name      string
+	Price     common.Money
+	CreatedAt time.Time
+}
+
+func CursorOf(p *Product) ProductCursor {
+	return ProductCursor{ID: p.id, Name: p.name, Price: p.basePrice, CreatedAt: p.createdAt}
+}
+
+// Compare - отрицательное, если a в выдаче идет раньше b.
+func (s ProductSort) Compare(a, b ProductCursor) int {
+	var c int
+	switch s {
+	case SortByPriceAsc:
+		c = a.Price.Cmp(b.Price)
+	case SortByPriceDesc:
+		c = b.Price.Cmp(a.Price)
+	case SortByNewest:
+		c = b.CreatedAt.Compare(a.CreatedAt)
+	default:
+		c = strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	}
+	if c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// ProductFilter - выборка товаров каталога.
+type ProductFilter struct {
+	// Categories - пусто - все категории.
+	Categories    []CategoryType
+	AvailableOnly bool
+	// Ingredient - только товары, в составе которых есть ингредиент.
+	Ingredient string
+	// Topping - только товары, к которым можно добавить топпинг.
+	Topping string
+	// Query - подстрока названия или описания без учета регистра.
+	Query string
+	Sort  ProductSort
+	// After - курсор предыдущей страницы, nil - с начала.
+	After *ProductCursor
+	// Limit - 0 - без ограничения.
+	Limit int
+}
+
+func (f ProductFilter) Matches(p *Product) bool {
+	if f.AvailableOnly && !p.IsAvailable() {
+		return false
+	}
+	if f.Ingredient != "" && !p.usesIngredient(f.Ingredient) {
+		return false
+	}
+	if f.Topping != "" && !p.allowsTopping(f.Topping) {
+		return false
+	}
+	if len(f.Categories) > 0 {
+		found := false
+		for _, c := range f.Categories {
+			if c == p.category {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if q := strings.ToLower(strings.TrimSpace(f.Query)); q != "" {
+		if !strings.Contains(strings.ToLower(p.name), q) && !strings.Contains(strings.ToLower(p.description), q) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterProducts - выборка по фильтру для хранилищ, которые держат товары в памяти.
+func FilterProducts(products []*Product, f ProductFilter) []*Product {
+	result := make([]*Product, 0, len(products))
+	for _, p := range products {
+		if !f.Matches(p) {
+			continue
+		}
+		if f.After != nil && f.Sort.Compare(CursorOf(p), *f.After) <= 0 {
+			continue
+		}
+		result = append(result, p)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return f.Sort.Compare(CursorOf(result[i]), CursorOf(result[j])) < 0
+	})
+	if f.Limit > 0 && len(result) > f.Limit {
+		result = result[:f.Limit]
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func listingFixture(t *testing.T) []*Product {
+	t.Helper()
+	var list []*Product
+	add := func(name, desc string, cat CategoryType, price float64, available bool, age time.Duration) {
+		p, err := NewProduct(name, desc, cat, common.NewMoney(price))
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+		p.SetAvailability(available)
+		p.createdAt = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).Add(-age)
+		list = append(list, p)
+	}
+	add("Pepperoni", "Spicy salami", CatSpicy, 600, true, 3*time.Hour)
+	add("Margherita", "Tomato and mozzarella", CatClassic, 450, true, 2*time.Hour)
+	add("Diablo", "Very spicy", CatSpicy, 650, false, time.Hour)
+	add("cola", "Drink", CatDrinks, 100, true, 0)
+	return list
+}
+
+func names(list []*Product) []string {
+	res := make([]string, len(list))
+	for i, p := range list {
+		res[i] = p.Name()
+	}
+	return res
+}
+
+func TestFilterProducts(t *testing.T) {
+	list := listingFixture(t)
+
+	tests := []struct {
+		name   string
+		filter ProductFilter
+		want   []string
+	}{
+		{"by name ignoring case", ProductFilter{Sort: SortByName}, []string{"cola", "Diablo", "Margherita", "Pepperoni"}},
+		{"cheapest first", ProductFilter{Sort: SortByPriceAsc, Limit: 2}, []string{"cola", "Margherita"}},
+		{"most expensive first", ProductFilter{Sort: SortByPriceDesc, Limit: 1}, []string{"Diablo"}},
+		{"newest first", ProductFilter{Sort: SortByNewest}, []string{"cola", "Diablo", "Margherita", "Pepperoni"}},
+		{"category", ProductFilter{Sort: SortByName, Categories: []CategoryType{CatSpicy}}, []string{"Diablo", "Pepperoni"}},
+		{"available spicy", ProductFilter{Sort: SortByName, Categories: []CategoryType{CatSpicy}, AvailableOnly: true}, []string{"Pepperoni"}},
+		{"search description", ProductFilter{Sort: SortByName, Query: "SPICY"}, []string{"Diablo", "Pepperoni"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := names(FilterProducts(list, tt.filter))
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterProducts_Cursor(t *testing.T) {
+	list := listingFixture(t)
+
+	first := FilterProducts(list, ProductFilter{Sort: SortByPriceAsc, Limit: 2})
+	cursor := CursorOf(first[len(first)-1])
+	second := FilterProducts(list, ProductFilter{Sort: SortByPriceAsc, Limit: 2, After: &cursor})
+
+	if got := names(second); len(got) != 2 || got[0] != "Pepperoni" || got[1] != "Diablo" {
+		t.Errorf("unexpected second page: %v", got)
+	}
+}
+
+func TestParseProductSort(t *testing.T) {
+	if s, err := ParseProductSort(""); err != nil || s != SortByName {
+		t.Errorf("expected default sort by name, got %q (%v)", s, err)
+	}
+	if _, err := ParseProductSort("rating"); err == nil {
+		t.Error("expected error for unknown sort")
+	}
+}
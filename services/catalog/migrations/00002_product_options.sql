@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE products ADD COLUMN IF NOT EXISTS halves_allowed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- kind: size или crust, position - порядок вывода, первая опция используется по умолчанию
+CREATE TABLE IF NOT EXISTS product_options (
+    product_id UUID REFERENCES products(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    multiplier DOUBLE PRECISION NOT NULL CHECK (multiplier > 0),
+    position SMALLINT NOT NULL,
+    PRIMARY KEY (product_id, kind, code)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS product_options;
+ALTER TABLE products DROP COLUMN IF EXISTS halves_allowed;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- position - порядок ингредиентов в составе товара
+ALTER TABLE product_ingredients ADD COLUMN IF NOT EXISTS position SMALLINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE product_ingredients DROP COLUMN IF EXISTS position;
+-- +goose StatementEnd
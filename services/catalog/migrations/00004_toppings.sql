@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- price - цена порции для размеров без своей цены в topping_prices
+CREATE TABLE IF NOT EXISTS toppings (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    ingredient_id UUID NOT NULL REFERENCES ingredients(id),
+    quantity DOUBLE PRECISION NOT NULL CHECK (quantity > 0),
+    price DECIMAL(12,2) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS topping_prices (
+    topping_id UUID REFERENCES toppings(id) ON DELETE CASCADE,
+    size_code VARCHAR(50) NOT NULL,
+    price DECIMAL(12,2) NOT NULL,
+    PRIMARY KEY (topping_id, size_code)
+);
+
+-- max_count - порций топпинга в позиции, 0 - без ограничения
+CREATE TABLE IF NOT EXISTS product_toppings (
+    product_id UUID REFERENCES products(id) ON DELETE CASCADE,
+    topping_id UUID REFERENCES toppings(id),
+    max_count SMALLINT NOT NULL DEFAULT 0,
+    position SMALLINT NOT NULL,
+    PRIMARY KEY (product_id, topping_id)
+);
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS max_toppings SMALLINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE products DROP COLUMN IF EXISTS max_toppings;
+DROP TABLE IF EXISTS product_toppings;
+DROP TABLE IF EXISTS topping_prices;
+DROP TABLE IF EXISTS toppings;
+-- +goose StatementEnd
@@ -0,0 +1,78 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidOption = errors.New("invalid product option")
+
+// SizeOption - размер товара. Цена размера - базовая цена, умноженная на Multiplier.
+type SizeOption struct {
+	Code       string
+	Name       string
+	Multiplier float64
+}
+
+// CrustOption - тип теста, тоже множитель к цене.
+type CrustOption struct {
+	Code       string
+	Name       string
+	Multiplier float64
+}
+
+// SetSizes - размеры товара, первый считается размером по умолчанию.
+// Пустой список - товар продается в одном размере.
+func (p *Product) SetSizes(sizes []SizeOption) error {
+	codes := make(map[string]struct{}, len(sizes))
+	for _, s := range sizes {
+		if err := validateOption(codes, s.Code, s.Name, s.Multiplier); err != nil {
+			return err
+		}
+	}
+	p.sizes = append([]SizeOption(nil), sizes...)
+	return nil
+}
+
+// SetCrusts - типы теста, первый считается тестом по умолчанию.
+func (p *Product) SetCrusts(crusts []CrustOption) error {
+	codes := make(map[string]struct{}, len(crusts))
+	for _, c := range crusts {
+		if err := validateOption(codes, c.Code, c.Name, c.Multiplier); err != nil {
+			return err
+		}
+	}
+	p.crusts = append([]CrustOption(nil), crusts...)
+	return nil
+}
+
+// SetHalvesAllowed - товар можно заказать половинкой в пицце "пополам".
+func (p *Product) SetHalvesAllowed(allowed bool) {
+	p.halves = allowed
+}
+
+func (p *Product) HalvesAllowed() bool {
+	return p.halves
+}
+
+func (p *Product) Sizes() []SizeOption {
+	return append([]SizeOption(nil), p.sizes...)
+}
+
+func (p *Product) Crusts() []CrustOption {
+	return append([]CrustOption(nil), p.crusts...)
+}
+
+func validateOption(seen map[string]struct{}, code, name string, multiplier float64) error {
+	if code == "" || name == "" {
+		return fmt.Errorf("%w: code and name are required", ErrInvalidOption)
+	}
+	if multiplier <= 0 {
+		return fmt.Errorf("%w: multiplier of %s must be positive", ErrInvalidOption, code)
+	}
+	if _, dup := seen[code]; dup {
+		return fmt.Errorf("%w: duplicate code %s", ErrInvalidOption, code)
+	}
+	seen[code] = struct{}{}
+	return nil
+}
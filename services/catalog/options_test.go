@@ -0,0 +1,51 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestProduct_SetSizes(t *testing.T) {
+	p, _ := NewProduct("Pizza", "", CatClassic, common.NewMoney(500))
+
+	err := p.SetSizes([]SizeOption{
+		{Code: "25", Name: "25 см", Multiplier: 1},
+		{Code: "30", Name: "30 см", Multiplier: 1.3},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sizes := p.Sizes(); len(sizes) != 2 || sizes[1].Multiplier != 1.3 {
+		t.Errorf("sizes not set correctly: %+v", sizes)
+	}
+
+	cases := [][]SizeOption{
+		{{Code: "", Name: "Пустой", Multiplier: 1}},
+		{{Code: "25", Name: "25 см", Multiplier: 0}},
+		{{Code: "25", Name: "25 см", Multiplier: 1}, {Code: "25", Name: "Дубль", Multiplier: 1.2}},
+	}
+	for _, sizes := range cases {
+		if err := p.SetSizes(sizes); !errors.Is(err, ErrInvalidOption) {
+			t.Errorf("expected ErrInvalidOption for %+v, got %v", sizes, err)
+		}
+	}
+	if len(p.Sizes()) != 2 {
+		t.Error("invalid sizes must not replace existing ones")
+	}
+}
+
+func TestProduct_SetCrusts(t *testing.T) {
+	p, _ := NewProduct("Pizza", "", CatClassic, common.NewMoney(500))
+
+	if err := p.SetCrusts([]CrustOption{{Code: "thin", Name: "Тонкое", Multiplier: 1.1}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if crusts := p.Crusts(); len(crusts) != 1 || crusts[0].Code != "thin" {
+		t.Errorf("crusts not set correctly: %+v", crusts)
+	}
+	if err := p.SetCrusts([]CrustOption{{Code: "thin", Name: "Тонкое", Multiplier: -1}}); !errors.Is(err, ErrInvalidOption) {
+		t.Errorf("expected ErrInvalidOption, got %v", err)
+	}
+}
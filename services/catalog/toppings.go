@@ -0,0 +1,125 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var (
+	ErrToppingNotFound = errors.New("topping not found")
+	ErrInvalidTopping  = errors.New("invalid topping")
+	ErrToppingInUse    = errors.New("topping is allowed for products")
+)
+
+// Topping - добавка к товару из справочника каталога. Топпинга нет в меню, пока
+// его ингредиента нет на складе.
+type Topping struct {
+	ID           string
+	Name         string
+	IngredientID string
+	// Quantity - расход ингредиента на одну порцию, в единицах ингредиента.
+	Quantity float64
+	// Price - цена порции для размеров, которых нет в SizePrices.
+	Price common.Money
+	// SizePrices - цена порции по коду размера товара.
+	SizePrices map[string]common.Money
+}
+
+func NewTopping(name, ingredientID string, qty float64, price common.Money, sizePrices map[string]common.Money) (*Topping, error) {
+	t := &Topping{
+		Name:         strings.TrimSpace(name),
+		IngredientID: ingredientID,
+		Quantity:     qty,
+		Price:        price,
+		SizePrices:   sizePrices,
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, _ := uuid.NewV7()
+	t.ID = id.String()
+	return t, nil
+}
+
+func (t *Topping) Validate() error {
+	if t.Name == "" || t.IngredientID == "" {
+		return fmt.Errorf("%w: name and ingredient are required", ErrInvalidTopping)
+	}
+	if t.Quantity <= 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidTopping, ErrNegativeQty)
+	}
+	if t.Price.IsNegative() {
+		return fmt.Errorf("%w: %w", ErrInvalidTopping, ErrNegativePrice)
+	}
+	for size, price := range t.SizePrices {
+		if size == "" || price.IsNegative() {
+			return fmt.Errorf("%w: price for size %q", ErrInvalidTopping, size)
+		}
+	}
+	return nil
+}
+
+// PriceFor - цена порции для размера товара, пустой код - товар без размеров.
+func (t *Topping) PriceFor(size string) common.Money {
+	if price, ok := t.SizePrices[size]; ok {
+		return price
+	}
+	return t.Price
+}
+
+// ToppingRule - топпинг, который можно добавить к товару.
+type ToppingRule struct {
+	ToppingID string
+	// MaxCount - сколько порций можно добавить в позицию, 0 - без ограничения.
+	MaxCount int
+}
+
+// SetToppings - разрешенные топпинги и общий лимит порций на позицию, 0 - без ограничения.
+func (p *Product) SetToppings(rules []ToppingRule, maxTotal int) error {
+	if maxTotal < 0 {
+		return fmt.Errorf("%w: negative topping limit", ErrInvalidTopping)
+	}
+	seen := make(map[string]struct{}, len(rules))
+	for _, r := range rules {
+		if r.ToppingID == "" || r.MaxCount < 0 {
+			return fmt.Errorf("%w: rule for %q", ErrInvalidTopping, r.ToppingID)
+		}
+		if _, dup := seen[r.ToppingID]; dup {
+			return fmt.Errorf("%w: duplicate topping %s", ErrInvalidTopping, r.ToppingID)
+		}
+		seen[r.ToppingID] = struct{}{}
+	}
+	p.toppings = append([]ToppingRule(nil), rules...)
+	p.maxToppings = maxTotal
+	return nil
+}
+
+func (p *Product) Toppings() []ToppingRule {
+	return append([]ToppingRule(nil), p.toppings...)
+}
+
+func (p *Product) MaxToppings() int {
+	return p.maxToppings
+}
+
+func (p *Product) allowsTopping(toppingID string) bool {
+	for _, r := range p.toppings {
+		if r.ToppingID == toppingID {
+			return true
+		}
+	}
+	return false
+}
+
+type ToppingRepository interface {
+	FindAll(ctx context.Context) ([]*Topping, error)
+	FindByID(ctx context.Context, id string) (*Topping, error)
+	Save(ctx context.Context, t *Topping) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,80 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestNewTopping(t *testing.T) {
+	top, err := NewTopping(" Extra cheese ", "cheese", 30, common.NewMoney(60), map[string]common.Money{"35": common.NewMoney(90)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if top.ID == "" || top.Name != "Extra cheese" {
+		t.Errorf("unexpected topping: %+v", top)
+	}
+	if !top.PriceFor("35").Equal(common.NewMoney(90)) || !top.PriceFor("25").Equal(common.NewMoney(60)) || !top.PriceFor("").Equal(common.NewMoney(60)) {
+		t.Errorf("unexpected prices: 35=%s 25=%s", top.PriceFor("35"), top.PriceFor("25"))
+	}
+
+	tests := []struct {
+		name       string
+		ingredient string
+		qty        float64
+		price      common.Money
+		sizePrices map[string]common.Money
+	}{
+		{"no ingredient", "", 30, common.NewMoney(60), nil},
+		{"zero quantity", "cheese", 0, common.NewMoney(60), nil},
+		{"negative price", "cheese", 30, common.NewMoney(-1), nil},
+		{"negative size price", "cheese", 30, common.NewMoney(60), map[string]common.Money{"35": common.NewMoney(-1)}},
+		{"empty size code", "cheese", 30, common.NewMoney(60), map[string]common.Money{"": common.NewMoney(70)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTopping("Cheese", tt.ingredient, tt.qty, tt.price, tt.sizePrices); !errors.Is(err, ErrInvalidTopping) {
+				t.Errorf("expected ErrInvalidTopping, got %v", err)
+			}
+		})
+	}
+}
+
+func TestProduct_SetToppings(t *testing.T) {
+	p, _ := NewProduct("Pizza", "", CatClassic, common.NewMoney(500))
+
+	if err := p.SetToppings([]ToppingRule{{ToppingID: "cheese", MaxCount: 2}, {ToppingID: "basil"}}, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.Toppings()) != 2 || p.MaxToppings() != 3 {
+		t.Errorf("unexpected toppings: %+v, max %d", p.Toppings(), p.MaxToppings())
+	}
+
+	invalid := [][]ToppingRule{
+		{{ToppingID: "cheese"}, {ToppingID: "cheese"}},
+		{{ToppingID: "cheese", MaxCount: -1}},
+		{{ToppingID: ""}},
+	}
+	for _, rules := range invalid {
+		if err := p.SetToppings(rules, 0); !errors.Is(err, ErrInvalidTopping) {
+			t.Errorf("expected ErrInvalidTopping for %+v, got %v", rules, err)
+		}
+	}
+	if err := p.SetToppings(nil, -1); !errors.Is(err, ErrInvalidTopping) {
+		t.Errorf("expected ErrInvalidTopping for negative limit, got %v", err)
+	}
+
+	restored := RestoreProduct(p.Snapshot())
+	if len(restored.Toppings()) != 2 || restored.MaxToppings() != 3 {
+		t.Error("toppings must survive snapshot round-trip")
+	}
+
+	f := ProductFilter{Topping: "basil"}
+	if !f.Matches(p) {
+		t.Error("filter by allowed topping must match")
+	}
+	if f.Topping = "ham"; f.Matches(p) {
+		t.Error("filter by foreign topping must not match")
+	}
+}
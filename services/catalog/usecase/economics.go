@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/versoit/diploma/services/catalog"
+)
+
+type EconomicsInput struct {
+	// ProductIDs - пусто - весь каталог.
+	ProductIDs []string
+	// TargetPercent - 0 - целевая маржа из настроек сервиса.
+	TargetPercent   float64
+	BelowTargetOnly bool
+}
+
+// EconomicsReport - экономика товаров и целевая маржа, с которой они сравнивались.
+type EconomicsReport struct {
+	TargetPercent float64
+	Products      []*catalog.ProductEconomics
+}
+
+// GetProductEconomics - себестоимость по текущим ценам ингредиентов и маржа товаров
+// относительно базовой цены и цен размеров.
+func (uc *CatalogUseCase) GetProductEconomics(ctx context.Context, input EconomicsInput) (*EconomicsReport, error) {
+	policy := *uc.margin
+	if input.TargetPercent != 0 {
+		policy.TargetPercent = input.TargetPercent
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+
+	products, err := uc.economicsProducts(ctx, input.ProductIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	all, err := uc.ingredients.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingredients: %w", err)
+	}
+	ingredients := make(map[string]*catalog.Ingredient, len(all))
+	for _, ing := range all {
+		ingredients[ing.ID] = ing
+	}
+
+	report := &EconomicsReport{TargetPercent: policy.TargetPercent, Products: make([]*catalog.ProductEconomics, 0, len(products))}
+	for _, p := range products {
+		e, err := catalog.CalculateEconomics(p, ingredients, policy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate cost of product %s: %w", p.ID(), err)
+		}
+		if input.BelowTargetOnly && !e.BelowTarget {
+			continue
+		}
+		report.Products = append(report.Products, e)
+	}
+	return report, nil
+}
+
+func (uc *CatalogUseCase) economicsProducts(ctx context.Context, ids []string) ([]*catalog.Product, error) {
+	if len(ids) == 0 {
+		products, err := uc.repo.List(ctx, catalog.ProductFilter{Sort: catalog.SortByName})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list products: %w", err)
+		}
+		return products, nil
+	}
+
+	products := make([]*catalog.Product, 0, len(ids))
+	for _, id := range ids {
+		p, err := uc.GetProduct(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, p)
+	}
+	return products, nil
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/catalog"
+)
+
+type IngredientInput struct {
+	Name string
+	Unit string
+	Cost common.Money
+}
+
+func (uc *CatalogUseCase) CreateIngredient(ctx context.Context, input IngredientInput) (*catalog.Ingredient, error) {
+	ing, err := catalog.NewIngredient(input.Name, input.Unit, input.Cost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize ingredient: %w", err)
+	}
+
+	if err := uc.ingredients.Save(ctx, ing); err != nil {
+		return nil, fmt.Errorf("failed to save new ingredient: %w", err)
+	}
+	return ing, nil
+}
+
+func (uc *CatalogUseCase) GetIngredient(ctx context.Context, ingredientID string) (*catalog.Ingredient, error) {
+	if ingredientID == "" {
+		return nil, fmt.Errorf("%w: ingredient ID is required", ErrInvalidInput)
+	}
+
+	ing, err := uc.ingredients.FindByID(ctx, ingredientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ingredient %s: %w", ingredientID, err)
+	}
+	return ing, nil
+}
+
+// ListIngredients - справочник ингредиентов по названию.
+func (uc *CatalogUseCase) ListIngredients(ctx context.Context) ([]*catalog.Ingredient, error) {
+	list, err := uc.ingredients.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingredients: %w", err)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if c := strings.Compare(strings.ToLower(list[i].Name), strings.ToLower(list[j].Name)); c != 0 {
+			return c < 0
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+
+// UpdateIngredient - название, единица и стоимость. Наличие меняется через SetIngredientStock.
+func (uc *CatalogUseCase) UpdateIngredient(ctx context.Context, ingredientID string, input IngredientInput) (*catalog.Ingredient, error) {
+	ing, err := uc.GetIngredient(ctx, ingredientID)
+	if err != nil {
+		return nil, err
+	}
+
+	ing.Name = strings.TrimSpace(input.Name)
+	ing.Unit = strings.TrimSpace(input.Unit)
+	ing.Cost = input.Cost
+	if err := ing.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ingredients.Save(ctx, ing); err != nil {
+		return nil, fmt.Errorf("failed to persist ingredient %s: %w", ingredientID, err)
+	}
+	return ing, nil
+}
+
+// DeleteIngredient - ингредиент, который входит в состав товаров или топпингов, удалить нельзя.
+func (uc *CatalogUseCase) DeleteIngredient(ctx context.Context, ingredientID string) error {
+	if _, err := uc.GetIngredient(ctx, ingredientID); err != nil {
+		return err
+	}
+
+	used, err := uc.repo.List(ctx, catalog.ProductFilter{Ingredient: ingredientID, Limit: 1})
+	if err != nil {
+		return fmt.Errorf("failed to find products with ingredient %s: %w", ingredientID, err)
+	}
+	if len(used) > 0 {
+		return fmt.Errorf("%w: %s is used by %s", catalog.ErrIngredientInUse, ingredientID, used[0].ID())
+	}
+
+	toppings, err := uc.toppings.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list toppings: %w", err)
+	}
+	for _, t := range toppings {
+		if t.IngredientID == ingredientID {
+			return fmt.Errorf("%w: %s is used by topping %s", catalog.ErrIngredientInUse, ingredientID, t.ID)
+		}
+	}
+
+	if err := uc.ingredients.Delete(ctx, ingredientID); err != nil {
+		return fmt.Errorf("failed to delete ingredient %s: %w", ingredientID, err)
+	}
+	return nil
+}
+
+// StockUpdate - ингредиент после смены наличия и товары, в состав которых он входит.
+type StockUpdate struct {
+	Ingredient *catalog.Ingredient
+	Products   []*catalog.Product
+}
+
+// SetIngredientStock - отмечает наличие ингредиента и пересчитывает доступность товаров:
+// без неубираемого ингредиента товар недоступен, убираемый просто скрывается из состава.
+func (uc *CatalogUseCase) SetIngredientStock(ctx context.Context, ingredientID string, inStock bool) (*StockUpdate, error) {
+	ing, err := uc.GetIngredient(ctx, ingredientID)
+	if err != nil {
+		return nil, err
+	}
+
+	ing.InStock = inStock
+	if err := uc.ingredients.Save(ctx, ing); err != nil {
+		return nil, fmt.Errorf("failed to persist stock of ingredient %s: %w", ingredientID, err)
+	}
+
+	products, err := uc.repo.List(ctx, catalog.ProductFilter{Ingredient: ingredientID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find products with ingredient %s: %w", ingredientID, err)
+	}
+	for _, p := range products {
+		p.SetIngredientStock(ingredientID, inStock)
+		if err := uc.repo.Save(ctx, p); err != nil {
+			return nil, fmt.Errorf("failed to persist availability of product %s: %w", p.ID(), err)
+		}
+	}
+
+	return &StockUpdate{Ingredient: ing, Products: products}, nil
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/catalog"
+)
+
+type ToppingInput struct {
+	Name         string
+	IngredientID string
+	Quantity     float64
+	Price        common.Money
+	SizePrices   map[string]common.Money
+}
+
+func (uc *CatalogUseCase) CreateTopping(ctx context.Context, input ToppingInput) (*catalog.Topping, error) {
+	t, err := catalog.NewTopping(input.Name, input.IngredientID, input.Quantity, input.Price, input.SizePrices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize topping: %w", err)
+	}
+	if _, err := uc.GetIngredient(ctx, t.IngredientID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.toppings.Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to save new topping: %w", err)
+	}
+	return t, nil
+}
+
+func (uc *CatalogUseCase) UpdateTopping(ctx context.Context, toppingID string, input ToppingInput) (*catalog.Topping, error) {
+	t, err := uc.getTopping(ctx, toppingID)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Name = strings.TrimSpace(input.Name)
+	t.IngredientID = input.IngredientID
+	t.Quantity = input.Quantity
+	t.Price = input.Price
+	t.SizePrices = input.SizePrices
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.GetIngredient(ctx, t.IngredientID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.toppings.Save(ctx, t); err != nil {
+		return nil, fmt.Errorf("failed to persist topping %s: %w", toppingID, err)
+	}
+	return t, nil
+}
+
+// ListToppings - справочник топпингов по названию.
+func (uc *CatalogUseCase) ListToppings(ctx context.Context) ([]*catalog.Topping, error) {
+	list, err := uc.toppings.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list toppings: %w", err)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if c := strings.Compare(strings.ToLower(list[i].Name), strings.ToLower(list[j].Name)); c != 0 {
+			return c < 0
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list, nil
+}
+
+// DeleteTopping - топпинг, разрешенный для товаров, удалить нельзя.
+func (uc *CatalogUseCase) DeleteTopping(ctx context.Context, toppingID string) error {
+	if _, err := uc.getTopping(ctx, toppingID); err != nil {
+		return err
+	}
+
+	used, err := uc.repo.List(ctx, catalog.ProductFilter{Topping: toppingID, Limit: 1})
+	if err != nil {
+		return fmt.Errorf("failed to find products with topping %s: %w", toppingID, err)
+	}
+	if len(used) > 0 {
+		return fmt.Errorf("%w: %s is allowed for %s", catalog.ErrToppingInUse, toppingID, used[0].ID())
+	}
+
+	if err := uc.toppings.Delete(ctx, toppingID); err != nil {
+		return fmt.Errorf("failed to delete topping %s: %w", toppingID, err)
+	}
+	return nil
+}
+
+// SetProductToppings - топпинги, которые можно добавить к товару, и общий лимит порций.
+func (uc *CatalogUseCase) SetProductToppings(ctx context.Context, productID string, rules []catalog.ToppingRule, maxTotal int) (*catalog.Product, error) {
+	product, err := uc.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rules {
+		if _, err := uc.getTopping(ctx, r.ToppingID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := product.SetToppings(rules, maxTotal); err != nil {
+		return nil, fmt.Errorf("invalid toppings: %w", err)
+	}
+	if err := uc.repo.Save(ctx, product); err != nil {
+		return nil, fmt.Errorf("failed to persist product toppings: %w", err)
+	}
+	return product, nil
+}
+
+// ProductTopping - топпинг в меню товара.
+type ProductTopping struct {
+	Topping *catalog.Topping
+	// MaxCount - порций в позиции, 0 - без ограничения.
+	MaxCount int
+}
+
+// ToppingMenu - топпинги, которые сейчас можно добавить к товару.
+type ToppingMenu struct {
+	Toppings []ProductTopping
+	// MaxToppings - всего порций в позиции, 0 - без ограничения.
+	MaxToppings int
+}
+
+// ListProductToppings - меню топпингов товара. Топпинги, ингредиента которых нет на складе, скрыты.
+func (uc *CatalogUseCase) ListProductToppings(ctx context.Context, productID string) (*ToppingMenu, error) {
+	product, err := uc.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	menu := &ToppingMenu{MaxToppings: product.MaxToppings()}
+	for _, r := range product.Toppings() {
+		t, err := uc.getTopping(ctx, r.ToppingID)
+		if err != nil {
+			return nil, err
+		}
+		ing, err := uc.GetIngredient(ctx, t.IngredientID)
+		if err != nil {
+			return nil, err
+		}
+		if !ing.InStock {
+			continue
+		}
+		menu.Toppings = append(menu.Toppings, ProductTopping{Topping: t, MaxCount: r.MaxCount})
+	}
+	return menu, nil
+}
+
+func (uc *CatalogUseCase) getTopping(ctx context.Context, toppingID string) (*catalog.Topping, error) {
+	if toppingID == "" {
+		return nil, fmt.Errorf("%w: topping ID is required", ErrInvalidInput)
+	}
+
+	t, err := uc.toppings.FindByID(ctx, toppingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find topping %s: %w", toppingID, err)
+	}
+	return t, nil
+}
@@ -13,12 +13,74 @@ var (
 	ErrInvalidInput = errors.New("invalid input data")
 )
 
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
 type CatalogUseCase struct {
-	repo catalog.ProductRepository
+	repo        catalog.ProductRepository
+	ingredients catalog.IngredientRepository
+	toppings    catalog.ToppingRepository
+	margin      *catalog.MarginPolicy
+}
+
+func NewCatalogUseCase(
+	repo catalog.ProductRepository,
+	ingredients catalog.IngredientRepository,
+	toppings catalog.ToppingRepository,
+	margin *catalog.MarginPolicy,
+) *CatalogUseCase {
+	return &CatalogUseCase{repo: repo, ingredients: ingredients, toppings: toppings, margin: margin}
+}
+
+func (uc *CatalogUseCase) GetProduct(ctx context.Context, productID string) (*catalog.Product, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("%w: product ID is required", ErrInvalidInput)
+	}
+
+	product, err := uc.repo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find product %s: %w", productID, err)
+	}
+
+	return product, nil
 }
 
-func NewCatalogUseCase(repo catalog.ProductRepository) *CatalogUseCase {
-	return &CatalogUseCase{repo: repo}
+// ProductPage - страница выдачи каталога. NextCursor - nil, если страница последняя.
+type ProductPage struct {
+	Products   []*catalog.Product
+	NextCursor *catalog.ProductCursor
+}
+
+// ListProducts - товары по фильтру, постранично по курсору.
+func (uc *CatalogUseCase) ListProducts(ctx context.Context, filter catalog.ProductFilter) (*ProductPage, error) {
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = DefaultPageSize
+	case filter.Limit > MaxPageSize:
+		filter.Limit = MaxPageSize
+	}
+	if filter.Sort == "" {
+		filter.Sort = catalog.SortByName
+	}
+
+	// Запрашиваем на один товар больше, чтобы понять, есть ли следующая страница
+	pageSize := filter.Limit
+	filter.Limit++
+
+	list, err := uc.repo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list products: %w", err)
+	}
+
+	page := &ProductPage{Products: list}
+	if len(list) > pageSize {
+		page.Products = list[:pageSize]
+		cursor := catalog.CursorOf(page.Products[pageSize-1])
+		page.NextCursor = &cursor
+	}
+	return page, nil
 }
 
 func (uc *CatalogUseCase) UpdatePrice(ctx context.Context, productID string, newPrice common.Money) error {
@@ -61,15 +123,40 @@ func (uc *CatalogUseCase) SetAvailability(ctx context.Context, productID string,
 	return nil
 }
 
-func (uc *CatalogUseCase) CreateProduct(ctx context.Context, name, desc string, cat catalog.CategoryType, price common.Money) (*catalog.Product, error) {
-	if name == "" {
+type CreateProductInput struct {
+	Name        string
+	Description string
+	Category    catalog.CategoryType
+	Price       common.Money
+	ImageURL    string
+	Ingredients []catalog.IngredientRef
+	Options     ProductOptions
+}
+
+func (uc *CatalogUseCase) CreateProduct(ctx context.Context, input CreateProductInput) (*catalog.Product, error) {
+	if input.Name == "" {
 		return nil, fmt.Errorf("%w: product name is required", ErrInvalidInput)
 	}
 
-	product, err := catalog.NewProduct(name, desc, cat, price)
+	product, err := catalog.NewProduct(input.Name, input.Description, input.Category, input.Price)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize product: %w", err)
 	}
+	product.SetImageURL(input.ImageURL)
+	for _, ref := range input.Ingredients {
+		ing, err := uc.ingredients.FindByID(ctx, ref.IngredientID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find ingredient %s: %w", ref.IngredientID, err)
+		}
+		if err := product.AddIngredient(ref.IngredientID, ref.Quantity, ref.IsRemovable); err != nil {
+			return nil, fmt.Errorf("invalid ingredient %s: %w", ref.IngredientID, err)
+		}
+		product.SetIngredientStock(ing.ID, ing.InStock)
+	}
+
+	if err := applyOptions(product, input.Options); err != nil {
+		return nil, err
+	}
 
 	if err := uc.repo.Save(ctx, product); err != nil {
 		return nil, fmt.Errorf("failed to save new product: %w", err)
@@ -77,3 +164,42 @@ func (uc *CatalogUseCase) CreateProduct(ctx context.Context, name, desc string,
 
 	return product, nil
 }
+
+// ProductOptions - размеры, типы теста и возможность заказать товар половинкой.
+type ProductOptions struct {
+	Sizes         []catalog.SizeOption
+	Crusts        []catalog.CrustOption
+	HalvesAllowed bool
+}
+
+func (uc *CatalogUseCase) SetProductOptions(ctx context.Context, productID string, opts ProductOptions) (*catalog.Product, error) {
+	if productID == "" {
+		return nil, fmt.Errorf("%w: product ID is required", ErrInvalidInput)
+	}
+
+	product, err := uc.repo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find product %s: %w", productID, err)
+	}
+
+	if err := applyOptions(product, opts); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Save(ctx, product); err != nil {
+		return nil, fmt.Errorf("failed to persist product options: %w", err)
+	}
+
+	return product, nil
+}
+
+func applyOptions(product *catalog.Product, opts ProductOptions) error {
+	if err := product.SetSizes(opts.Sizes); err != nil {
+		return fmt.Errorf("invalid sizes: %w", err)
+	}
+	if err := product.SetCrusts(opts.Crusts); err != nil {
+		return fmt.Errorf("invalid crusts: %w", err)
+	}
+	product.SetHalvesAllowed(opts.HalvesAllowed)
+	return nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/versoit/diploma/pkg/common"
@@ -36,11 +37,92 @@ func (m *MockProductRepo) FindAll(ctx context.Context) ([]*catalog.Product, erro
 	return list, nil
 }
 
+func (m *MockProductRepo) List(ctx context.Context, filter catalog.ProductFilter) ([]*catalog.Product, error) {
+	list, _ := m.FindAll(ctx)
+	return catalog.FilterProducts(list, filter), nil
+}
+
+type MockIngredientRepo struct {
+	store map[string]catalog.Ingredient
+}
+
+func NewMockIngredientRepo() *MockIngredientRepo {
+	return &MockIngredientRepo{store: make(map[string]catalog.Ingredient)}
+}
+
+func (m *MockIngredientRepo) Save(ctx context.Context, ing *catalog.Ingredient) error {
+	m.store[ing.ID] = *ing
+	return nil
+}
+
+func (m *MockIngredientRepo) FindByID(ctx context.Context, id string) (*catalog.Ingredient, error) {
+	if ing, ok := m.store[id]; ok {
+		return &ing, nil
+	}
+	return nil, catalog.ErrIngredientNotFound
+}
+
+func (m *MockIngredientRepo) FindAll(ctx context.Context) ([]*catalog.Ingredient, error) {
+	var list []*catalog.Ingredient
+	for _, ing := range m.store {
+		list = append(list, &ing)
+	}
+	return list, nil
+}
+
+func (m *MockIngredientRepo) Delete(ctx context.Context, id string) error {
+	if _, ok := m.store[id]; !ok {
+		return catalog.ErrIngredientNotFound
+	}
+	delete(m.store, id)
+	return nil
+}
+
+type MockToppingRepo struct {
+	store map[string]catalog.Topping
+}
+
+func NewMockToppingRepo() *MockToppingRepo {
+	return &MockToppingRepo{store: make(map[string]catalog.Topping)}
+}
+
+func (m *MockToppingRepo) Save(ctx context.Context, t *catalog.Topping) error {
+	m.store[t.ID] = *t
+	return nil
+}
+
+func (m *MockToppingRepo) FindByID(ctx context.Context, id string) (*catalog.Topping, error) {
+	if t, ok := m.store[id]; ok {
+		return &t, nil
+	}
+	return nil, catalog.ErrToppingNotFound
+}
+
+func (m *MockToppingRepo) FindAll(ctx context.Context) ([]*catalog.Topping, error) {
+	var list []*catalog.Topping
+	for _, t := range m.store {
+		list = append(list, &t)
+	}
+	return list, nil
+}
+
+func (m *MockToppingRepo) Delete(ctx context.Context, id string) error {
+	if _, ok := m.store[id]; !ok {
+		return catalog.ErrToppingNotFound
+	}
+	delete(m.store, id)
+	return nil
+}
+
+func defaultMargin() *catalog.MarginPolicy {
+	return &catalog.MarginPolicy{TargetPercent: 70}
+}
+
 func TestCatalogUseCase_CreateProduct(t *testing.T) {
 	repo := NewMockProductRepo()
-	uc := NewCatalogUseCase(repo)
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
 
-	p, err := uc.CreateProduct(context.Background(), "Burger", "Delicious", catalog.CatClassic, common.NewMoney(100))
+	p, err := uc.CreateProduct(context.Background(), CreateProductInput{Name: "Burger", Description: "Delicious", Category: catalog.CatClassic, Price: common.NewMoney(100)})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -56,8 +138,8 @@ func TestCatalogUseCase_CreateProduct(t *testing.T) {
 
 func TestCatalogUseCase_UpdatePrice(t *testing.T) {
 	repo := NewMockProductRepo()
-	uc := NewCatalogUseCase(repo)
-	p, err := uc.CreateProduct(context.Background(), "Burger", "Desc", catalog.CatClassic, common.NewMoney(100))
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	p, err := uc.CreateProduct(context.Background(), CreateProductInput{Name: "Burger", Description: "Desc", Category: catalog.CatClassic, Price: common.NewMoney(100)})
 	if err != nil {
 		t.Fatalf("setup failed: %v", err)
 	}
@@ -74,4 +156,299 @@ func TestCatalogUseCase_UpdatePrice(t *testing.T) {
 	if !updated.BasePrice().Equal(common.NewMoney(150)) {
 		t.Errorf("expected price 150, got %v", updated.BasePrice())
 	}
-}
\ No newline at end of file
+}
+func TestCatalogUseCase_GetProduct(t *testing.T) {
+	repo := NewMockProductRepo()
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	p, _ := uc.CreateProduct(context.Background(), CreateProductInput{Name: "Burger", Description: "Desc", Category: catalog.CatClassic, Price: common.NewMoney(100)})
+
+	got, err := uc.GetProduct(context.Background(), p.ID())
+	if err != nil || got.ID() != p.ID() {
+		t.Fatalf("expected product %s, got %v (%v)", p.ID(), got, err)
+	}
+
+	if _, err := uc.GetProduct(context.Background(), "missing"); !errors.Is(err, catalog.ErrProductNotFound) {
+		t.Errorf("expected ErrProductNotFound, got %v", err)
+	}
+}
+
+func TestCatalogUseCase_SetProductOptions(t *testing.T) {
+	repo := NewMockProductRepo()
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	p, _ := uc.CreateProduct(context.Background(), CreateProductInput{Name: "Pepperoni", Description: "Desc", Category: catalog.CatClassic, Price: common.NewMoney(500)})
+
+	updated, err := uc.SetProductOptions(context.Background(), p.ID(), ProductOptions{
+		Sizes:         []catalog.SizeOption{{Code: "25", Name: "25 см", Multiplier: 1}, {Code: "35", Name: "35 см", Multiplier: 1.6}},
+		Crusts:        []catalog.CrustOption{{Code: "thin", Name: "Тонкое", Multiplier: 1}},
+		HalvesAllowed: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updated.Sizes()) != 2 || len(updated.Crusts()) != 1 || !updated.HalvesAllowed() {
+		t.Errorf("options not applied: sizes=%v crusts=%v", updated.Sizes(), updated.Crusts())
+	}
+
+	_, err = uc.SetProductOptions(context.Background(), p.ID(), ProductOptions{
+		Sizes: []catalog.SizeOption{{Code: "25", Name: "25 см", Multiplier: 0}},
+	})
+	if !errors.Is(err, catalog.ErrInvalidOption) {
+		t.Errorf("expected ErrInvalidOption, got %v", err)
+	}
+
+	if _, err := uc.SetProductOptions(context.Background(), "missing", ProductOptions{}); !errors.Is(err, catalog.ErrProductNotFound) {
+		t.Errorf("expected ErrProductNotFound, got %v", err)
+	}
+}
+
+func TestCatalogUseCase_ListProducts(t *testing.T) {
+	repo := NewMockProductRepo()
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	ctx := context.Background()
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		if _, err := uc.CreateProduct(ctx, CreateProductInput{Name: name, Category: catalog.CatClassic, Price: common.NewMoney(100)}); err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+	}
+
+	var seen []string
+	filter := catalog.ProductFilter{Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("pagination does not terminate")
+		}
+		page, err := uc.ListProducts(ctx, filter)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, p := range page.Products {
+			seen = append(seen, p.Name())
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		filter.After = page.NextCursor
+	}
+
+	if len(seen) != 5 || seen[0] != "A" || seen[4] != "E" {
+		t.Errorf("expected all products in name order, got %v", seen)
+	}
+}
+
+func TestCatalogUseCase_IngredientStock(t *testing.T) {
+	repo := NewMockProductRepo()
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	ctx := context.Background()
+
+	dough, _ := uc.CreateIngredient(ctx, IngredientInput{Name: "Dough", Unit: "g", Cost: common.NewMoney(10)})
+	olives, _ := uc.CreateIngredient(ctx, IngredientInput{Name: "Olives", Unit: "g", Cost: common.NewMoney(30)})
+	if _, err := uc.CreateIngredient(ctx, IngredientInput{Name: "Salt", Cost: common.NewMoney(1)}); !errors.Is(err, catalog.ErrInvalidIngredient) {
+		t.Errorf("expected ErrInvalidIngredient, got %v", err)
+	}
+
+	pizza, err := uc.CreateProduct(ctx, CreateProductInput{Name: "Pizza", Price: common.NewMoney(400), Ingredients: []catalog.IngredientRef{
+		{IngredientID: dough.ID, Quantity: 200},
+		{IngredientID: olives.ID, Quantity: 30, IsRemovable: true},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bread, _ := uc.CreateProduct(ctx, CreateProductInput{Name: "Bread", Price: common.NewMoney(100), Ingredients: []catalog.IngredientRef{
+		{IngredientID: dough.ID, Quantity: 300},
+	}})
+	water, _ := uc.CreateProduct(ctx, CreateProductInput{Name: "Water", Category: catalog.CatDrinks, Price: common.NewMoney(50)})
+
+	_, err = uc.CreateProduct(ctx, CreateProductInput{Name: "Ghost", Price: common.NewMoney(1), Ingredients: []catalog.IngredientRef{{IngredientID: "missing", Quantity: 1}}})
+	if !errors.Is(err, catalog.ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound, got %v", err)
+	}
+
+	// Убираемый ингредиент только скрывается из состава
+	update, err := uc.SetIngredientStock(ctx, olives.ID, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if update.Ingredient.InStock || len(update.Products) != 1 {
+		t.Errorf("unexpected stock update: %+v", update)
+	}
+	if !pizza.IsAvailable() || len(pizza.MenuIngredients()) != 1 || len(pizza.Ingredients()) != 2 {
+		t.Errorf("olives must be hidden, pizza must stay available: %v", pizza.MenuIngredients())
+	}
+
+	// Без обязательного ингредиента товары недоступны
+	if update, err = uc.SetIngredientStock(ctx, dough.ID, false); err != nil || len(update.Products) != 2 {
+		t.Fatalf("unexpected stock update: %+v, %v", update, err)
+	}
+	if pizza.IsAvailable() || bread.IsAvailable() || !water.IsAvailable() {
+		t.Errorf("unexpected availability: pizza=%v bread=%v water=%v", pizza.IsAvailable(), bread.IsAvailable(), water.IsAvailable())
+	}
+	page, _ := uc.ListProducts(ctx, catalog.ProductFilter{AvailableOnly: true})
+	if len(page.Products) != 1 || page.Products[0].ID() != water.ID() {
+		t.Errorf("expected only water to be available, got %d products", len(page.Products))
+	}
+
+	// Возврат на склад не возвращает в продажу товар, снятый вручную
+	_ = uc.SetAvailability(ctx, bread.ID(), false)
+	if _, err := uc.SetIngredientStock(ctx, dough.ID, true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !pizza.IsAvailable() || bread.IsAvailable() {
+		t.Errorf("unexpected availability after restock: pizza=%v bread=%v", pizza.IsAvailable(), bread.IsAvailable())
+	}
+
+	if _, err := uc.SetIngredientStock(ctx, "missing", true); !errors.Is(err, catalog.ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound, got %v", err)
+	}
+}
+
+func TestCatalogUseCase_UpdateAndDeleteIngredient(t *testing.T) {
+	repo := NewMockProductRepo()
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	ctx := context.Background()
+
+	cheese, _ := uc.CreateIngredient(ctx, IngredientInput{Name: "Cheese", Unit: "g", Cost: common.NewMoney(50)})
+	basil, _ := uc.CreateIngredient(ctx, IngredientInput{Name: "basil", Unit: "g", Cost: common.NewMoney(5)})
+
+	updated, err := uc.UpdateIngredient(ctx, cheese.ID, IngredientInput{Name: "Mozzarella", Unit: "g", Cost: common.NewMoney(60)})
+	if err != nil || updated.Name != "Mozzarella" || !updated.Cost.Equal(common.NewMoney(60)) || !updated.InStock {
+		t.Fatalf("unexpected update: %+v, %v", updated, err)
+	}
+	if _, err := uc.UpdateIngredient(ctx, cheese.ID, IngredientInput{Name: "Mozzarella", Unit: "g", Cost: common.NewMoney(-1)}); !errors.Is(err, catalog.ErrInvalidIngredient) {
+		t.Errorf("expected ErrInvalidIngredient, got %v", err)
+	}
+
+	list, _ := uc.ListIngredients(ctx)
+	if len(list) != 2 || list[0].ID != basil.ID || list[1].Name != "Mozzarella" {
+		t.Errorf("expected ingredients ordered by name, got %+v", list)
+	}
+
+	if _, err := uc.CreateProduct(ctx, CreateProductInput{Name: "Caprese", Price: common.NewMoney(300), Ingredients: []catalog.IngredientRef{
+		{IngredientID: cheese.ID, Quantity: 100},
+	}}); err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+	if err := uc.DeleteIngredient(ctx, cheese.ID); !errors.Is(err, catalog.ErrIngredientInUse) {
+		t.Errorf("expected ErrIngredientInUse, got %v", err)
+	}
+	if err := uc.DeleteIngredient(ctx, basil.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.GetIngredient(ctx, basil.ID); !errors.Is(err, catalog.ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound, got %v", err)
+	}
+}
+
+func TestCatalogUseCase_GetProductEconomics(t *testing.T) {
+	repo := NewMockProductRepo()
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	ctx := context.Background()
+
+	cheese, _ := uc.CreateIngredient(ctx, IngredientInput{Name: "Cheese", Unit: "g", Cost: common.NewMoney(1)})
+	cheap, _ := uc.CreateProduct(ctx, CreateProductInput{Name: "Cheap", Price: common.NewMoney(200), Ingredients: []catalog.IngredientRef{
+		{IngredientID: cheese.ID, Quantity: 100},
+	}})
+	rich, _ := uc.CreateProduct(ctx, CreateProductInput{Name: "Rich", Price: common.NewMoney(1000), Ingredients: []catalog.IngredientRef{
+		{IngredientID: cheese.ID, Quantity: 100},
+	}})
+
+	report, err := uc.GetProductEconomics(ctx, EconomicsInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.TargetPercent != 70 || len(report.Products) != 2 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if e := report.Products[0]; e.ProductID != cheap.ID() || e.Base.MarginPercent != 50 || !e.BelowTarget {
+		t.Errorf("cheap product must be below target: %+v", e.Base)
+	}
+
+	// Себестоимость пересчитывается по текущей цене ингредиента
+	if _, err := uc.UpdateIngredient(ctx, cheese.ID, IngredientInput{Name: "Cheese", Unit: "g", Cost: common.NewMoney(4)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	report, _ = uc.GetProductEconomics(ctx, EconomicsInput{BelowTargetOnly: true})
+	if len(report.Products) != 2 {
+		t.Errorf("expected both products below target, got %d", len(report.Products))
+	}
+
+	report, _ = uc.GetProductEconomics(ctx, EconomicsInput{ProductIDs: []string{rich.ID()}, TargetPercent: 65, BelowTargetOnly: true})
+	if report.TargetPercent != 65 || len(report.Products) != 1 || !report.Products[0].Base.Cost.Equal(common.NewMoney(400)) {
+		t.Errorf("unexpected report for rich product: %+v", report)
+	}
+
+	if _, err := uc.GetProductEconomics(ctx, EconomicsInput{TargetPercent: 120}); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+	if _, err := uc.GetProductEconomics(ctx, EconomicsInput{ProductIDs: []string{"missing"}}); !errors.Is(err, catalog.ErrProductNotFound) {
+		t.Errorf("expected ErrProductNotFound, got %v", err)
+	}
+}
+
+func TestCatalogUseCase_Toppings(t *testing.T) {
+	repo := NewMockProductRepo()
+	uc := NewCatalogUseCase(repo, NewMockIngredientRepo(), NewMockToppingRepo(), defaultMargin())
+	ctx := context.Background()
+
+	cheese, _ := uc.CreateIngredient(ctx, IngredientInput{Name: "Cheese", Unit: "g", Cost: common.NewMoney(1)})
+	basil, _ := uc.CreateIngredient(ctx, IngredientInput{Name: "Basil", Unit: "g", Cost: common.NewMoney(2)})
+
+	extraCheese, err := uc.CreateTopping(ctx, ToppingInput{Name: "Extra cheese", IngredientID: cheese.ID, Quantity: 30, Price: common.NewMoney(60),
+		SizePrices: map[string]common.Money{"35": common.NewMoney(90)}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	basilTop, _ := uc.CreateTopping(ctx, ToppingInput{Name: "basil", IngredientID: basil.ID, Quantity: 5, Price: common.NewMoney(20)})
+	if _, err := uc.CreateTopping(ctx, ToppingInput{Name: "Ghost", IngredientID: "missing", Quantity: 1, Price: common.NewMoney(1)}); !errors.Is(err, catalog.ErrIngredientNotFound) {
+		t.Errorf("expected ErrIngredientNotFound, got %v", err)
+	}
+
+	updated, err := uc.UpdateTopping(ctx, extraCheese.ID, ToppingInput{Name: "Mozzarella", IngredientID: cheese.ID, Quantity: 40, Price: common.NewMoney(70)})
+	if err != nil || updated.Name != "Mozzarella" || len(updated.SizePrices) != 0 {
+		t.Fatalf("unexpected update: %+v, %v", updated, err)
+	}
+	if _, err := uc.UpdateTopping(ctx, extraCheese.ID, ToppingInput{Name: "Mozzarella", IngredientID: cheese.ID}); !errors.Is(err, catalog.ErrInvalidTopping) {
+		t.Errorf("expected ErrInvalidTopping, got %v", err)
+	}
+	list, _ := uc.ListToppings(ctx)
+	if len(list) != 2 || list[0].ID != basilTop.ID {
+		t.Errorf("expected toppings ordered by name, got %+v", list)
+	}
+
+	pizza, _ := uc.CreateProduct(ctx, CreateProductInput{Name: "Pizza", Price: common.NewMoney(400)})
+	if _, err := uc.SetProductToppings(ctx, pizza.ID(), []catalog.ToppingRule{{ToppingID: "missing"}}, 0); !errors.Is(err, catalog.ErrToppingNotFound) {
+		t.Errorf("expected ErrToppingNotFound, got %v", err)
+	}
+	if _, err := uc.SetProductToppings(ctx, pizza.ID(), []catalog.ToppingRule{
+		{ToppingID: extraCheese.ID, MaxCount: 2},
+		{ToppingID: basilTop.ID},
+	}, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Топпинг без ингредиента на складе пропадает из меню товара
+	_, _ = uc.SetIngredientStock(ctx, basil.ID, false)
+	menu, err := uc.ListProductToppings(ctx, pizza.ID())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if menu.MaxToppings != 3 || len(menu.Toppings) != 1 || menu.Toppings[0].Topping.ID != extraCheese.ID || menu.Toppings[0].MaxCount != 2 {
+		t.Errorf("unexpected topping menu: %+v", menu)
+	}
+
+	if err := uc.DeleteTopping(ctx, basilTop.ID); !errors.Is(err, catalog.ErrToppingInUse) {
+		t.Errorf("expected ErrToppingInUse, got %v", err)
+	}
+	if err := uc.DeleteIngredient(ctx, cheese.ID); !errors.Is(err, catalog.ErrIngredientInUse) {
+		t.Errorf("expected ErrIngredientInUse for ingredient of a topping, got %v", err)
+	}
+
+	if _, err := uc.SetProductToppings(ctx, pizza.ID(), nil, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.DeleteTopping(ctx, basilTop.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := uc.DeleteTopping(ctx, basilTop.ID); !errors.Is(err, catalog.ErrToppingNotFound) {
+		t.Errorf("expected ErrToppingNotFound, got %v", err)
+	}
+}
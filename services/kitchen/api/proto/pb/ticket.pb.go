@@ -22,10 +22,15 @@ const (
 )
 
 type KitchenItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductId   string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity    int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Size        string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Crust       string                 `protobuf:"bytes,5,opt,name=crust,proto3" json:"crust,omitempty"`
+	// Половины пиццы "пополам", пусто для обычной позиции.
+	Halves        []*KitchenHalf `protobuf:"bytes,6,rep,name=halves,proto3" json:"halves,omitempty"`
+	Toppings      []string       `protobuf:"bytes,7,rep,name=toppings,proto3" json:"toppings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,17 +86,99 @@ func (x *KitchenItem) GetQuantity() int32 {
 	return 0
 }
 
-type CreateTicketRequest struct {
+func (x *KitchenItem) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *KitchenItem) GetCrust() string {
+	if x != nil {
+		return x.Crust
+	}
+	return ""
+}
+
+func (x *KitchenItem) GetHalves() []*KitchenHalf {
+	if x != nil {
+		return x.Halves
+	}
+	return nil
+}
+
+func (x *KitchenItem) GetToppings() []string {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+type KitchenHalf struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Items         []*KitchenItem         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitchenHalf) Reset() {
+	*x = KitchenHalf{}
+	mi := &file_ticket_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitchenHalf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitchenHalf) ProtoMessage() {}
+
+func (x *KitchenHalf) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitchenHalf.ProtoReflect.Descriptor instead.
+func (*KitchenHalf) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KitchenHalf) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *KitchenHalf) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+type CreateTicketRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items   []*KitchenItem         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Точка, которая готовит эти позиции. Заказ, разделенный между точками, дает тикет на каждую.
+	StoreId       string `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTicketRequest) Reset() {
 	*x = CreateTicketRequest{}
-	mi := &file_ticket_proto_msgTypes[1]
+	mi := &file_ticket_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -103,7 +190,7 @@ func (x *CreateTicketRequest) String() string {
 func (*CreateTicketRequest) ProtoMessage() {}
 
 func (x *CreateTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[1]
+	mi := &file_ticket_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,7 +203,7 @@ func (x *CreateTicketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTicketRequest.ProtoReflect.Descriptor instead.
 func (*CreateTicketRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{1}
+	return file_ticket_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateTicketRequest) GetOrderId() string {
@@ -133,6 +220,13 @@ func (x *CreateTicketRequest) GetItems() []*KitchenItem {
 	return nil
 }
 
+func (x *CreateTicketRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
 type UpdateTicketStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
@@ -143,7 +237,7 @@ type UpdateTicketStatusRequest struct {
 
 func (x *UpdateTicketStatusRequest) Reset() {
 	*x = UpdateTicketStatusRequest{}
-	mi := &file_ticket_proto_msgTypes[2]
+	mi := &file_ticket_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -155,7 +249,7 @@ func (x *UpdateTicketStatusRequest) String() string {
 func (*UpdateTicketStatusRequest) ProtoMessage() {}
 
 func (x *UpdateTicketStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[2]
+	mi := &file_ticket_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +262,7 @@ func (x *UpdateTicketStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTicketStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTicketStatusRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{2}
+	return file_ticket_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateTicketStatusRequest) GetTicketId() string {
@@ -185,17 +279,63 @@ func (x *UpdateTicketStatusRequest) GetStatus() int32 {
 	return 0
 }
 
+type TicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketRequest) Reset() {
+	*x = TicketRequest{}
+	mi := &file_ticket_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketRequest) ProtoMessage() {}
+
+func (x *TicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketRequest.ProtoReflect.Descriptor instead.
+func (*TicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TicketRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
 type TicketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	StoreId       string                 `protobuf:"bytes,4,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TicketResponse) Reset() {
 	*x = TicketResponse{}
-	mi := &file_ticket_proto_msgTypes[3]
+	mi := &file_ticket_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -207,7 +347,7 @@ func (x *TicketResponse) String() string {
 func (*TicketResponse) ProtoMessage() {}
 
 func (x *TicketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[3]
+	mi := &file_ticket_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +360,7 @@ func (x *TicketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TicketResponse.ProtoReflect.Descriptor instead.
 func (*TicketResponse) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{3}
+	return file_ticket_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TicketResponse) GetTicketId() string {
@@ -237,29 +377,58 @@ func (x *TicketResponse) GetStatus() string {
 	return ""
 }
 
+func (x *TicketResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *TicketResponse) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
 var File_ticket_proto protoreflect.FileDescriptor
 
 const file_ticket_proto_rawDesc = "" +
 	"\n" +
 	"\fticket.proto\x12\n" +
-	"kitchen.v1\"k\n" +
+	"kitchen.v1\"\xe2\x01\n" +
 	"\vKitchenItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"_\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\tR\x04size\x12\x14\n" +
+	"\x05crust\x18\x05 \x01(\tR\x05crust\x12/\n" +
+	"\x06halves\x18\x06 \x03(\v2\x17.kitchen.v1.KitchenHalfR\x06halves\x12\x1a\n" +
+	"\btoppings\x18\a \x03(\tR\btoppings\"O\n" +
+	"\vKitchenHalf\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\"z\n" +
 	"\x13CreateTicketRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12-\n" +
-	"\x05items\x18\x02 \x03(\v2\x17.kitchen.v1.KitchenItemR\x05items\"P\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.kitchen.v1.KitchenItemR\x05items\x12\x19\n" +
+	"\bstore_id\x18\x03 \x01(\tR\astoreId\"P\n" +
 	"\x19UpdateTicketStatusRequest\x12\x1b\n" +
 	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\"E\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\",\n" +
+	"\rTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\"{\n" +
 	"\x0eTicketResponse\x12\x1b\n" +
 	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status2\xb5\x01\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x19\n" +
+	"\bstore_id\x18\x04 \x01(\tR\astoreId2\xc0\x02\n" +
 	"\rTicketService\x12K\n" +
 	"\fCreateTicket\x12\x1f.kitchen.v1.CreateTicketRequest\x1a\x1a.kitchen.v1.TicketResponse\x12W\n" +
-	"\x12UpdateTicketStatus\x12%.kitchen.v1.UpdateTicketStatusRequest\x1a\x1a.kitchen.v1.TicketResponseB\x11Z\x0f./pb;kitchen_pbb\x06proto3"
+	"\x12UpdateTicketStatus\x12%.kitchen.v1.UpdateTicketStatusRequest\x1a\x1a.kitchen.v1.TicketResponse\x12B\n" +
+	"\tGetTicket\x12\x19.kitchen.v1.TicketRequest\x1a\x1a.kitchen.v1.TicketResponse\x12E\n" +
+	"\fCancelTicket\x12\x19.kitchen.v1.TicketRequest\x1a\x1a.kitchen.v1.TicketResponseB\x11Z\x0f./pb;kitchen_pbb\x06proto3"
 
 var (
 	file_ticket_proto_rawDescOnce sync.Once
@@ -273,24 +442,31 @@ func file_ticket_proto_rawDescGZIP() []byte {
 	return file_ticket_proto_rawDescData
 }
 
-var file_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_ticket_proto_goTypes = []any{
 	(*KitchenItem)(nil),               // 0: kitchen.v1.KitchenItem
-	(*CreateTicketRequest)(nil),       // 1: kitchen.v1.CreateTicketRequest
-	(*UpdateTicketStatusRequest)(nil), // 2: kitchen.v1.UpdateTicketStatusRequest
-	(*TicketResponse)(nil),            // 3: kitchen.v1.TicketResponse
+	(*KitchenHalf)(nil),               // 1: kitchen.v1.KitchenHalf
+	(*CreateTicketRequest)(nil),       // 2: kitchen.v1.CreateTicketRequest
+	(*UpdateTicketStatusRequest)(nil), // 3: kitchen.v1.UpdateTicketStatusRequest
+	(*TicketRequest)(nil),             // 4: kitchen.v1.TicketRequest
+	(*TicketResponse)(nil),            // 5: kitchen.v1.TicketResponse
 }
 var file_ticket_proto_depIdxs = []int32{
-	0, // 0: kitchen.v1.CreateTicketRequest.items:type_name -> kitchen.v1.KitchenItem
-	1, // 1: kitchen.v1.TicketService.CreateTicket:input_type -> kitchen.v1.CreateTicketRequest
-	2, // 2: kitchen.v1.TicketService.UpdateTicketStatus:input_type -> kitchen.v1.UpdateTicketStatusRequest
-	3, // 3: kitchen.v1.TicketService.CreateTicket:output_type -> kitchen.v1.TicketResponse
-	3, // 4: kitchen.v1.TicketService.UpdateTicketStatus:output_type -> kitchen.v1.TicketResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: kitchen.v1.KitchenItem.halves:type_name -> kitchen.v1.KitchenHalf
+	0, // 1: kitchen.v1.CreateTicketRequest.items:type_name -> kitchen.v1.KitchenItem
+	2, // 2: kitchen.v1.TicketService.CreateTicket:input_type -> kitchen.v1.CreateTicketRequest
+	3, // 3: kitchen.v1.TicketService.UpdateTicketStatus:input_type -> kitchen.v1.UpdateTicketStatusRequest
+	4, // 4: kitchen.v1.TicketService.GetTicket:input_type -> kitchen.v1.TicketRequest
+	4, // 5: kitchen.v1.TicketService.CancelTicket:input_type -> kitchen.v1.TicketRequest
+	5, // 6: kitchen.v1.TicketService.CreateTicket:output_type -> kitchen.v1.TicketResponse
+	5, // 7: kitchen.v1.TicketService.UpdateTicketStatus:output_type -> kitchen.v1.TicketResponse
+	5, // 8: kitchen.v1.TicketService.GetTicket:output_type -> kitchen.v1.TicketResponse
+	5, // 9: kitchen.v1.TicketService.CancelTicket:output_type -> kitchen.v1.TicketResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_ticket_proto_init() }
@@ -304,7 +480,7 @@ func file_ticket_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ticket_proto_rawDesc), len(file_ticket_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
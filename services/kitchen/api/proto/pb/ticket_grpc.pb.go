@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	TicketService_CreateTicket_FullMethodName       = "/kitchen.v1.TicketService/CreateTicket"
 	TicketService_UpdateTicketStatus_FullMethodName = "/kitchen.v1.TicketService/UpdateTicketStatus"
+	TicketService_GetTicket_FullMethodName          = "/kitchen.v1.TicketService/GetTicket"
+	TicketService_CancelTicket_FullMethodName       = "/kitchen.v1.TicketService/CancelTicket"
 )
 
 // TicketServiceClient is the client API for TicketService service.
@@ -29,6 +31,8 @@ const (
 type TicketServiceClient interface {
 	CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*TicketResponse, error)
 	UpdateTicketStatus(ctx context.Context, in *UpdateTicketStatusRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	GetTicket(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*TicketResponse, error)
+	CancelTicket(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*TicketResponse, error)
 }
 
 type ticketServiceClient struct {
@@ -59,12 +63,34 @@ func (c *ticketServiceClient) UpdateTicketStatus(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *ticketServiceClient) GetTicket(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelTicket(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*TicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_CancelTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TicketServiceServer is the server API for TicketService service.
 // All implementations must embed UnimplementedTicketServiceServer
 // for forward compatibility.
 type TicketServiceServer interface {
 	CreateTicket(context.Context, *CreateTicketRequest) (*TicketResponse, error)
 	UpdateTicketStatus(context.Context, *UpdateTicketStatusRequest) (*TicketResponse, error)
+	GetTicket(context.Context, *TicketRequest) (*TicketResponse, error)
+	CancelTicket(context.Context, *TicketRequest) (*TicketResponse, error)
 	mustEmbedUnimplementedTicketServiceServer()
 }
 
@@ -81,6 +107,12 @@ func (UnimplementedTicketServiceServer) CreateTicket(context.Context, *CreateTic
 func (UnimplementedTicketServiceServer) UpdateTicketStatus(context.Context, *UpdateTicketStatusRequest) (*TicketResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateTicketStatus not implemented")
 }
+func (UnimplementedTicketServiceServer) GetTicket(context.Context, *TicketRequest) (*TicketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelTicket(context.Context, *TicketRequest) (*TicketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelTicket not implemented")
+}
 func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
 func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
 
@@ -138,6 +170,42 @@ func _TicketService_UpdateTicketStatus_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicketService_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetTicket(ctx, req.(*TicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelTicket(ctx, req.(*TicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +221,14 @@ var TicketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTicketStatus",
 			Handler:    _TicketService_UpdateTicketStatus_Handler,
 		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _TicketService_GetTicket_Handler,
+		},
+		{
+			MethodName: "CancelTicket",
+			Handler:    _TicketService_CancelTicket_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ticket.proto",
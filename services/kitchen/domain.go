@@ -3,6 +3,7 @@ package kitchen
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,6 +15,13 @@ const (
 	TicketQueued  TicketStatus = 0
 	TicketCooking TicketStatus = 1
 	TicketReady   TicketStatus = 2
+	// TicketCanceled - заказ отменен, готовить не нужно.
+	TicketCanceled TicketStatus = 3
+)
+
+var (
+	ErrTicketNotCancelable = errors.New("ticket cannot be canceled")
+	ErrTicketNotFound      = errors.New("ticket not found")
 )
 
 func (s TicketStatus) String() string {
@@ -24,6 +32,8 @@ func (s TicketStatus) String() string {
 		return "cooking"
 	case TicketReady:
 		return "ready"
+	case TicketCanceled:
+		return "canceled"
 	default:
 		return "unknown"
 	}
@@ -32,6 +42,7 @@ func (s TicketStatus) String() string {
 type KitchenTicket struct {
 	id               string
 	orderID          string
+	storeID          string
 	items            []KitchenItem
 	status           TicketStatus
 	createdAt        time.Time
@@ -45,13 +56,27 @@ type KitchenItem struct {
 	Ingredients []string
 	Quantity    int
 	Comment     string
+	// Size и Crust - названия размера и теста, которые нужно приготовить.
+	Size  string
+	Crust string
+	// Halves - половины пиццы "пополам", пусто для обычной позиции.
+	Halves   []KitchenHalf
+	Toppings []string
+}
+
+// KitchenHalf - половина пиццы "пополам".
+type KitchenHalf struct {
+	ProductID string
+	Name      string
 }
 
-func NewTicket(orderID string, items []KitchenItem) *KitchenTicket {
+// NewTicket - тикет на часть заказа, которую готовит точка storeID.
+func NewTicket(orderID, storeID string, items []KitchenItem) *KitchenTicket {
 	id, _ := uuid.NewV7()
 	return &KitchenTicket{
 		id:        id.String(),
 		orderID:   orderID,
+		storeID:   storeID,
 		items:     items,
 		status:    TicketQueued,
 		createdAt: time.Now(),
@@ -76,6 +101,15 @@ func (t *KitchenTicket) MarkReady() error {
 	return nil
 }
 
+// Cancel - снятие тикета с кухни. Готовый тикет уже не отменить.
+func (t *KitchenTicket) Cancel() error {
+	if t.status != TicketQueued && t.status != TicketCooking {
+		return fmt.Errorf("%w: ticket is %s", ErrTicketNotCancelable, t.status)
+	}
+	t.status = TicketCanceled
+	return nil
+}
+
 func (t *KitchenTicket) GetCookingDuration() time.Duration {
 	if t.readyTime.IsZero() || t.startCookingTime.IsZero() {
 		return 0
@@ -85,6 +119,7 @@ func (t *KitchenTicket) GetCookingDuration() time.Duration {
 
 func (t *KitchenTicket) ID() string           { return t.id }
 func (t *KitchenTicket) OrderID() string      { return t.orderID }
+func (t *KitchenTicket) StoreID() string      { return t.storeID }
 func (t *KitchenTicket) Status() TicketStatus { return t.status }
 func (t *KitchenTicket) Items() []KitchenItem { return t.items }
 func (t *KitchenTicket) CreatedAt() time.Time { return t.createdAt }
@@ -95,4 +130,6 @@ type TicketRepository interface {
 	Save(ctx context.Context, t *KitchenTicket) error
 	FindPending(ctx context.Context) ([]*KitchenTicket, error)
 	FindByID(ctx context.Context, id string) (*KitchenTicket, error)
+	// FindByOrder - тикет точки storeID по заказу, у заказа может быть по тикету на каждую точку.
+	FindByOrder(ctx context.Context, orderID, storeID string) (*KitchenTicket, error)
 }
@@ -0,0 +1,39 @@
+package kitchen
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TopicTicketUpdated - тема события об изменении состояния тикета.
+const TopicTicketUpdated = "kitchen.ticket.updated"
+
+// TicketEvent - изменение тикета для других сервисов, например отслеживания заказа клиентом.
+type TicketEvent struct {
+	EventID    string    `json:"event_id"`
+	TicketID   string    `json:"ticket_id"`
+	OrderID    string    `json:"order_id"`
+	StoreID    string    `json:"store_id"`
+	Status     string    `json:"status"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewTicketEvent(t *KitchenTicket) TicketEvent {
+	id, _ := uuid.NewV7()
+	return TicketEvent{
+		EventID:    id.String(),
+		TicketID:   t.ID(),
+		OrderID:    t.OrderID(),
+		StoreID:    t.StoreID(),
+		Status:     t.Status().String(),
+		OccurredAt: time.Now(),
+	}
+}
+
+// EventPublisher - публикация событий кухни. Доставка не гарантируется: оркестратор заказа
+// по-прежнему опрашивает тикеты, события только сокращают задержку для клиента.
+type EventPublisher interface {
+	PublishTicket(ctx context.Context, e TicketEvent)
+}
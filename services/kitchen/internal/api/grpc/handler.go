@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/versoit/diploma/services/kitchen"
 	kitchen_pb "github.com/versoit/diploma/services/kitchen/api/proto/pb"
@@ -25,24 +26,72 @@ func (h *KitchenHandler) Register(server *grpc.Server) {
 func (h *KitchenHandler) CreateTicket(ctx context.Context, req *kitchen_pb.CreateTicketRequest) (*kitchen_pb.TicketResponse, error) {
 	items := make([]kitchen.KitchenItem, len(req.Items))
 	for i, item := range req.Items {
-		items[i] = kitchen.KitchenItem{
-			ProductID: item.ProductId,
-			Name:      item.ProductName,
-			Quantity:  int(item.Quantity),
-		}
+		items[i] = toKitchenItem(item)
 	}
 
-	ticket, err := h.uc.AcceptOrder(ctx, req.OrderId, items)
+	ticket, err := h.uc.AcceptOrder(ctx, req.OrderId, req.StoreId, items)
 	if err != nil {
 		return nil, err
 	}
 
-	return &kitchen_pb.TicketResponse{
-		TicketId: ticket.ID(),
-		Status:   ticket.Status().String(),
-	}, nil
+	return toTicketResponse(ticket), nil
 }
 
 func (h *KitchenHandler) UpdateTicketStatus(ctx context.Context, req *kitchen_pb.UpdateTicketStatusRequest) (*kitchen_pb.TicketResponse, error) {
-	return &kitchen_pb.TicketResponse{TicketId: req.TicketId}, nil
-}
\ No newline at end of file
+	var err error
+	switch kitchen.TicketStatus(req.Status) {
+	case kitchen.TicketCooking:
+		err = h.uc.StartCooking(ctx, req.TicketId)
+	case kitchen.TicketReady:
+		err = h.uc.MarkReady(ctx, req.TicketId)
+	case kitchen.TicketCanceled:
+		_, err = h.uc.CancelTicket(ctx, req.TicketId)
+	default:
+		err = fmt.Errorf("%w: unsupported ticket status %d", usecase.ErrInvalidInput, req.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return h.GetTicket(ctx, &kitchen_pb.TicketRequest{TicketId: req.TicketId})
+}
+
+func (h *KitchenHandler) GetTicket(ctx context.Context, req *kitchen_pb.TicketRequest) (*kitchen_pb.TicketResponse, error) {
+	ticket, err := h.uc.GetTicket(ctx, req.TicketId)
+	if err != nil {
+		return nil, err
+	}
+	return toTicketResponse(ticket), nil
+}
+
+func (h *KitchenHandler) CancelTicket(ctx context.Context, req *kitchen_pb.TicketRequest) (*kitchen_pb.TicketResponse, error) {
+	ticket, err := h.uc.CancelTicket(ctx, req.TicketId)
+	if err != nil {
+		return nil, err
+	}
+	return toTicketResponse(ticket), nil
+}
+
+func toTicketResponse(t *kitchen.KitchenTicket) *kitchen_pb.TicketResponse {
+	return &kitchen_pb.TicketResponse{
+		TicketId: t.ID(),
+		Status:   t.Status().String(),
+		OrderId:  t.OrderID(),
+		StoreId:  t.StoreID(),
+	}
+}
+
+func toKitchenItem(item *kitchen_pb.KitchenItem) kitchen.KitchenItem {
+	res := kitchen.KitchenItem{
+		ProductID: item.ProductId,
+		Name:      item.ProductName,
+		Quantity:  int(item.Quantity),
+		Size:      item.Size,
+		Crust:     item.Crust,
+		Toppings:  item.Toppings,
+	}
+	for _, h := range item.Halves {
+		res.Halves = append(res.Halves, kitchen.KitchenHalf{ProductID: h.ProductId, Name: h.ProductName})
+	}
+	return res
+}
@@ -1,16 +1,46 @@
 package app
 
 import (
+	"context"
+	"os"
+
+	"github.com/versoit/diploma/services/kitchen"
 	"github.com/versoit/diploma/services/kitchen/internal/api/grpc"
+	"github.com/versoit/diploma/services/kitchen/internal/broker"
 	"github.com/versoit/diploma/services/kitchen/internal/repository"
 	"github.com/versoit/diploma/services/kitchen/usecase"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 var Module = fx.Options(
 	fx.Provide(
 		repository.NewInMemoryTicketRepository,
+		NewEventPublisher,
 		usecase.NewKitchenUseCase,
 		grpc.NewKitchenHandler,
 	),
 )
+
+// NewEventPublisher - события тикетов в NATS, если задан KITCHEN_NATS_URL.
+func NewEventPublisher(lc fx.Lifecycle, logger *zap.Logger) (kitchen.EventPublisher, error) {
+	url := os.Getenv("KITCHEN_NATS_URL")
+	if url == "" {
+		return broker.NoopPublisher{}, nil
+	}
+	prefix, ok := os.LookupEnv("KITCHEN_NATS_SUBJECT_PREFIX")
+	if !ok {
+		prefix = "pizza."
+	}
+
+	publisher, err := broker.NewNATSPublisher(url, prefix, logger)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return publisher.Close()
+		},
+	})
+	return publisher, nil
+}
@@ -0,0 +1,54 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/versoit/diploma/services/kitchen"
+	"go.uber.org/zap"
+)
+
+// NATSPublisher - публикация событий кухни в NATS. Тема - префикс и тема события,
+// ID события передается в заголовке Nats-Msg-Id для дедупликации в JetStream.
+type NATSPublisher struct {
+	conn   *nats.Conn
+	prefix string
+	logger *zap.Logger
+}
+
+func NewNATSPublisher(url, subjectPrefix string, logger *zap.Logger) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("kitchen-events"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats %s: %w", url, err)
+	}
+	return &NATSPublisher{conn: conn, prefix: subjectPrefix, logger: logger}, nil
+}
+
+// PublishTicket - ошибка только логируется: тикет уже сохранен, а оркестратор заказа
+// узнает его состояние опросом.
+func (p *NATSPublisher) PublishTicket(ctx context.Context, e kitchen.TicketEvent) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		p.logger.Warn("Failed to encode ticket event", zap.String("ticket_id", e.TicketID), zap.Error(err))
+		return
+	}
+
+	m := nats.NewMsg(p.prefix + kitchen.TopicTicketUpdated)
+	m.Data = data
+	m.Header.Set(nats.MsgIdHdr, e.EventID)
+	m.Header.Set("Order-Id", e.OrderID)
+	if err := p.conn.PublishMsg(m); err != nil {
+		p.logger.Warn("Failed to publish ticket event", zap.String("ticket_id", e.TicketID), zap.Error(err))
+	}
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+// NoopPublisher - события никуда не отправляются, когда брокер не настроен.
+type NoopPublisher struct{}
+
+func (NoopPublisher) PublishTicket(ctx context.Context, e kitchen.TicketEvent) {}
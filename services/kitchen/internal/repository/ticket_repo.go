@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"fmt"
 	"sync"
 
 	"github.com/versoit/diploma/services/kitchen"
@@ -31,7 +30,7 @@ func (r *InMemoryTicketRepository) FindByID(ctx context.Context, id string) (*ki
 	defer r.mu.RUnlock()
 	t, ok := r.store[id]
 	if !ok {
-		return nil, fmt.Errorf("ticket not found")
+		return nil, kitchen.ErrTicketNotFound
 	}
 	return t, nil
 }
@@ -47,3 +46,14 @@ func (r *InMemoryTicketRepository) FindPending(ctx context.Context) ([]*kitchen.
 	}
 	return list, nil
 }
+
+func (r *InMemoryTicketRepository) FindByOrder(ctx context.Context, orderID, storeID string) (*kitchen.KitchenTicket, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, t := range r.store {
+		if t.OrderID() == orderID && t.StoreID() == storeID {
+			return t, nil
+		}
+	}
+	return nil, kitchen.ErrTicketNotFound
+}
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE kitchen_items
+    ADD COLUMN IF NOT EXISTS size VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS crust VARCHAR(100);
+
+CREATE TABLE IF NOT EXISTS kitchen_item_halves (
+    kitchen_item_id BIGINT REFERENCES kitchen_items(id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    product_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    PRIMARY KEY (kitchen_item_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS kitchen_item_toppings (
+    id BIGSERIAL PRIMARY KEY,
+    kitchen_item_id BIGINT REFERENCES kitchen_items(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS kitchen_item_toppings;
+DROP TABLE IF EXISTS kitchen_item_halves;
+ALTER TABLE kitchen_items
+    DROP COLUMN IF EXISTS size,
+    DROP COLUMN IF EXISTS crust;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE kitchen_tickets
+    ADD COLUMN IF NOT EXISTS store_id VARCHAR(50) NOT NULL DEFAULT '';
+
+ALTER TABLE kitchen_tickets DROP CONSTRAINT IF EXISTS kitchen_tickets_order_id_key;
+ALTER TABLE kitchen_tickets
+    ADD CONSTRAINT kitchen_tickets_order_store_key UNIQUE (order_id, store_id);
+CREATE INDEX IF NOT EXISTS idx_kitchen_tickets_store ON kitchen_tickets(store_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_kitchen_tickets_store;
+ALTER TABLE kitchen_tickets DROP CONSTRAINT IF EXISTS kitchen_tickets_order_store_key;
+ALTER TABLE kitchen_tickets
+    ADD CONSTRAINT kitchen_tickets_order_id_key UNIQUE (order_id);
+ALTER TABLE kitchen_tickets DROP COLUMN IF EXISTS store_id;
+-- +goose StatementEnd
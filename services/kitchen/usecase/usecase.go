@@ -13,14 +13,16 @@ var (
 )
 
 type KitchenUseCase struct {
-	repo kitchen.TicketRepository
+	repo   kitchen.TicketRepository
+	events kitchen.EventPublisher
 }
 
-func NewKitchenUseCase(repo kitchen.TicketRepository) *KitchenUseCase {
-	return &KitchenUseCase{repo: repo}
+func NewKitchenUseCase(repo kitchen.TicketRepository, events kitchen.EventPublisher) *KitchenUseCase {
+	return &KitchenUseCase{repo: repo, events: events}
 }
 
-func (uc *KitchenUseCase) AcceptOrder(ctx context.Context, orderID string, items []kitchen.KitchenItem) (*kitchen.KitchenTicket, error) {
+// AcceptOrder - тикет на позиции заказа, которые готовит точка storeID.
+func (uc *KitchenUseCase) AcceptOrder(ctx context.Context, orderID, storeID string, items []kitchen.KitchenItem) (*kitchen.KitchenTicket, error) {
 	if orderID == "" {
 		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
 	}
@@ -28,11 +30,21 @@ func (uc *KitchenUseCase) AcceptOrder(ctx context.Context, orderID string, items
 		return nil, fmt.Errorf("%w: ticket must contain items", ErrInvalidInput)
 	}
 
-	ticket := kitchen.NewTicket(orderID, items)
+	// Повторная передача заказа (ретрай оркестратора) не создает второй тикет
+	existing, err := uc.repo.FindByOrder(ctx, orderID, storeID)
+	switch {
+	case err == nil && existing.Status() != kitchen.TicketCanceled:
+		return existing, nil
+	case err != nil && !errors.Is(err, kitchen.ErrTicketNotFound):
+		return nil, fmt.Errorf("failed to find kitchen ticket of order %s: %w", orderID, err)
+	}
+
+	ticket := kitchen.NewTicket(orderID, storeID, items)
 
 	if err := uc.repo.Save(ctx, ticket); err != nil {
 		return nil, fmt.Errorf("failed to create kitchen ticket: %w", err)
 	}
+	uc.events.PublishTicket(ctx, kitchen.NewTicketEvent(ticket))
 
 	return ticket, nil
 }
@@ -54,6 +66,7 @@ func (uc *KitchenUseCase) StartCooking(ctx context.Context, ticketID string) err
 	if err := uc.repo.Save(ctx, ticket); err != nil {
 		return fmt.Errorf("failed to update ticket status to cooking: %w", err)
 	}
+	uc.events.PublishTicket(ctx, kitchen.NewTicketEvent(ticket))
 
 	return nil
 }
@@ -75,6 +88,41 @@ func (uc *KitchenUseCase) MarkReady(ctx context.Context, ticketID string) error
 	if err := uc.repo.Save(ctx, ticket); err != nil {
 		return fmt.Errorf("failed to update ticket status to ready: %w", err)
 	}
+	uc.events.PublishTicket(ctx, kitchen.NewTicketEvent(ticket))
 
 	return nil
 }
+
+func (uc *KitchenUseCase) GetTicket(ctx context.Context, ticketID string) (*kitchen.KitchenTicket, error) {
+	if ticketID == "" {
+		return nil, fmt.Errorf("%w: ticket ID is required", ErrInvalidInput)
+	}
+
+	ticket, err := uc.repo.FindByID(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find kitchen ticket %s: %w", ticketID, err)
+	}
+	return ticket, nil
+}
+
+// CancelTicket - снимает тикет отмененного заказа. Повторная отмена не считается ошибкой.
+func (uc *KitchenUseCase) CancelTicket(ctx context.Context, ticketID string) (*kitchen.KitchenTicket, error) {
+	ticket, err := uc.GetTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.Status() == kitchen.TicketCanceled {
+		return ticket, nil
+	}
+
+	if err := ticket.Cancel(); err != nil {
+		return nil, fmt.Errorf("could not cancel ticket %s: %w", ticketID, err)
+	}
+
+	if err := uc.repo.Save(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("failed to update ticket status to canceled: %w", err)
+	}
+	uc.events.PublishTicket(ctx, kitchen.NewTicketEvent(ticket))
+
+	return ticket, nil
+}
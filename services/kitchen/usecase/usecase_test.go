@@ -2,7 +2,7 @@ package usecase
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"testing"
 
 	"github.com/versoit/diploma/services/kitchen"
@@ -21,19 +21,36 @@ func (m *MockTicketRepo) FindByID(ctx context.Context, id string) (*kitchen.Kitc
 	if t, ok := m.store[id]; ok {
 		return t, nil
 	}
-	return nil, fmt.Errorf("ticket not found")
+	return nil, kitchen.ErrTicketNotFound
+}
+
+func (m *MockTicketRepo) FindByOrder(ctx context.Context, orderID, storeID string) (*kitchen.KitchenTicket, error) {
+	for _, t := range m.store {
+		if t.OrderID() == orderID && t.StoreID() == storeID {
+			return t, nil
+		}
+	}
+	return nil, kitchen.ErrTicketNotFound
 }
 
 func (m *MockTicketRepo) FindPending(ctx context.Context) ([]*kitchen.KitchenTicket, error) {
 	return nil, nil
 }
 
+type MockPublisher struct {
+	events []kitchen.TicketEvent
+}
+
+func (m *MockPublisher) PublishTicket(ctx context.Context, e kitchen.TicketEvent) {
+	m.events = append(m.events, e)
+}
+
 func TestKitchenUseCase_AcceptOrder(t *testing.T) {
 	repo := &MockTicketRepo{store: make(map[string]*kitchen.KitchenTicket)}
-	uc := NewKitchenUseCase(repo)
+	uc := NewKitchenUseCase(repo, &MockPublisher{})
 
 	items := []kitchen.KitchenItem{{Name: "Pizza", Quantity: 1}}
-	ticket, err := uc.AcceptOrder(context.Background(), "order-123", items)
+	ticket, err := uc.AcceptOrder(context.Background(), "order-123", "main", items)
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -44,11 +61,30 @@ func TestKitchenUseCase_AcceptOrder(t *testing.T) {
 	}
 }
 
+func TestKitchenUseCase_AcceptOrderPerStore(t *testing.T) {
+	repo := &MockTicketRepo{store: make(map[string]*kitchen.KitchenTicket)}
+	uc := NewKitchenUseCase(repo, &MockPublisher{})
+	ctx := context.Background()
+	items := []kitchen.KitchenItem{{Name: "Pizza", Quantity: 1}}
+
+	north, err := uc.AcceptOrder(ctx, "order-1", "north", items)
+	if err != nil {
+		t.Fatalf("failed to accept: %v", err)
+	}
+	south, err := uc.AcceptOrder(ctx, "order-1", "south", items)
+	if err != nil {
+		t.Fatalf("failed to accept: %v", err)
+	}
+	if north.ID() == south.ID() || south.StoreID() != "south" {
+		t.Errorf("expected separate ticket per store, got %s and %s", north.ID(), south.ID())
+	}
+}
+
 func TestKitchenUseCase_CookingFlow(t *testing.T) {
 	repo := &MockTicketRepo{store: make(map[string]*kitchen.KitchenTicket)}
-	uc := NewKitchenUseCase(repo)
+	uc := NewKitchenUseCase(repo, &MockPublisher{})
 
-	ticket, err := uc.AcceptOrder(context.Background(), "ord-1", []kitchen.KitchenItem{{Name: "P"}})
+	ticket, err := uc.AcceptOrder(context.Background(), "ord-1", "main", []kitchen.KitchenItem{{Name: "P"}})
 	if err != nil {
 		t.Fatalf("setup failed: %v", err)
 	}
@@ -67,3 +103,88 @@ func TestKitchenUseCase_CookingFlow(t *testing.T) {
 		t.Errorf("expected cooking status, got %v", saved.Status())
 	}
 }
+
+func TestKitchenUseCase_CancelTicket(t *testing.T) {
+	repo := &MockTicketRepo{store: make(map[string]*kitchen.KitchenTicket)}
+	uc := NewKitchenUseCase(repo, &MockPublisher{})
+	ctx := context.Background()
+	items := []kitchen.KitchenItem{{ProductID: "p1", Name: "Pizza", Quantity: 1}}
+
+	ticket, err := uc.AcceptOrder(ctx, "order-1", "main", items)
+	if err != nil {
+		t.Fatalf("failed to accept: %v", err)
+	}
+	again, err := uc.AcceptOrder(ctx, "order-1", "main", items)
+	if err != nil || again.ID() != ticket.ID() {
+		t.Fatalf("repeated accept should return existing ticket: %v", err)
+	}
+
+	for range 2 {
+		canceled, err := uc.CancelTicket(ctx, ticket.ID())
+		if err != nil {
+			t.Fatalf("failed to cancel: %v", err)
+		}
+		if canceled.Status() != kitchen.TicketCanceled {
+			t.Errorf("expected canceled, got %s", canceled.Status())
+		}
+	}
+	if err := uc.StartCooking(ctx, ticket.ID()); err == nil {
+		t.Error("canceled ticket must not be cooked")
+	}
+
+	ready, _ := uc.AcceptOrder(ctx, "order-2", "main", items)
+	_ = uc.StartCooking(ctx, ready.ID())
+	_ = uc.MarkReady(ctx, ready.ID())
+	if _, err := uc.CancelTicket(ctx, ready.ID()); !errors.Is(err, kitchen.ErrTicketNotCancelable) {
+		t.Errorf("expected ErrTicketNotCancelable, got %v", err)
+	}
+}
+
+// brokenLookupRepo - поиск тикета по заказу падает, например, при недоступной базе.
+type brokenLookupRepo struct {
+	MockTicketRepo
+}
+
+func (r *brokenLookupRepo) FindByOrder(ctx context.Context, orderID, storeID string) (*kitchen.KitchenTicket, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestKitchenUseCase_AcceptOrderLookupFailure(t *testing.T) {
+	repo := &brokenLookupRepo{MockTicketRepo{store: make(map[string]*kitchen.KitchenTicket)}}
+	uc := NewKitchenUseCase(repo, &MockPublisher{})
+
+	items := []kitchen.KitchenItem{{Name: "Pizza", Quantity: 1}}
+	if _, err := uc.AcceptOrder(context.Background(), "order-123", "main", items); err == nil {
+		t.Fatal("expected lookup error to be returned")
+	}
+	if len(repo.store) != 0 {
+		t.Errorf("ticket must not be created when lookup fails, got %d", len(repo.store))
+	}
+}
+
+func TestKitchenUseCase_PublishesTicketEvents(t *testing.T) {
+	repo := &MockTicketRepo{store: make(map[string]*kitchen.KitchenTicket)}
+	events := &MockPublisher{}
+	uc := NewKitchenUseCase(repo, events)
+	ctx := context.Background()
+
+	ticket, err := uc.AcceptOrder(ctx, "order-1", "north", []kitchen.KitchenItem{{Name: "P"}})
+	if err != nil {
+		t.Fatalf("failed to accept: %v", err)
+	}
+	// Повторная передача заказа ничего не меняет и не публикуется
+	_, _ = uc.AcceptOrder(ctx, "order-1", "north", []kitchen.KitchenItem{{Name: "P"}})
+	_ = uc.StartCooking(ctx, ticket.ID())
+	_ = uc.MarkReady(ctx, ticket.ID())
+
+	var statuses []string
+	for _, e := range events.events {
+		if e.TicketID != ticket.ID() || e.OrderID != "order-1" || e.StoreID != "north" {
+			t.Errorf("unexpected event: %+v", e)
+		}
+		statuses = append(statuses, e.Status)
+	}
+	if len(statuses) != 3 || statuses[0] != "queued" || statuses[1] != "cooking" || statuses[2] != "ready" {
+		t.Errorf("expected queued, cooking, ready events, got %v", statuses)
+	}
+}
@@ -22,8 +22,12 @@ const (
 )
 
 type CreateDeliveryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Точки, где курьер забирает части заказа.
+	StoreIds []string `protobuf:"bytes,2,rep,name=store_ids,json=storeIds,proto3" json:"store_ids,omitempty"`
+	// Чаевые клиента курьеру.
+	Tip           float64 `protobuf:"fixed64,3,opt,name=tip,proto3" json:"tip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,6 +69,20 @@ func (x *CreateDeliveryRequest) GetOrderId() string {
 	return ""
 }
 
+func (x *CreateDeliveryRequest) GetStoreIds() []string {
+	if x != nil {
+		return x.StoreIds
+	}
+	return nil
+}
+
+func (x *CreateDeliveryRequest) GetTip() float64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
 type UpdateLocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -125,17 +143,67 @@ func (x *UpdateLocationRequest) GetLng() float64 {
 	return 0
 }
 
-type DeliveryResponse struct {
+type DeliveryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryRequest) Reset() {
+	*x = DeliveryRequest{}
+	mi := &file_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryRequest) ProtoMessage() {}
+
+func (x *DeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryRequest.ProtoReflect.Descriptor instead.
+func (*DeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeliveryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type DeliveryResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status    string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CourierId string                 `protobuf:"bytes,3,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	StoreIds  []string               `protobuf:"bytes,4,rep,name=store_ids,json=storeIds,proto3" json:"store_ids,omitempty"`
+	// Последние координаты курьера, 0,0 - еще не передавались.
+	Lat           float64 `protobuf:"fixed64,5,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64 `protobuf:"fixed64,6,opt,name=lng,proto3" json:"lng,omitempty"`
+	Tip           float64 `protobuf:"fixed64,7,opt,name=tip,proto3" json:"tip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeliveryResponse) Reset() {
 	*x = DeliveryResponse{}
-	mi := &file_delivery_proto_msgTypes[2]
+	mi := &file_delivery_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +215,7 @@ func (x *DeliveryResponse) String() string {
 func (*DeliveryResponse) ProtoMessage() {}
 
 func (x *DeliveryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_delivery_proto_msgTypes[2]
+	mi := &file_delivery_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +228,7 @@ func (x *DeliveryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeliveryResponse.ProtoReflect.Descriptor instead.
 func (*DeliveryResponse) Descriptor() ([]byte, []int) {
-	return file_delivery_proto_rawDescGZIP(), []int{2}
+	return file_delivery_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DeliveryResponse) GetOrderId() string {
@@ -177,23 +245,196 @@ func (x *DeliveryResponse) GetStatus() string {
 	return ""
 }
 
+func (x *DeliveryResponse) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *DeliveryResponse) GetStoreIds() []string {
+	if x != nil {
+		return x.StoreIds
+	}
+	return nil
+}
+
+func (x *DeliveryResponse) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *DeliveryResponse) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+func (x *DeliveryResponse) GetTip() float64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+type CourierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourierRequest) Reset() {
+	*x = CourierRequest{}
+	mi := &file_delivery_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourierRequest) ProtoMessage() {}
+
+func (x *CourierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourierRequest.ProtoReflect.Descriptor instead.
+func (*CourierRequest) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CourierRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+type CourierResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CourierId string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status    string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Чаевые за все доставленные заказы.
+	TipsEarned    float64 `protobuf:"fixed64,4,opt,name=tips_earned,json=tipsEarned,proto3" json:"tips_earned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourierResponse) Reset() {
+	*x = CourierResponse{}
+	mi := &file_delivery_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourierResponse) ProtoMessage() {}
+
+func (x *CourierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delivery_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourierResponse.ProtoReflect.Descriptor instead.
+func (*CourierResponse) Descriptor() ([]byte, []int) {
+	return file_delivery_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CourierResponse) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *CourierResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourierResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CourierResponse) GetTipsEarned() float64 {
+	if x != nil {
+		return x.TipsEarned
+	}
+	return 0
+}
+
 var File_delivery_proto protoreflect.FileDescriptor
 
 const file_delivery_proto_rawDesc = "" +
 	"\n" +
-	"\x0edelivery.proto\x12\flogistics.v1\"2\n" +
+	"\x0edelivery.proto\x12\flogistics.v1\"a\n" +
 	"\x15CreateDeliveryRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"V\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tstore_ids\x18\x02 \x03(\tR\bstoreIds\x12\x10\n" +
+	"\x03tip\x18\x03 \x01(\x01R\x03tip\"V\n" +
 	"\x15UpdateLocationRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x10\n" +
 	"\x03lat\x18\x02 \x01(\x01R\x03lat\x12\x10\n" +
-	"\x03lng\x18\x03 \x01(\x01R\x03lng\"E\n" +
+	"\x03lng\x18\x03 \x01(\x01R\x03lng\",\n" +
+	"\x0fDeliveryRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\xb7\x01\n" +
 	"\x10DeliveryResponse\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status2\xbf\x01\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x03 \x01(\tR\tcourierId\x12\x1b\n" +
+	"\tstore_ids\x18\x04 \x03(\tR\bstoreIds\x12\x10\n" +
+	"\x03lat\x18\x05 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x06 \x01(\x01R\x03lng\x12\x10\n" +
+	"\x03tip\x18\a \x01(\x01R\x03tip\"/\n" +
+	"\x0eCourierRequest\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\"}\n" +
+	"\x0fCourierResponse\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1f\n" +
+	"\vtips_earned\x18\x04 \x01(\x01R\n" +
+	"tipsEarned2\xfc\x03\n" +
 	"\x0fDeliveryService\x12U\n" +
 	"\x0eCreateDelivery\x12#.logistics.v1.CreateDeliveryRequest\x1a\x1e.logistics.v1.DeliveryResponse\x12U\n" +
-	"\x0eUpdateLocation\x12#.logistics.v1.UpdateLocationRequest\x1a\x1e.logistics.v1.DeliveryResponseB\x13Z\x11./pb;logistics_pbb\x06proto3"
+	"\x0eUpdateLocation\x12#.logistics.v1.UpdateLocationRequest\x1a\x1e.logistics.v1.DeliveryResponse\x12L\n" +
+	"\vGetDelivery\x12\x1d.logistics.v1.DeliveryRequest\x1a\x1e.logistics.v1.DeliveryResponse\x12O\n" +
+	"\x0ePickupDelivery\x12\x1d.logistics.v1.DeliveryRequest\x1a\x1e.logistics.v1.DeliveryResponse\x12Q\n" +
+	"\x10CompleteDelivery\x12\x1d.logistics.v1.DeliveryRequest\x1a\x1e.logistics.v1.DeliveryResponse\x12I\n" +
+	"\n" +
+	"GetCourier\x12\x1c.logistics.v1.CourierRequest\x1a\x1d.logistics.v1.CourierResponseB\x13Z\x11./pb;logistics_pbb\x06proto3"
 
 var (
 	file_delivery_proto_rawDescOnce sync.Once
@@ -207,19 +448,30 @@ func file_delivery_proto_rawDescGZIP() []byte {
 	return file_delivery_proto_rawDescData
 }
 
-var file_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_delivery_proto_goTypes = []any{
 	(*CreateDeliveryRequest)(nil), // 0: logistics.v1.CreateDeliveryRequest
 	(*UpdateLocationRequest)(nil), // 1: logistics.v1.UpdateLocationRequest
-	(*DeliveryResponse)(nil),      // 2: logistics.v1.DeliveryResponse
+	(*DeliveryRequest)(nil),       // 2: logistics.v1.DeliveryRequest
+	(*DeliveryResponse)(nil),      // 3: logistics.v1.DeliveryResponse
+	(*CourierRequest)(nil),        // 4: logistics.v1.CourierRequest
+	(*CourierResponse)(nil),       // 5: logistics.v1.CourierResponse
 }
 var file_delivery_proto_depIdxs = []int32{
 	0, // 0: logistics.v1.DeliveryService.CreateDelivery:input_type -> logistics.v1.CreateDeliveryRequest
 	1, // 1: logistics.v1.DeliveryService.UpdateLocation:input_type -> logistics.v1.UpdateLocationRequest
-	2, // 2: logistics.v1.DeliveryService.CreateDelivery:output_type -> logistics.v1.DeliveryResponse
-	2, // 3: logistics.v1.DeliveryService.UpdateLocation:output_type -> logistics.v1.DeliveryResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	2, // 2: logistics.v1.DeliveryService.GetDelivery:input_type -> logistics.v1.DeliveryRequest
+	2, // 3: logistics.v1.DeliveryService.PickupDelivery:input_type -> logistics.v1.DeliveryRequest
+	2, // 4: logistics.v1.DeliveryService.CompleteDelivery:input_type -> logistics.v1.DeliveryRequest
+	4, // 5: logistics.v1.DeliveryService.GetCourier:input_type -> logistics.v1.CourierRequest
+	3, // 6: logistics.v1.DeliveryService.CreateDelivery:output_type -> logistics.v1.DeliveryResponse
+	3, // 7: logistics.v1.DeliveryService.UpdateLocation:output_type -> logistics.v1.DeliveryResponse
+	3, // 8: logistics.v1.DeliveryService.GetDelivery:output_type -> logistics.v1.DeliveryResponse
+	3, // 9: logistics.v1.DeliveryService.PickupDelivery:output_type -> logistics.v1.DeliveryResponse
+	3, // 10: logistics.v1.DeliveryService.CompleteDelivery:output_type -> logistics.v1.DeliveryResponse
+	5, // 11: logistics.v1.DeliveryService.GetCourier:output_type -> logistics.v1.CourierResponse
+	6, // [6:12] is the sub-list for method output_type
+	0, // [0:6] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -236,7 +488,7 @@ func file_delivery_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_delivery_proto_rawDesc), len(file_delivery_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
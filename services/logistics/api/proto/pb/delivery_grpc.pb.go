@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DeliveryService_CreateDelivery_FullMethodName = "/logistics.v1.DeliveryService/CreateDelivery"
-	DeliveryService_UpdateLocation_FullMethodName = "/logistics.v1.DeliveryService/UpdateLocation"
+	DeliveryService_CreateDelivery_FullMethodName   = "/logistics.v1.DeliveryService/CreateDelivery"
+	DeliveryService_UpdateLocation_FullMethodName   = "/logistics.v1.DeliveryService/UpdateLocation"
+	DeliveryService_GetDelivery_FullMethodName      = "/logistics.v1.DeliveryService/GetDelivery"
+	DeliveryService_PickupDelivery_FullMethodName   = "/logistics.v1.DeliveryService/PickupDelivery"
+	DeliveryService_CompleteDelivery_FullMethodName = "/logistics.v1.DeliveryService/CompleteDelivery"
+	DeliveryService_GetCourier_FullMethodName       = "/logistics.v1.DeliveryService/GetCourier"
 )
 
 // DeliveryServiceClient is the client API for DeliveryService service.
@@ -29,6 +33,11 @@ const (
 type DeliveryServiceClient interface {
 	CreateDelivery(ctx context.Context, in *CreateDeliveryRequest, opts ...grpc.CallOption) (*DeliveryResponse, error)
 	UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*DeliveryResponse, error)
+	GetDelivery(ctx context.Context, in *DeliveryRequest, opts ...grpc.CallOption) (*DeliveryResponse, error)
+	PickupDelivery(ctx context.Context, in *DeliveryRequest, opts ...grpc.CallOption) (*DeliveryResponse, error)
+	// Вручение заказа, чаевые зачисляются курьеру.
+	CompleteDelivery(ctx context.Context, in *DeliveryRequest, opts ...grpc.CallOption) (*DeliveryResponse, error)
+	GetCourier(ctx context.Context, in *CourierRequest, opts ...grpc.CallOption) (*CourierResponse, error)
 }
 
 type deliveryServiceClient struct {
@@ -59,12 +68,57 @@ func (c *deliveryServiceClient) UpdateLocation(ctx context.Context, in *UpdateLo
 	return out, nil
 }
 
+func (c *deliveryServiceClient) GetDelivery(ctx context.Context, in *DeliveryRequest, opts ...grpc.CallOption) (*DeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliveryResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_GetDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryServiceClient) PickupDelivery(ctx context.Context, in *DeliveryRequest, opts ...grpc.CallOption) (*DeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliveryResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_PickupDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryServiceClient) CompleteDelivery(ctx context.Context, in *DeliveryRequest, opts ...grpc.CallOption) (*DeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliveryResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_CompleteDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryServiceClient) GetCourier(ctx context.Context, in *CourierRequest, opts ...grpc.CallOption) (*CourierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourierResponse)
+	err := c.cc.Invoke(ctx, DeliveryService_GetCourier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeliveryServiceServer is the server API for DeliveryService service.
 // All implementations must embed UnimplementedDeliveryServiceServer
 // for forward compatibility.
 type DeliveryServiceServer interface {
 	CreateDelivery(context.Context, *CreateDeliveryRequest) (*DeliveryResponse, error)
 	UpdateLocation(context.Context, *UpdateLocationRequest) (*DeliveryResponse, error)
+	GetDelivery(context.Context, *DeliveryRequest) (*DeliveryResponse, error)
+	PickupDelivery(context.Context, *DeliveryRequest) (*DeliveryResponse, error)
+	// Вручение заказа, чаевые зачисляются курьеру.
+	CompleteDelivery(context.Context, *DeliveryRequest) (*DeliveryResponse, error)
+	GetCourier(context.Context, *CourierRequest) (*CourierResponse, error)
 	mustEmbedUnimplementedDeliveryServiceServer()
 }
 
@@ -81,6 +135,18 @@ func (UnimplementedDeliveryServiceServer) CreateDelivery(context.Context, *Creat
 func (UnimplementedDeliveryServiceServer) UpdateLocation(context.Context, *UpdateLocationRequest) (*DeliveryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateLocation not implemented")
 }
+func (UnimplementedDeliveryServiceServer) GetDelivery(context.Context, *DeliveryRequest) (*DeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDelivery not implemented")
+}
+func (UnimplementedDeliveryServiceServer) PickupDelivery(context.Context, *DeliveryRequest) (*DeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PickupDelivery not implemented")
+}
+func (UnimplementedDeliveryServiceServer) CompleteDelivery(context.Context, *DeliveryRequest) (*DeliveryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteDelivery not implemented")
+}
+func (UnimplementedDeliveryServiceServer) GetCourier(context.Context, *CourierRequest) (*CourierResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCourier not implemented")
+}
 func (UnimplementedDeliveryServiceServer) mustEmbedUnimplementedDeliveryServiceServer() {}
 func (UnimplementedDeliveryServiceServer) testEmbeddedByValue()                         {}
 
@@ -138,6 +204,78 @@ func _DeliveryService_UpdateLocation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeliveryService_GetDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).GetDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_GetDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).GetDelivery(ctx, req.(*DeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryService_PickupDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).PickupDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_PickupDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).PickupDelivery(ctx, req.(*DeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryService_CompleteDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).CompleteDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_CompleteDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).CompleteDelivery(ctx, req.(*DeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryService_GetCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryServiceServer).GetCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryService_GetCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryServiceServer).GetCourier(ctx, req.(*CourierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeliveryService_ServiceDesc is the grpc.ServiceDesc for DeliveryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +291,22 @@ var DeliveryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLocation",
 			Handler:    _DeliveryService_UpdateLocation_Handler,
 		},
+		{
+			MethodName: "GetDelivery",
+			Handler:    _DeliveryService_GetDelivery_Handler,
+		},
+		{
+			MethodName: "PickupDelivery",
+			Handler:    _DeliveryService_PickupDelivery_Handler,
+		},
+		{
+			MethodName: "CompleteDelivery",
+			Handler:    _DeliveryService_CompleteDelivery_Handler,
+		},
+		{
+			MethodName: "GetCourier",
+			Handler:    _DeliveryService_GetCourier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "delivery.proto",
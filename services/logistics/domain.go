@@ -5,6 +5,8 @@ import (
 	"errors"
 	"time"
 
+	"github.com/versoit/diploma/pkg/common"
+
 	"github.com/google/uuid"
 )
 
@@ -59,6 +61,9 @@ func (s CourierStatus) String() string {
 type Delivery struct {
 	orderID      string
 	courierID    string
+	storeIDs     []string
+	// tip - чаевые клиента, достаются курьеру после вручения заказа.
+	tip          common.Money
 	status       DeliveryStatus
 	createdAt    time.Time
 	pickupTime   time.Time
@@ -75,11 +80,16 @@ type Courier struct {
 	status     CourierStatus
 	currentLat float64
 	currentLng float64
+	// tipsEarned - чаевые за все доставленные заказы.
+	tipsEarned common.Money
 }
 
-func NewDelivery(orderID string) *Delivery {
+// NewDelivery - доставка заказа. storeIDs - точки, где курьер забирает части заказа.
+func NewDelivery(orderID string, storeIDs []string, tip common.Money) *Delivery {
 	return &Delivery{
 		orderID:   orderID,
+		storeIDs:  append([]string(nil), storeIDs...),
+		tip:       tip,
 		status:    DelStatusPending,
 		createdAt: time.Now(),
 	}
@@ -128,6 +138,7 @@ var (
 	ErrInvalidStatus      = errors.New("invalid status for operation")
 	ErrCourierBusy        = errors.New("courier is busy")
 	ErrInvalidCoordinates = errors.New("invalid coordinates")
+	ErrDeliveryNotFound   = errors.New("delivery not found")
 )
 
 func (d *Delivery) UpdateLocation(lat, lng float64) error {
@@ -176,8 +187,19 @@ func (c *Courier) CompleteOrder() {
 	}
 }
 
+// EarnTip - зачисляет курьеру чаевые за врученный заказ.
+func (c *Courier) EarnTip(d *Delivery) error {
+	if d.status != DelStatusDelivered || d.courierID != c.id {
+		return ErrInvalidStatus
+	}
+	c.tipsEarned = c.tipsEarned.Add(d.tip)
+	return nil
+}
+
 func (d *Delivery) OrderID() string      { return d.orderID }
 func (d *Delivery) CourierID() string    { return d.courierID }
+func (d *Delivery) StoreIDs() []string   { return d.storeIDs }
+func (d *Delivery) Tip() common.Money     { return d.tip }
 func (d *Delivery) Status() DeliveryStatus { return d.status }
 func (d *Delivery) PickupTime() time.Time  { return d.pickupTime }
 func (d *Delivery) DeliveryTime() time.Time { return d.deliveryTime }
@@ -188,6 +210,7 @@ func (c *Courier) Name() string         { return c.name }
 func (c *Courier) Phone() string        { return c.phone }
 func (c *Courier) Status() CourierStatus { return c.status }
 func (c *Courier) Location() (lat, lng float64) { return c.currentLat, c.currentLng }
+func (c *Courier) TipsEarned() common.Money     { return c.tipsEarned }
 
 type DeliveryRepository interface {
 	Save(ctx context.Context, d *Delivery) error
@@ -2,6 +2,8 @@ package logistics
 
 import (
 	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
 )
 
 func TestCourier_Workflow(t *testing.T) {
@@ -24,7 +26,10 @@ func TestCourier_Workflow(t *testing.T) {
 }
 
 func TestDelivery_Lifecycle(t *testing.T) {
-	d := NewDelivery("order-1")
+	d := NewDelivery("order-1", []string{"north", "south"}, common.NewMoney(100))
+	if len(d.StoreIDs()) != 2 {
+		t.Errorf("expected two pickup points, got %v", d.StoreIDs())
+	}
 
 	_ = d.AssignCourier("c-1")
 	if d.Status() != DelStatusAssigned {
@@ -38,3 +43,27 @@ func TestDelivery_Lifecycle(t *testing.T) {
 		t.Errorf("delivery should be completed")
 	}
 }
+
+func TestCourier_EarnTip(t *testing.T) {
+	c := NewCourier("John", "123456")
+	d := NewDelivery("order-1", nil, common.NewMoney(100))
+	_ = d.AssignCourier(c.ID())
+
+	if err := c.EarnTip(d); err != ErrInvalidStatus {
+		t.Errorf("tip of undelivered order must not be credited")
+	}
+
+	_ = d.Pickup()
+	_ = d.Complete()
+	if err := c.EarnTip(d); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !c.TipsEarned().Equal(common.NewMoney(100)) {
+		t.Errorf("expected tips 100, got %s", c.TipsEarned())
+	}
+
+	other := NewCourier("Jane", "654321")
+	if err := other.EarnTip(d); err != ErrInvalidStatus {
+		t.Errorf("tip must go to the assigned courier")
+	}
+}
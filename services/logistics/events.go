@@ -0,0 +1,43 @@
+package logistics
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TopicDeliveryUpdated - тема события об изменении доставки или координат курьера.
+const TopicDeliveryUpdated = "logistics.delivery.updated"
+
+// DeliveryEvent - состояние доставки для других сервисов, например отслеживания заказа клиентом.
+type DeliveryEvent struct {
+	EventID   string `json:"event_id"`
+	OrderID   string `json:"order_id"`
+	CourierID string `json:"courier_id,omitempty"`
+	Status    string `json:"status"`
+	// Lat, Lng - последние координаты курьера, нули - неизвестны.
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewDeliveryEvent(d *Delivery) DeliveryEvent {
+	id, _ := uuid.NewV7()
+	lat, lng := d.Location()
+	return DeliveryEvent{
+		EventID:    id.String(),
+		OrderID:    d.OrderID(),
+		CourierID:  d.CourierID(),
+		Status:     d.Status().String(),
+		Lat:        lat,
+		Lng:        lng,
+		OccurredAt: time.Now(),
+	}
+}
+
+// EventPublisher - публикация событий логистики. Доставка не гарантируется: оркестратор заказа
+// по-прежнему опрашивает доставку, события только сокращают задержку для клиента.
+type EventPublisher interface {
+	PublishDelivery(ctx context.Context, e DeliveryEvent)
+}
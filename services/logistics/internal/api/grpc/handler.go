@@ -3,6 +3,8 @@ package grpc
 import (
 	"context"
 
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/logistics"
 	logistics_pb "github.com/versoit/diploma/services/logistics/api/proto/pb"
 	"github.com/versoit/diploma/services/logistics/usecase"
 	"google.golang.org/grpc"
@@ -22,7 +24,61 @@ func (h *LogisticsHandler) Register(server *grpc.Server) {
 }
 
 func (h *LogisticsHandler) CreateDelivery(ctx context.Context, req *logistics_pb.CreateDeliveryRequest) (*logistics_pb.DeliveryResponse, error) {
-	return &logistics_pb.DeliveryResponse{OrderId: req.OrderId}, nil
+	delivery, err := h.uc.CreateDelivery(ctx, req.OrderId, req.StoreIds, common.NewMoney(req.Tip))
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveryResponse(delivery), nil
+}
+
+func (h *LogisticsHandler) GetDelivery(ctx context.Context, req *logistics_pb.DeliveryRequest) (*logistics_pb.DeliveryResponse, error) {
+	delivery, err := h.uc.GetDelivery(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveryResponse(delivery), nil
+}
+
+func (h *LogisticsHandler) PickupDelivery(ctx context.Context, req *logistics_pb.DeliveryRequest) (*logistics_pb.DeliveryResponse, error) {
+	delivery, err := h.uc.PickupDelivery(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveryResponse(delivery), nil
+}
+
+func (h *LogisticsHandler) CompleteDelivery(ctx context.Context, req *logistics_pb.DeliveryRequest) (*logistics_pb.DeliveryResponse, error) {
+	delivery, err := h.uc.CompleteDelivery(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveryResponse(delivery), nil
+}
+
+func toDeliveryResponse(d *logistics.Delivery) *logistics_pb.DeliveryResponse {
+	lat, lng := d.Location()
+	return &logistics_pb.DeliveryResponse{
+		OrderId:   d.OrderID(),
+		Status:    d.Status().String(),
+		CourierId: d.CourierID(),
+		StoreIds:  d.StoreIDs(),
+		Lat:       lat,
+		Lng:       lng,
+		Tip:       d.Tip().InexactFloat64(),
+	}
+}
+
+func (h *LogisticsHandler) GetCourier(ctx context.Context, req *logistics_pb.CourierRequest) (*logistics_pb.CourierResponse, error) {
+	courier, err := h.uc.GetCourier(ctx, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+	return &logistics_pb.CourierResponse{
+		CourierId:  courier.ID(),
+		Name:       courier.Name(),
+		Status:     courier.Status().String(),
+		TipsEarned: courier.TipsEarned().InexactFloat64(),
+	}, nil
 }
 
 func (h *LogisticsHandler) UpdateLocation(ctx context.Context, req *logistics_pb.UpdateLocationRequest) (*logistics_pb.DeliveryResponse, error) {
@@ -1,17 +1,47 @@
 package app
 
 import (
+	"context"
+	"os"
+
+	"github.com/versoit/diploma/services/logistics"
 	"github.com/versoit/diploma/services/logistics/internal/api/grpc"
+	"github.com/versoit/diploma/services/logistics/internal/broker"
 	"github.com/versoit/diploma/services/logistics/internal/repository"
 	"github.com/versoit/diploma/services/logistics/usecase"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 var Module = fx.Options(
 	fx.Provide(
 		repository.NewInMemoryDeliveryRepository,
 		repository.NewInMemoryCourierRepository,
+		NewEventPublisher,
 		usecase.NewLogisticsUseCase,
 		grpc.NewLogisticsHandler,
 	),
 )
+
+// NewEventPublisher - события доставок в NATS, если задан LOGISTICS_NATS_URL.
+func NewEventPublisher(lc fx.Lifecycle, logger *zap.Logger) (logistics.EventPublisher, error) {
+	url := os.Getenv("LOGISTICS_NATS_URL")
+	if url == "" {
+		return broker.NoopPublisher{}, nil
+	}
+	prefix, ok := os.LookupEnv("LOGISTICS_NATS_SUBJECT_PREFIX")
+	if !ok {
+		prefix = "pizza."
+	}
+
+	publisher, err := broker.NewNATSPublisher(url, prefix, logger)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return publisher.Close()
+		},
+	})
+	return publisher, nil
+}
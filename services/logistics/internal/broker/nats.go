@@ -0,0 +1,54 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/versoit/diploma/services/logistics"
+	"go.uber.org/zap"
+)
+
+// NATSPublisher - публикация событий логистики в NATS. Тема - префикс и тема события,
+// ID события передается в заголовке Nats-Msg-Id для дедупликации в JetStream.
+type NATSPublisher struct {
+	conn   *nats.Conn
+	prefix string
+	logger *zap.Logger
+}
+
+func NewNATSPublisher(url, subjectPrefix string, logger *zap.Logger) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("logistics-events"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats %s: %w", url, err)
+	}
+	return &NATSPublisher{conn: conn, prefix: subjectPrefix, logger: logger}, nil
+}
+
+// PublishDelivery - ошибка только логируется: доставка уже сохранена, а оркестратор заказа
+// узнает ее состояние опросом.
+func (p *NATSPublisher) PublishDelivery(ctx context.Context, e logistics.DeliveryEvent) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		p.logger.Warn("Failed to encode delivery event", zap.String("order_id", e.OrderID), zap.Error(err))
+		return
+	}
+
+	m := nats.NewMsg(p.prefix + logistics.TopicDeliveryUpdated)
+	m.Data = data
+	m.Header.Set(nats.MsgIdHdr, e.EventID)
+	m.Header.Set("Order-Id", e.OrderID)
+	if err := p.conn.PublishMsg(m); err != nil {
+		p.logger.Warn("Failed to publish delivery event", zap.String("order_id", e.OrderID), zap.Error(err))
+	}
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+// NoopPublisher - события никуда не отправляются, когда брокер не настроен.
+type NoopPublisher struct{}
+
+func (NoopPublisher) PublishDelivery(ctx context.Context, e logistics.DeliveryEvent) {}
@@ -31,7 +31,7 @@ func (r *InMemoryDeliveryRepository) FindByOrderID(ctx context.Context, id strin
 	defer r.mu.RUnlock()
 	d, ok := r.store[id]
 	if !ok {
-		return nil, fmt.Errorf("delivery not found")
+		return nil, logistics.ErrDeliveryNotFound
 	}
 	return d, nil
 }
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS delivery_pickups (
+    order_id UUID REFERENCES deliveries(order_id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    store_id VARCHAR(50) NOT NULL,
+    PRIMARY KEY (order_id, position)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS delivery_pickups;
+-- +goose StatementEnd
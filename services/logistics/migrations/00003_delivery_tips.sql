@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE deliveries ADD COLUMN IF NOT EXISTS tip DECIMAL(12,2) NOT NULL DEFAULT 0;
+ALTER TABLE couriers ADD COLUMN IF NOT EXISTS tips_earned DECIMAL(12,2) NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE couriers DROP COLUMN IF EXISTS tips_earned;
+ALTER TABLE deliveries DROP COLUMN IF EXISTS tip;
+-- +goose StatementEnd
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/versoit/diploma/pkg/common"
 	"github.com/versoit/diploma/services/logistics"
 )
 
@@ -15,12 +16,14 @@ var (
 type LogisticsUseCase struct {
 	deliveryRepo logistics.DeliveryRepository
 	courierRepo  logistics.CourierRepository
+	events       logistics.EventPublisher
 }
 
-func NewLogisticsUseCase(dr logistics.DeliveryRepository, cr logistics.CourierRepository) *LogisticsUseCase {
+func NewLogisticsUseCase(dr logistics.DeliveryRepository, cr logistics.CourierRepository, events logistics.EventPublisher) *LogisticsUseCase {
 	return &LogisticsUseCase{
 		deliveryRepo: dr,
 		courierRepo:  cr,
+		events:       events,
 	}
 }
 
@@ -30,9 +33,12 @@ func (uc *LogisticsUseCase) AssignCourierToDelivery(ctx context.Context, orderID
 	}
 
 	delivery, err := uc.deliveryRepo.FindByOrderID(ctx, orderID)
-	if err != nil {
+	switch {
+	case errors.Is(err, logistics.ErrDeliveryNotFound):
 		// Если доставка еще не зарегистрирована, создаем новый процесс
-		delivery = logistics.NewDelivery(orderID)
+		delivery = logistics.NewDelivery(orderID, nil, common.ZeroMoney())
+	case err != nil:
+		return fmt.Errorf("failed to find delivery for order %s: %w", orderID, err)
 	}
 
 	courier, err := uc.courierRepo.FindByID(ctx, courierID)
@@ -56,6 +62,7 @@ func (uc *LogisticsUseCase) AssignCourierToDelivery(ctx context.Context, orderID
 	if err := uc.deliveryRepo.Save(ctx, delivery); err != nil {
 		return fmt.Errorf("failed to save delivery assignment: %w", err)
 	}
+	uc.events.PublishDelivery(ctx, logistics.NewDeliveryEvent(delivery))
 
 	return nil
 }
@@ -77,6 +84,107 @@ func (uc *LogisticsUseCase) UpdateLocation(ctx context.Context, orderID string,
 	if err := uc.deliveryRepo.Save(ctx, delivery); err != nil {
 		return fmt.Errorf("failed to persist location update: %w", err)
 	}
+	uc.events.PublishDelivery(ctx, logistics.NewDeliveryEvent(delivery))
 
 	return nil
 }
+
+// CreateDelivery - регистрирует доставку готового заказа с точками, где его забирает курьер,
+// и чаевыми курьеру. Повторный вызов возвращает существующую.
+func (uc *LogisticsUseCase) CreateDelivery(ctx context.Context, orderID string, storeIDs []string, tip common.Money) (*logistics.Delivery, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+	}
+	if tip.IsNegative() {
+		return nil, fmt.Errorf("%w: tip cannot be negative", ErrInvalidInput)
+	}
+
+	existing, err := uc.deliveryRepo.FindByOrderID(ctx, orderID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, logistics.ErrDeliveryNotFound) {
+		return nil, fmt.Errorf("failed to find delivery for order %s: %w", orderID, err)
+	}
+
+	delivery := logistics.NewDelivery(orderID, storeIDs, tip)
+	if err := uc.deliveryRepo.Save(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to save delivery for order %s: %w", orderID, err)
+	}
+	uc.events.PublishDelivery(ctx, logistics.NewDeliveryEvent(delivery))
+
+	return delivery, nil
+}
+
+func (uc *LogisticsUseCase) GetDelivery(ctx context.Context, orderID string) (*logistics.Delivery, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+	}
+
+	delivery, err := uc.deliveryRepo.FindByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("delivery process not found for order %s: %w", orderID, err)
+	}
+	return delivery, nil
+}
+
+func (uc *LogisticsUseCase) PickupDelivery(ctx context.Context, orderID string) (*logistics.Delivery, error) {
+	delivery, err := uc.GetDelivery(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := delivery.Pickup(); err != nil {
+		return nil, fmt.Errorf("courier cannot pick up order %s: %w", orderID, err)
+	}
+
+	if err := uc.deliveryRepo.Save(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to persist pickup: %w", err)
+	}
+	uc.events.PublishDelivery(ctx, logistics.NewDeliveryEvent(delivery))
+
+	return delivery, nil
+}
+
+// CompleteDelivery - заказ вручен, курьер получает чаевые и снова свободен.
+func (uc *LogisticsUseCase) CompleteDelivery(ctx context.Context, orderID string) (*logistics.Delivery, error) {
+	delivery, err := uc.GetDelivery(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	courier, err := uc.courierRepo.FindByID(ctx, delivery.CourierID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate courier %s: %w", delivery.CourierID(), err)
+	}
+
+	if err := delivery.Complete(); err != nil {
+		return nil, fmt.Errorf("delivery of order %s cannot be completed: %w", orderID, err)
+	}
+	courier.CompleteOrder()
+	if err := courier.EarnTip(delivery); err != nil {
+		return nil, fmt.Errorf("failed to credit tip of order %s: %w", orderID, err)
+	}
+
+	if err := uc.deliveryRepo.Save(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("failed to persist delivery completion: %w", err)
+	}
+	uc.events.PublishDelivery(ctx, logistics.NewDeliveryEvent(delivery))
+	if err := uc.courierRepo.Save(ctx, courier); err != nil {
+		return nil, fmt.Errorf("failed to release courier: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (uc *LogisticsUseCase) GetCourier(ctx context.Context, courierID string) (*logistics.Courier, error) {
+	if courierID == "" {
+		return nil, fmt.Errorf("%w: courier ID is required", ErrInvalidInput)
+	}
+
+	courier, err := uc.courierRepo.FindByID(ctx, courierID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate courier %s: %w", courierID, err)
+	}
+	return courier, nil
+}
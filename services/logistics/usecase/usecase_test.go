@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/versoit/diploma/pkg/common"
 	"github.com/versoit/diploma/services/logistics"
 )
 
@@ -20,7 +22,7 @@ func (m *MockDeliveryRepo) FindByOrderID(ctx context.Context, id string) (*logis
 	if d, ok := m.store[id]; ok {
 		return d, nil
 	}
-	return nil, fmt.Errorf("not found")
+	return nil, logistics.ErrDeliveryNotFound
 }
 
 type MockCourierRepo struct {
@@ -44,10 +46,18 @@ func (m *MockCourierRepo) UpdateLocation(ctx context.Context, id string, lat, ln
 	return nil
 }
 
+type MockPublisher struct {
+	events []logistics.DeliveryEvent
+}
+
+func (m *MockPublisher) PublishDelivery(ctx context.Context, e logistics.DeliveryEvent) {
+	m.events = append(m.events, e)
+}
+
 func TestLogisticsUseCase_AssignCourier(t *testing.T) {
 	dRepo := &MockDeliveryRepo{store: make(map[string]*logistics.Delivery)}
 	cRepo := &MockCourierRepo{store: make(map[string]*logistics.Courier)}
-	uc := NewLogisticsUseCase(dRepo, cRepo)
+	uc := NewLogisticsUseCase(dRepo, cRepo, &MockPublisher{})
 
 	courier := logistics.NewCourier("Vasya", "123")
 	courier.GoOnline()
@@ -68,3 +78,110 @@ func TestLogisticsUseCase_AssignCourier(t *testing.T) {
 		t.Error("courier not assigned")
 	}
 }
+
+func TestLogisticsUseCase_DeliveryFlow(t *testing.T) {
+	dRepo := &MockDeliveryRepo{store: make(map[string]*logistics.Delivery)}
+	cRepo := &MockCourierRepo{store: make(map[string]*logistics.Courier)}
+	uc := NewLogisticsUseCase(dRepo, cRepo, &MockPublisher{})
+	ctx := context.Background()
+
+	courier := logistics.NewCourier("Vasya", "123")
+	courier.GoOnline()
+	_ = cRepo.Save(ctx, courier)
+
+	created, err := uc.CreateDelivery(ctx, "order-1", []string{"main"}, common.NewMoney(150))
+	if err != nil {
+		t.Fatalf("failed to create delivery: %v", err)
+	}
+	again, err := uc.CreateDelivery(ctx, "order-1", []string{"main"}, common.NewMoney(150))
+	if err != nil || again != created {
+		t.Fatalf("repeated create should return existing delivery: %v", err)
+	}
+
+	if err := uc.AssignCourierToDelivery(ctx, "order-1", courier.ID()); err != nil {
+		t.Fatalf("failed to assign: %v", err)
+	}
+	if _, err := uc.PickupDelivery(ctx, "order-1"); err != nil {
+		t.Fatalf("failed to pick up: %v", err)
+	}
+	d, err := uc.CompleteDelivery(ctx, "order-1")
+	if err != nil {
+		t.Fatalf("failed to complete: %v", err)
+	}
+	if d.Status() != logistics.DelStatusDelivered {
+		t.Errorf("expected delivered, got %s", d.Status())
+	}
+	if courier.Status() != logistics.CourierFree {
+		t.Errorf("courier should be free after delivery, got %s", courier.Status())
+	}
+	if !courier.TipsEarned().Equal(common.NewMoney(150)) {
+		t.Errorf("courier should earn the tip, got %s", courier.TipsEarned())
+	}
+}
+
+func TestLogisticsUseCase_CreateDeliveryRejectsNegativeTip(t *testing.T) {
+	dRepo := &MockDeliveryRepo{store: make(map[string]*logistics.Delivery)}
+	uc := NewLogisticsUseCase(dRepo, &MockCourierRepo{store: make(map[string]*logistics.Courier)}, &MockPublisher{})
+
+	if _, err := uc.CreateDelivery(context.Background(), "order-1", nil, common.NewMoney(-1)); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+// brokenLookupRepo - поиск доставки падает, например, при недоступной базе.
+type brokenLookupRepo struct {
+	MockDeliveryRepo
+}
+
+func (r *brokenLookupRepo) FindByOrderID(ctx context.Context, id string) (*logistics.Delivery, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestLogisticsUseCase_LookupFailureKeepsDelivery(t *testing.T) {
+	dRepo := &brokenLookupRepo{MockDeliveryRepo{store: make(map[string]*logistics.Delivery)}}
+	cRepo := &MockCourierRepo{store: make(map[string]*logistics.Courier)}
+	uc := NewLogisticsUseCase(dRepo, cRepo, &MockPublisher{})
+	ctx := context.Background()
+
+	courier := logistics.NewCourier("Vasya", "123")
+	courier.GoOnline()
+	_ = cRepo.Save(ctx, courier)
+
+	if _, err := uc.CreateDelivery(ctx, "order-1", []string{"main"}, common.NewMoney(150)); err == nil {
+		t.Error("expected lookup error from CreateDelivery")
+	}
+	if err := uc.AssignCourierToDelivery(ctx, "order-1", courier.ID()); err == nil {
+		t.Error("expected lookup error from AssignCourierToDelivery")
+	}
+	if len(dRepo.store) != 0 {
+		t.Errorf("delivery must not be replaced when lookup fails, got %d", len(dRepo.store))
+	}
+}
+
+func TestLogisticsUseCase_PublishesDeliveryEvents(t *testing.T) {
+	dRepo := &MockDeliveryRepo{store: make(map[string]*logistics.Delivery)}
+	cRepo := &MockCourierRepo{store: make(map[string]*logistics.Courier)}
+	events := &MockPublisher{}
+	uc := NewLogisticsUseCase(dRepo, cRepo, events)
+	ctx := context.Background()
+
+	courier := logistics.NewCourier("Vasya", "123")
+	courier.GoOnline()
+	_ = cRepo.Save(ctx, courier)
+
+	_, _ = uc.CreateDelivery(ctx, "order-1", []string{"main"}, common.ZeroMoney())
+	_ = uc.AssignCourierToDelivery(ctx, "order-1", courier.ID())
+	_, _ = uc.PickupDelivery(ctx, "order-1")
+	_ = uc.UpdateLocation(ctx, "order-1", 55.75, 37.61)
+
+	if len(events.events) != 4 {
+		t.Fatalf("expected 4 events, got %d", len(events.events))
+	}
+	last := events.events[3]
+	if last.OrderID != "order-1" || last.CourierID != courier.ID() || last.Status != "on_way" || last.Lat != 55.75 || last.Lng != 37.61 {
+		t.Errorf("unexpected location event: %+v", last)
+	}
+	if events.events[0].Status != "pending" || events.events[1].Status != "assigned" {
+		t.Errorf("unexpected status events: %+v", events.events[:2])
+	}
+}
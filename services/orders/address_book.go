@@ -0,0 +1,45 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrAddressNotFound = errors.New("saved address not found")
+
+// SavedAddress - адрес из адресной книги клиента.
+type SavedAddress struct {
+	ID         string
+	CustomerID string
+	// Label - подпись клиента, например "Дом" или "Работа".
+	Label   string
+	Address DeliveryAddress
+	// IsDefault - основной адрес, подставляется в заказ без указанного адреса.
+	IsDefault bool
+	CreatedAt time.Time
+}
+
+func NewSavedAddress(customerID, label string, addr DeliveryAddress) *SavedAddress {
+	id, _ := uuid.NewV7()
+	return &SavedAddress{
+		ID:         id.String(),
+		CustomerID: customerID,
+		Label:      label,
+		Address:    addr,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// AddressBook - сохраненные адреса клиентов.
+type AddressBook interface {
+	Save(ctx context.Context, a *SavedAddress) error
+	FindByID(ctx context.Context, id string) (*SavedAddress, error)
+	// ListByCustomer - адреса клиента в порядке добавления.
+	ListByCustomer(ctx context.Context, customerID string) ([]*SavedAddress, error)
+	Delete(ctx context.Context, id string) error
+	// SetDefault - делает адрес основным и снимает отметку с остальных адресов клиента.
+	SetDefault(ctx context.Context, customerID, id string) error
+}
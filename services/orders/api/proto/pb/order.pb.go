@@ -9,6 +9,7 @@ package orders_pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,9 +23,17 @@ const (
 )
 
 type Address struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
-	Street        string                 `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	City     string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Street   string                 `protobuf:"bytes,2,opt,name=street,proto3" json:"street,omitempty"`
+	District string                 `protobuf:"bytes,3,opt,name=district,proto3" json:"district,omitempty"`
+	// Координаты геокодированного адреса, нужны для полигональных зон.
+	Location  *GeoPoint `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	House     string    `protobuf:"bytes,5,opt,name=house,proto3" json:"house,omitempty"`
+	Apartment string    `protobuf:"bytes,6,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	Floor     string    `protobuf:"bytes,7,opt,name=floor,proto3" json:"floor,omitempty"`
+	// Комментарий курьеру: код домофона, ориентиры.
+	Comment       string `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,18 +82,119 @@ func (x *Address) GetStreet() string {
 	return ""
 }
 
-type OrderItem struct {
+func (x *Address) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *Address) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *Address) GetApartment() string {
+	if x != nil {
+		return x.Apartment
+	}
+	return ""
+}
+
+func (x *Address) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *Address) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type GeoPoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeoPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+type OrderItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Не используется сервером: название и цена берутся из каталога.
+	ProductName string   `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity    int32    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Toppings    []string `protobuf:"bytes,4,rep,name=toppings,proto3" json:"toppings,omitempty"`
+	// Коды размера и теста из каталога, пусто - вариант по умолчанию.
+	Size  string `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`
+	Crust string `protobuf:"bytes,6,opt,name=crust,proto3" json:"crust,omitempty"`
+	// Вторая половина пиццы "пополам", product_id - первая.
+	HalfProductId string `protobuf:"bytes,7,opt,name=half_product_id,json=halfProductId,proto3" json:"half_product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderItem) Reset() {
 	*x = OrderItem{}
-	mi := &file_order_proto_msgTypes[1]
+	mi := &file_order_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +206,7 @@ func (x *OrderItem) String() string {
 func (*OrderItem) ProtoMessage() {}
 
 func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[1]
+	mi := &file_order_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +219,7 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
 func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{1}
+	return file_order_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *OrderItem) GetProductId() string {
@@ -133,18 +243,57 @@ func (x *OrderItem) GetQuantity() int32 {
 	return 0
 }
 
+func (x *OrderItem) GetToppings() []string {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+func (x *OrderItem) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *OrderItem) GetCrust() string {
+	if x != nil {
+		return x.Crust
+	}
+	return ""
+}
+
+func (x *OrderItem) GetHalfProductId() string {
+	if x != nil {
+		return x.HalfProductId
+	}
+	return ""
+}
+
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Address       *Address               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Address    *Address               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Items      []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// Время доставки предзаказа, пустое - доставка как можно скорее.
+	DeliverAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deliver_at,json=deliverAt,proto3" json:"deliver_at,omitempty"`
+	// Ключ идемпотентности: повтор с тем же ключом и телом возвращает исходный ответ.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Адрес из адресной книги вместо address. Без обоих берется основной адрес клиента.
+	AddressId string `protobuf:"bytes,6,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	// card, cash, card_on_delivery; пусто - card. От способа оплаты зависит сервисный сбор,
+	// cash и card_on_delivery принимает курьер.
+	PaymentMethod string `protobuf:"bytes,7,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Чаевые курьеру.
+	Tip           float64 `protobuf:"fixed64,8,opt,name=tip,proto3" json:"tip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_order_proto_msgTypes[2]
+	mi := &file_order_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +305,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[2]
+	mi := &file_order_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +318,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{2}
+	return file_order_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateOrderRequest) GetCustomerId() string {
@@ -193,16 +342,60 @@ func (x *CreateOrderRequest) GetItems() []*OrderItem {
 	return nil
 }
 
+func (x *CreateOrderRequest) GetDeliverAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliverAt
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetTip() float64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
 type PayOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Не используются: в историю записывается вызывающий из метаданных x-actor-id и
+	// x-actor-role, которые проставляет шлюз после аутентификации.
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	ActorRole      string `protobuf:"bytes,3,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Note           string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PayOrderRequest) Reset() {
 	*x = PayOrderRequest{}
-	mi := &file_order_proto_msgTypes[3]
+	mi := &file_order_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -214,7 +407,7 @@ func (x *PayOrderRequest) String() string {
 func (*PayOrderRequest) ProtoMessage() {}
 
 func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[3]
+	mi := &file_order_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,7 +420,7 @@ func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayOrderRequest.ProtoReflect.Descriptor instead.
 func (*PayOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{3}
+	return file_order_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PayOrderRequest) GetOrderId() string {
@@ -237,31 +430,58 @@ func (x *PayOrderRequest) GetOrderId() string {
 	return ""
 }
 
-type OrderResponse struct {
+// Deprecated: Marked as deprecated in order.proto.
+func (x *PayOrderRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *PayOrderRequest) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	FinalPrice    float64                `protobuf:"fixed64,3,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`
-	OrderNumber   string                 `protobuf:"bytes,4,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderResponse) Reset() {
-	*x = OrderResponse{}
-	mi := &file_order_proto_msgTypes[4]
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_order_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderResponse) String() string {
+func (x *GetOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderResponse) ProtoMessage() {}
+func (*GetOrderRequest) ProtoMessage() {}
 
-func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[4]
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,68 +492,2954 @@ func (x *OrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
-func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *OrderResponse) GetOrderId() string {
+func (x *GetOrderRequest) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *OrderResponse) GetStatus() string {
+type ListOrdersRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Пустая строка - без фильтра по статусу.
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOrdersRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *OrderResponse) GetFinalPrice() float64 {
+func (x *ListOrdersRequest) GetCreatedFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FinalPrice
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *OrderResponse) GetOrderNumber() string {
+func (x *ListOrdersRequest) GetPageToken() string {
 	if x != nil {
-		return x.OrderNumber
+		return x.PageToken
 	}
 	return ""
 }
 
-var File_order_proto protoreflect.FileDescriptor
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_order_proto_rawDesc = "" +
-	"\n" +
-	"\vorder.proto\x12\torders.v1\"5\n" +
-	"\aAddress\x12\x12\n" +
-	"\x04city\x18\x01 \x01(\tR\x04city\x12\x16\n" +
-	"\x06street\x18\x02 \x01(\tR\x06street\"i\n" +
-	"\tOrderItem\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
-	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x8f\x01\n" +
-	"\x12CreateOrderRequest\x12\x1f\n" +
-	"\vcustomer_id\x18\x01 \x01(\tR\n" +
-	"customerId\x12,\n" +
-	"\aaddress\x18\x02 \x01(\v2\x12.orders.v1.AddressR\aaddress\x12*\n" +
-	"\x05items\x18\x03 \x03(\v2\x14.orders.v1.OrderItemR\x05items\",\n" +
-	"\x0fPayOrderRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"\x86\x01\n" +
-	"\rOrderResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
-	"\vfinal_price\x18\x03 \x01(\x01R\n" +
-	"finalPrice\x12!\n" +
-	"\forder_number\x18\x04 \x01(\tR\vorderNumber2\x98\x01\n" +
-	"\fOrderService\x12F\n" +
-	"\vCreateOrder\x12\x1d.orders.v1.CreateOrderRequest\x1a\x18.orders.v1.OrderResponse\x12@\n" +
-	"\bPayOrder\x12\x1a.orders.v1.PayOrderRequest\x1a\x18.orders.v1.OrderResponseB\x10Z\x0e./pb;orders_pbb\x06proto3"
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type OrderTransitionRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Не используются: в историю записывается вызывающий из метаданных x-actor-id и
+	// x-actor-role, которые проставляет шлюз после аутентификации.
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	ActorRole     string `protobuf:"bytes,3,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Note          string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTransitionRequest) Reset() {
+	*x = OrderTransitionRequest{}
+	mi := &file_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTransitionRequest) ProtoMessage() {}
+
+func (x *OrderTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTransitionRequest.ProtoReflect.Descriptor instead.
+func (*OrderTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OrderTransitionRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *OrderTransitionRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *OrderTransitionRequest) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *OrderTransitionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CancelOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// customer_request, payment_failed, out_of_stock, kitchen_failure, delivery_failed, fraud, other
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Не используются: вызывающий берется из метаданных x-actor-id и x-actor-role,
+	// которые проставляет шлюз после аутентификации (customer, operator, manager).
+	//
+	// Deprecated: Marked as deprecated in order.proto.
+	ActorId string `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// Deprecated: Marked as deprecated in order.proto.
+	ActorRole     string `protobuf:"bytes,5,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *CancelOrderRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order.proto.
+func (x *CancelOrderRequest) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+type Cancellation struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Reason                   string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Comment                  string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	ActorId                  string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole                string                 `protobuf:"bytes,4,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	CanceledAt               *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	PreviousStatus           string                 `protobuf:"bytes,6,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	RefundRequired           bool                   `protobuf:"varint,7,opt,name=refund_required,json=refundRequired,proto3" json:"refund_required,omitempty"`
+	TicketWithdrawalRequired bool                   `protobuf:"varint,8,opt,name=ticket_withdrawal_required,json=ticketWithdrawalRequired,proto3" json:"ticket_withdrawal_required,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Cancellation) Reset() {
+	*x = Cancellation{}
+	mi := &file_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancellation) ProtoMessage() {}
+
+func (x *Cancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancellation.ProtoReflect.Descriptor instead.
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Cancellation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Cancellation) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Cancellation) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *Cancellation) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *Cancellation) GetCanceledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
+func (x *Cancellation) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *Cancellation) GetRefundRequired() bool {
+	if x != nil {
+		return x.RefundRequired
+	}
+	return false
+}
+
+func (x *Cancellation) GetTicketWithdrawalRequired() bool {
+	if x != nil {
+		return x.TicketWithdrawalRequired
+	}
+	return false
+}
+
+type Topping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Topping) Reset() {
+	*x = Topping{}
+	mi := &file_order_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Topping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topping) ProtoMessage() {}
+
+func (x *Topping) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topping.ProtoReflect.Descriptor instead.
+func (*Topping) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Topping) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Topping) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type OrderLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName    string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity       int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BasePrice      float64                `protobuf:"fixed64,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	SizeMultiplier float64                `protobuf:"fixed64,5,opt,name=size_multiplier,json=sizeMultiplier,proto3" json:"size_multiplier,omitempty"`
+	Toppings       []*Topping             `protobuf:"bytes,6,rep,name=toppings,proto3" json:"toppings,omitempty"`
+	TotalPrice     float64                `protobuf:"fixed64,7,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	LineId         string                 `protobuf:"bytes,8,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	Size           *ItemOption            `protobuf:"bytes,9,opt,name=size,proto3" json:"size,omitempty"`
+	Crust          *ItemOption            `protobuf:"bytes,10,opt,name=crust,proto3" json:"crust,omitempty"`
+	// Половины пиццы "пополам", base_price строки уже посчитана по ним.
+	Halves []*PizzaHalf `protobuf:"bytes,11,rep,name=halves,proto3" json:"halves,omitempty"`
+	// Точка, которая готовит позицию.
+	StoreId       string `protobuf:"bytes,12,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderLine) Reset() {
+	*x = OrderLine{}
+	mi := &file_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderLine) ProtoMessage() {}
+
+func (x *OrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderLine.ProtoReflect.Descriptor instead.
+func (*OrderLine) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderLine) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *OrderLine) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *OrderLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderLine) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *OrderLine) GetSizeMultiplier() float64 {
+	if x != nil {
+		return x.SizeMultiplier
+	}
+	return 0
+}
+
+func (x *OrderLine) GetToppings() []*Topping {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+func (x *OrderLine) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *OrderLine) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *OrderLine) GetSize() *ItemOption {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *OrderLine) GetCrust() *ItemOption {
+	if x != nil {
+		return x.Crust
+	}
+	return nil
+}
+
+func (x *OrderLine) GetHalves() []*PizzaHalf {
+	if x != nil {
+		return x.Halves
+	}
+	return nil
+}
+
+func (x *OrderLine) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+type ItemOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemOption) Reset() {
+	*x = ItemOption{}
+	mi := &file_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemOption) ProtoMessage() {}
+
+func (x *ItemOption) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemOption.ProtoReflect.Descriptor instead.
+func (*ItemOption) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ItemOption) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ItemOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ItemOption) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type PizzaHalf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	BasePrice     float64                `protobuf:"fixed64,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PizzaHalf) Reset() {
+	*x = PizzaHalf{}
+	mi := &file_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PizzaHalf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PizzaHalf) ProtoMessage() {}
+
+func (x *PizzaHalf) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PizzaHalf.ProtoReflect.Descriptor instead.
+func (*PizzaHalf) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PizzaHalf) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PizzaHalf) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PizzaHalf) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderNumber   string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Address       *Address               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Items         []*OrderLine           `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	DeliveryPrice float64                `protobuf:"fixed64,7,opt,name=delivery_price,json=deliveryPrice,proto3" json:"delivery_price,omitempty"`
+	Discount      float64                `protobuf:"fixed64,8,opt,name=discount,proto3" json:"discount,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,9,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	FinalPrice    float64                `protobuf:"fixed64,10,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Cancellation  *Cancellation          `protobuf:"bytes,12,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	ScheduledFor  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	// Точки, которые готовят заказ; больше одной - заказ разделен.
+	StoreIds      []string `protobuf:"bytes,14,rep,name=store_ids,json=storeIds,proto3" json:"store_ids,omitempty"`
+	PaymentMethod string   `protobuf:"bytes,15,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Из чего складывается final_price, сумма строк равна ему.
+	PriceBreakdown []*PriceLine `protobuf:"bytes,16,rep,name=price_breakdown,json=priceBreakdown,proto3" json:"price_breakdown,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Order) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Order) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *Order) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Order) GetItems() []*OrderLine {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetDeliveryPrice() float64 {
+	if x != nil {
+		return x.DeliveryPrice
+	}
+	return 0
+}
+
+func (x *Order) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Order) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Order) GetFinalPrice() float64 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *Order) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+func (x *Order) GetStoreIds() []string {
+	if x != nil {
+		return x.StoreIds
+	}
+	return nil
+}
+
+func (x *Order) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *Order) GetPriceBreakdown() []*PriceLine {
+	if x != nil {
+		return x.PriceBreakdown
+	}
+	return nil
+}
+
+type PriceLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// items, delivery, discount, packaging, service_fee, tip
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Скидка отрицательная.
+	Amount        float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLine) Reset() {
+	*x = PriceLine{}
+	mi := &file_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLine) ProtoMessage() {}
+
+func (x *PriceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLine.ProtoReflect.Descriptor instead.
+func (*PriceLine) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PriceLine) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PriceLine) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Promo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// fixed_amount, percentage, free_delivery, buy_n_get_m, free_topping
+	Type        string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount      float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Percent     float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	MaxDiscount float64                `protobuf:"fixed64,5,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`
+	BuyQty      int32                  `protobuf:"varint,6,opt,name=buy_qty,json=buyQty,proto3" json:"buy_qty,omitempty"`
+	FreeQty     int32                  `protobuf:"varint,7,opt,name=free_qty,json=freeQty,proto3" json:"free_qty,omitempty"`
+	ValidFrom   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// Не задано - бессрочно.
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	Active        bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	MinOrderValue float64                `protobuf:"fixed64,11,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,12,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	CategoryIds   []int32                `protobuf:"varint,13,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	// 0 - без ограничения.
+	UsageLimit       int32 `protobuf:"varint,14,opt,name=usage_limit,json=usageLimit,proto3" json:"usage_limit,omitempty"`
+	PerCustomerLimit int32 `protobuf:"varint,15,opt,name=per_customer_limit,json=perCustomerLimit,proto3" json:"per_customer_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Promo) Reset() {
+	*x = Promo{}
+	mi := &file_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promo) ProtoMessage() {}
+
+func (x *Promo) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promo.ProtoReflect.Descriptor instead.
+func (*Promo) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Promo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Promo) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Promo) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Promo) GetMaxDiscount() float64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *Promo) GetBuyQty() int32 {
+	if x != nil {
+		return x.BuyQty
+	}
+	return 0
+}
+
+func (x *Promo) GetFreeQty() int32 {
+	if x != nil {
+		return x.FreeQty
+	}
+	return 0
+}
+
+func (x *Promo) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Promo) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *Promo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Promo) GetMinOrderValue() float64 {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return 0
+}
+
+func (x *Promo) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *Promo) GetCategoryIds() []int32 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *Promo) GetUsageLimit() int32 {
+	if x != nil {
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *Promo) GetPerCustomerLimit() int32 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+type PromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoRequest) Reset() {
+	*x = PromoRequest{}
+	mi := &file_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoRequest) ProtoMessage() {}
+
+func (x *PromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoRequest.ProtoReflect.Descriptor instead.
+func (*PromoRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PromoRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PromoRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type PromoValidation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Valid bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Причина отказа, если промокод не применим.
+	Reason        string  `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Discount      float64 `protobuf:"fixed64,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	FinalPrice    float64 `protobuf:"fixed64,4,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoValidation) Reset() {
+	*x = PromoValidation{}
+	mi := &file_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoValidation) ProtoMessage() {}
+
+func (x *PromoValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoValidation.ProtoReflect.Descriptor instead.
+func (*PromoValidation) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PromoValidation) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *PromoValidation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PromoValidation) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *PromoValidation) GetFinalPrice() float64 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+type DeliveryQuote struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ZoneId    string                 `protobuf:"bytes,1,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`
+	ZoneName  string                 `protobuf:"bytes,2,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	BasePrice float64                `protobuf:"fixed64,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Surcharge float64                `protobuf:"fixed64,4,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
+	Price     float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	// 0 - бесплатной доставки в зоне нет.
+	FreeDeliveryFrom float64 `protobuf:"fixed64,6,opt,name=free_delivery_from,json=freeDeliveryFrom,proto3" json:"free_delivery_from,omitempty"`
+	MinOrderValue    float64 `protobuf:"fixed64,7,opt,name=min_order_value,json=minOrderValue,proto3" json:"min_order_value,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeliveryQuote) Reset() {
+	*x = DeliveryQuote{}
+	mi := &file_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryQuote) ProtoMessage() {}
+
+func (x *DeliveryQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryQuote.ProtoReflect.Descriptor instead.
+func (*DeliveryQuote) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeliveryQuote) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *DeliveryQuote) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *DeliveryQuote) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *DeliveryQuote) GetSurcharge() float64 {
+	if x != nil {
+		return x.Surcharge
+	}
+	return 0
+}
+
+func (x *DeliveryQuote) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *DeliveryQuote) GetFreeDeliveryFrom() float64 {
+	if x != nil {
+		return x.FreeDeliveryFrom
+	}
+	return 0
+}
+
+func (x *DeliveryQuote) GetMinOrderValue() float64 {
+	if x != nil {
+		return x.MinOrderValue
+	}
+	return 0
+}
+
+type OrderQuote struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Items          []*OrderLine           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	ItemsTotal     float64                `protobuf:"fixed64,2,opt,name=items_total,json=itemsTotal,proto3" json:"items_total,omitempty"`
+	Delivery       *DeliveryQuote         `protobuf:"bytes,3,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	FinalPrice     float64                `protobuf:"fixed64,4,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`
+	PriceBreakdown []*PriceLine           `protobuf:"bytes,5,rep,name=price_breakdown,json=priceBreakdown,proto3" json:"price_breakdown,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderQuote) Reset() {
+	*x = OrderQuote{}
+	mi := &file_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderQuote) ProtoMessage() {}
+
+func (x *OrderQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderQuote.ProtoReflect.Descriptor instead.
+func (*OrderQuote) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OrderQuote) GetItems() []*OrderLine {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderQuote) GetItemsTotal() float64 {
+	if x != nil {
+		return x.ItemsTotal
+	}
+	return 0
+}
+
+func (x *OrderQuote) GetDelivery() *DeliveryQuote {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *OrderQuote) GetFinalPrice() float64 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+func (x *OrderQuote) GetPriceBreakdown() []*PriceLine {
+	if x != nil {
+		return x.PriceBreakdown
+	}
+	return nil
+}
+
+type AddItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Item          *OrderItem             `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddItemRequest) Reset() {
+	*x = AddItemRequest{}
+	mi := &file_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemRequest) ProtoMessage() {}
+
+func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemRequest.ProtoReflect.Descriptor instead.
+func (*AddItemRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AddItemRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AddItemRequest) GetItem() *OrderItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UpdateItemRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	LineId  string                 `protobuf:"bytes,2,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	// 0 - количество не меняется.
+	Quantity int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Топпинги заменяются только при replace_toppings, пустой список убирает все.
+	ReplaceToppings bool     `protobuf:"varint,4,opt,name=replace_toppings,json=replaceToppings,proto3" json:"replace_toppings,omitempty"`
+	Toppings        []string `protobuf:"bytes,5,rep,name=toppings,proto3" json:"toppings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateItemRequest) Reset() {
+	*x = UpdateItemRequest{}
+	mi := &file_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemRequest) ProtoMessage() {}
+
+func (x *UpdateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateItemRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetReplaceToppings() bool {
+	if x != nil {
+		return x.ReplaceToppings
+	}
+	return false
+}
+
+func (x *UpdateItemRequest) GetToppings() []string {
+	if x != nil {
+		return x.Toppings
+	}
+	return nil
+}
+
+type RemoveItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	LineId        string                 `protobuf:"bytes,2,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveItemRequest) Reset() {
+	*x = RemoveItemRequest{}
+	mi := &file_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemRequest) ProtoMessage() {}
+
+func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RemoveItemRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RemoveItemRequest) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+type SetTipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Tip           float64                `protobuf:"fixed64,2,opt,name=tip,proto3" json:"tip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTipRequest) Reset() {
+	*x = SetTipRequest{}
+	mi := &file_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTipRequest) ProtoMessage() {}
+
+func (x *SetTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTipRequest.ProtoReflect.Descriptor instead.
+func (*SetTipRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetTipRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SetTipRequest) GetTip() float64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     string                 `protobuf:"bytes,4,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StatusChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *StatusChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *StatusChange) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *StatusChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type OrderHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Entries       []*StatusChange        `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderHistory) Reset() {
+	*x = OrderHistory{}
+	mi := &file_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderHistory) ProtoMessage() {}
+
+func (x *OrderHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderHistory.ProtoReflect.Descriptor instead.
+func (*OrderHistory) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrderHistory) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderHistory) GetEntries() []*StatusChange {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type OrderSaga struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrderId   string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	State     string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	PaymentId string                 `protobuf:"bytes,3,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// Тикет кухни первой точки, остальные - в tickets.
+	TicketId string `protobuf:"bytes,4,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	// Срок текущего шага, не задан - шаг не ограничен по времени.
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	CancelReason  string                 `protobuf:"bytes,6,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	FailureReason string                 `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Тикеты кухни по точкам, у разделенного заказа их несколько.
+	Tickets       []*SagaTicket `protobuf:"bytes,9,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderSaga) Reset() {
+	*x = OrderSaga{}
+	mi := &file_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderSaga) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderSaga) ProtoMessage() {}
+
+func (x *OrderSaga) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderSaga.ProtoReflect.Descriptor instead.
+func (*OrderSaga) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OrderSaga) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderSaga) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OrderSaga) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *OrderSaga) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *OrderSaga) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *OrderSaga) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *OrderSaga) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *OrderSaga) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OrderSaga) GetTickets() []*SagaTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type SagaTicket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	TicketId      string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SagaTicket) Reset() {
+	*x = SagaTicket{}
+	mi := &file_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SagaTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SagaTicket) ProtoMessage() {}
+
+func (x *SagaTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SagaTicket.ProtoReflect.Descriptor instead.
+func (*SagaTicket) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SagaTicket) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *SagaTicket) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+type ReorderRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId     string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReorderRequest) Reset() {
+	*x = ReorderRequest{}
+	mi := &file_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRequest) ProtoMessage() {}
+
+func (x *ReorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReorderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReorderRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ReorderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ReorderChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// item_dropped, topping_dropped или price_changed.
+	Kind        string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ProductId   string `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName string `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Topping     string `protobuf:"bytes,4,opt,name=topping,proto3" json:"topping,omitempty"`
+	// Стоимость строки в исходном и новом заказе.
+	OldPrice      float64 `protobuf:"fixed64,5,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      float64 `protobuf:"fixed64,6,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChange) Reset() {
+	*x = ReorderChange{}
+	mi := &file_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChange) ProtoMessage() {}
+
+func (x *ReorderChange) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChange.ProtoReflect.Descriptor instead.
+func (*ReorderChange) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReorderChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReorderChange) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReorderChange) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ReorderChange) GetTopping() string {
+	if x != nil {
+		return x.Topping
+	}
+	return ""
+}
+
+func (x *ReorderChange) GetOldPrice() float64 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *ReorderChange) GetNewPrice() float64 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+type ReorderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Changes       []*ReorderChange       `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderResponse) Reset() {
+	*x = ReorderResponse{}
+	mi := &file_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderResponse) ProtoMessage() {}
+
+func (x *ReorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderResponse.ProtoReflect.Descriptor instead.
+func (*ReorderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReorderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ReorderResponse) GetChanges() []*ReorderChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SavedAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Address       *Address               `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedAddress) Reset() {
+	*x = SavedAddress{}
+	mi := &file_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedAddress) ProtoMessage() {}
+
+func (x *SavedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedAddress.ProtoReflect.Descriptor instead.
+func (*SavedAddress) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SavedAddress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedAddress) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *SavedAddress) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SavedAddress) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *SavedAddress) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *SavedAddress) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	MakeDefault   bool                   `protobuf:"varint,4,opt,name=make_default,json=makeDefault,proto3" json:"make_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAddressRequest) Reset() {
+	*x = AddAddressRequest{}
+	mi := &file_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAddressRequest) ProtoMessage() {}
+
+func (x *AddAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddAddressRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *AddAddressRequest) GetMakeDefault() bool {
+	if x != nil {
+		return x.MakeDefault
+	}
+	return false
+}
+
+type UpdateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Address       *Address               `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressRequest) Reset() {
+	*x = UpdateAddressRequest{}
+	mi := &file_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressRequest) ProtoMessage() {}
+
+func (x *UpdateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateAddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpdateAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type ListAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressesRequest) Reset() {
+	*x = ListAddressesRequest{}
+	mi := &file_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesRequest) ProtoMessage() {}
+
+func (x *ListAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListAddressesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type ListAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*SavedAddress        `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressesResponse) Reset() {
+	*x = ListAddressesResponse{}
+	mi := &file_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesResponse) ProtoMessage() {}
+
+func (x *ListAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAddressesResponse) GetAddresses() []*SavedAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type AddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressRequest) Reset() {
+	*x = AddressRequest{}
+	mi := &file_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRequest) ProtoMessage() {}
+
+func (x *AddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressRequest.ProtoReflect.Descriptor instead.
+func (*AddressRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddressRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *AddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WatchOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *WatchOrderRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type OrderTrackingEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// status, kitchen или courier - какие из полей ниже заполнены.
+	Kind           string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	At             *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StoreId        string                 `protobuf:"bytes,5,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	TicketStatus   string                 `protobuf:"bytes,6,opt,name=ticket_status,json=ticketStatus,proto3" json:"ticket_status,omitempty"`
+	DeliveryStatus string                 `protobuf:"bytes,7,opt,name=delivery_status,json=deliveryStatus,proto3" json:"delivery_status,omitempty"`
+	// Координаты курьера, не заданы - еще неизвестны.
+	CourierLocation *GeoPoint `protobuf:"bytes,8,opt,name=courier_location,json=courierLocation,proto3" json:"courier_location,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrderTrackingEvent) Reset() {
+	*x = OrderTrackingEvent{}
+	mi := &file_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTrackingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTrackingEvent) ProtoMessage() {}
+
+func (x *OrderTrackingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTrackingEvent.ProtoReflect.Descriptor instead.
+func (*OrderTrackingEvent) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OrderTrackingEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderTrackingEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OrderTrackingEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *OrderTrackingEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderTrackingEvent) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *OrderTrackingEvent) GetTicketStatus() string {
+	if x != nil {
+		return x.TicketStatus
+	}
+	return ""
+}
+
+func (x *OrderTrackingEvent) GetDeliveryStatus() string {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return ""
+}
+
+func (x *OrderTrackingEvent) GetCourierLocation() *GeoPoint {
+	if x != nil {
+		return x.CourierLocation
+	}
+	return nil
+}
+
+var File_order_proto protoreflect.FileDescriptor
+
+const file_order_proto_rawDesc = "" +
+	"\n" +
+	"\vorder.proto\x12\torders.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x01\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x16\n" +
+	"\x06street\x18\x02 \x01(\tR\x06street\x12\x1a\n" +
+	"\bdistrict\x18\x03 \x01(\tR\bdistrict\x12/\n" +
+	"\blocation\x18\x04 \x01(\v2\x13.orders.v1.GeoPointR\blocation\x12\x14\n" +
+	"\x05house\x18\x05 \x01(\tR\x05house\x12\x1c\n" +
+	"\tapartment\x18\x06 \x01(\tR\tapartment\x12\x14\n" +
+	"\x05floor\x18\a \x01(\tR\x05floor\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\".\n" +
+	"\bGeoPoint\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\"\xd7\x01\n" +
+	"\tOrderItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1a\n" +
+	"\btoppings\x18\x04 \x03(\tR\btoppings\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\tR\x04size\x12\x14\n" +
+	"\x05crust\x18\x06 \x01(\tR\x05crust\x12&\n" +
+	"\x0fhalf_product_id\x18\a \x01(\tR\rhalfProductId\"\xcb\x02\n" +
+	"\x12CreateOrderRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12,\n" +
+	"\aaddress\x18\x02 \x01(\v2\x12.orders.v1.AddressR\aaddress\x12*\n" +
+	"\x05items\x18\x03 \x03(\v2\x14.orders.v1.OrderItemR\x05items\x129\n" +
+	"\n" +
+	"deliver_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeliverAt\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x06 \x01(\tR\taddressId\x12%\n" +
+	"\x0epayment_method\x18\a \x01(\tR\rpaymentMethod\x12\x10\n" +
+	"\x03tip\x18\b \x01(\x01R\x03tip\"\xab\x01\n" +
+	"\x0fPayOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\bactor_id\x18\x02 \x01(\tB\x02\x18\x01R\aactorId\x12!\n" +
+	"\n" +
+	"actor_role\x18\x03 \x01(\tB\x02\x18\x01R\tactorRole\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\",\n" +
+	"\x0fGetOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x82\x02\n" +
+	"\x11ListOrdersRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12=\n" +
+	"\fcreated_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"f\n" +
+	"\x12ListOrdersResponse\x12(\n" +
+	"\x06orders\x18\x01 \x03(\v2\x10.orders.v1.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x89\x01\n" +
+	"\x16OrderTransitionRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\bactor_id\x18\x02 \x01(\tB\x02\x18\x01R\aactorId\x12!\n" +
+	"\n" +
+	"actor_role\x18\x03 \x01(\tB\x02\x18\x01R\tactorRole\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xa3\x01\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1d\n" +
+	"\bactor_id\x18\x04 \x01(\tB\x02\x18\x01R\aactorId\x12!\n" +
+	"\n" +
+	"actor_role\x18\x05 \x01(\tB\x02\x18\x01R\tactorRole\"\xc7\x02\n" +
+	"\fCancellation\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x04 \x01(\tR\tactorRole\x12;\n" +
+	"\vcanceled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"canceledAt\x12'\n" +
+	"\x0fprevious_status\x18\x06 \x01(\tR\x0epreviousStatus\x12'\n" +
+	"\x0frefund_required\x18\a \x01(\bR\x0erefundRequired\x12<\n" +
+	"\x1aticket_withdrawal_required\x18\b \x01(\bR\x18ticketWithdrawalRequired\"3\n" +
+	"\aTopping\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\"\xbc\x03\n" +
+	"\tOrderLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\x04 \x01(\x01R\tbasePrice\x12'\n" +
+	"\x0fsize_multiplier\x18\x05 \x01(\x01R\x0esizeMultiplier\x12.\n" +
+	"\btoppings\x18\x06 \x03(\v2\x12.orders.v1.ToppingR\btoppings\x12\x1f\n" +
+	"\vtotal_price\x18\a \x01(\x01R\n" +
+	"totalPrice\x12\x17\n" +
+	"\aline_id\x18\b \x01(\tR\x06lineId\x12)\n" +
+	"\x04size\x18\t \x01(\v2\x15.orders.v1.ItemOptionR\x04size\x12+\n" +
+	"\x05crust\x18\n" +
+	" \x01(\v2\x15.orders.v1.ItemOptionR\x05crust\x12,\n" +
+	"\x06halves\x18\v \x03(\v2\x14.orders.v1.PizzaHalfR\x06halves\x12\x19\n" +
+	"\bstore_id\x18\f \x01(\tR\astoreId\"T\n" +
+	"\n" +
+	"ItemOption\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x01R\n" +
+	"multiplier\"l\n" +
+	"\tPizzaHalf\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\x03 \x01(\x01R\tbasePrice\"\x97\x05\n" +
+	"\x05Order\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12!\n" +
+	"\forder_number\x18\x02 \x01(\tR\vorderNumber\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12,\n" +
+	"\aaddress\x18\x05 \x01(\v2\x12.orders.v1.AddressR\aaddress\x12*\n" +
+	"\x05items\x18\x06 \x03(\v2\x14.orders.v1.OrderLineR\x05items\x12%\n" +
+	"\x0edelivery_price\x18\a \x01(\x01R\rdeliveryPrice\x12\x1a\n" +
+	"\bdiscount\x18\b \x01(\x01R\bdiscount\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\t \x01(\tR\tpromoCode\x12\x1f\n" +
+	"\vfinal_price\x18\n" +
+	" \x01(\x01R\n" +
+	"finalPrice\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\fcancellation\x18\f \x01(\v2\x17.orders.v1.CancellationR\fcancellation\x12?\n" +
+	"\rscheduled_for\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\x12\x1b\n" +
+	"\tstore_ids\x18\x0e \x03(\tR\bstoreIds\x12%\n" +
+	"\x0epayment_method\x18\x0f \x01(\tR\rpaymentMethod\x12=\n" +
+	"\x0fprice_breakdown\x18\x10 \x03(\v2\x14.orders.v1.PriceLineR\x0epriceBreakdown\"7\n" +
+	"\tPriceLine\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xfd\x03\n" +
+	"\x05Promo\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\x12!\n" +
+	"\fmax_discount\x18\x05 \x01(\x01R\vmaxDiscount\x12\x17\n" +
+	"\abuy_qty\x18\x06 \x01(\x05R\x06buyQty\x12\x19\n" +
+	"\bfree_qty\x18\a \x01(\x05R\afreeQty\x129\n" +
+	"\n" +
+	"valid_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06active\x12&\n" +
+	"\x0fmin_order_value\x18\v \x01(\x01R\rminOrderValue\x12\x1f\n" +
+	"\vproduct_ids\x18\f \x03(\tR\n" +
+	"productIds\x12!\n" +
+	"\fcategory_ids\x18\r \x03(\x05R\vcategoryIds\x12\x1f\n" +
+	"\vusage_limit\x18\x0e \x01(\x05R\n" +
+	"usageLimit\x12,\n" +
+	"\x12per_customer_limit\x18\x0f \x01(\x05R\x10perCustomerLimit\"=\n" +
+	"\fPromoRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"|\n" +
+	"\x0fPromoValidation\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bdiscount\x18\x03 \x01(\x01R\bdiscount\x12\x1f\n" +
+	"\vfinal_price\x18\x04 \x01(\x01R\n" +
+	"finalPrice\"\xee\x01\n" +
+	"\rDeliveryQuote\x12\x17\n" +
+	"\azone_id\x18\x01 \x01(\tR\x06zoneId\x12\x1b\n" +
+	"\tzone_name\x18\x02 \x01(\tR\bzoneName\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\x03 \x01(\x01R\tbasePrice\x12\x1c\n" +
+	"\tsurcharge\x18\x04 \x01(\x01R\tsurcharge\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12,\n" +
+	"\x12free_delivery_from\x18\x06 \x01(\x01R\x10freeDeliveryFrom\x12&\n" +
+	"\x0fmin_order_value\x18\a \x01(\x01R\rminOrderValue\"\xef\x01\n" +
+	"\n" +
+	"OrderQuote\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.orders.v1.OrderLineR\x05items\x12\x1f\n" +
+	"\vitems_total\x18\x02 \x01(\x01R\n" +
+	"itemsTotal\x124\n" +
+	"\bdelivery\x18\x03 \x01(\v2\x18.orders.v1.DeliveryQuoteR\bdelivery\x12\x1f\n" +
+	"\vfinal_price\x18\x04 \x01(\x01R\n" +
+	"finalPrice\x12=\n" +
+	"\x0fprice_breakdown\x18\x05 \x03(\v2\x14.orders.v1.PriceLineR\x0epriceBreakdown\"U\n" +
+	"\x0eAddItemRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12(\n" +
+	"\x04item\x18\x02 \x01(\v2\x14.orders.v1.OrderItemR\x04item\"\xaa\x01\n" +
+	"\x11UpdateItemRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\aline_id\x18\x02 \x01(\tR\x06lineId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12)\n" +
+	"\x10replace_toppings\x18\x04 \x01(\bR\x0freplaceToppings\x12\x1a\n" +
+	"\btoppings\x18\x05 \x03(\tR\btoppings\"G\n" +
+	"\x11RemoveItemRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\aline_id\x18\x02 \x01(\tR\x06lineId\"<\n" +
+	"\rSetTipRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x10\n" +
+	"\x03tip\x18\x02 \x01(\x01R\x03tip\"\xaf\x01\n" +
+	"\fStatusChange\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"changed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x04 \x01(\tR\tactorRole\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"\\\n" +
+	"\fOrderHistory\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x121\n" +
+	"\aentries\x18\x02 \x03(\v2\x17.orders.v1.StatusChangeR\aentries\"\xe8\x02\n" +
+	"\tOrderSaga\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x03 \x01(\tR\tpaymentId\x12\x1b\n" +
+	"\tticket_id\x18\x04 \x01(\tR\bticketId\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12#\n" +
+	"\rcancel_reason\x18\x06 \x01(\tR\fcancelReason\x12%\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\atickets\x18\t \x03(\v2\x15.orders.v1.SagaTicketR\atickets\"D\n" +
+	"\n" +
+	"SagaTicket\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\"u\n" +
+	"\x0eReorderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\xb9\x01\n" +
+	"\rReorderChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x18\n" +
+	"\atopping\x18\x04 \x01(\tR\atopping\x12\x1b\n" +
+	"\told_price\x18\x05 \x01(\x01R\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\x06 \x01(\x01R\bnewPrice\"m\n" +
+	"\x0fReorderResponse\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.orders.v1.OrderR\x05order\x122\n" +
+	"\achanges\x18\x02 \x03(\v2\x18.orders.v1.ReorderChangeR\achanges\"\xdd\x01\n" +
+	"\fSavedAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12,\n" +
+	"\aaddress\x18\x04 \x01(\v2\x12.orders.v1.AddressR\aaddress\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9b\x01\n" +
+	"\x11AddAddressRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12,\n" +
+	"\aaddress\x18\x03 \x01(\v2\x12.orders.v1.AddressR\aaddress\x12!\n" +
+	"\fmake_default\x18\x04 \x01(\bR\vmakeDefault\"\x9a\x01\n" +
+	"\x14UpdateAddressRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12,\n" +
+	"\aaddress\x18\x04 \x01(\v2\x12.orders.v1.AddressR\aaddress\"7\n" +
+	"\x14ListAddressesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"N\n" +
+	"\x15ListAddressesResponse\x125\n" +
+	"\taddresses\x18\x01 \x03(\v2\x17.orders.v1.SavedAddressR\taddresses\"P\n" +
+	"\x0eAddressRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\"O\n" +
+	"\x11WatchOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\"\xb0\x02\n" +
+	"\x12OrderTrackingEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x19\n" +
+	"\bstore_id\x18\x05 \x01(\tR\astoreId\x12#\n" +
+	"\rticket_status\x18\x06 \x01(\tR\fticketStatus\x12'\n" +
+	"\x0fdelivery_status\x18\a \x01(\tR\x0edeliveryStatus\x12>\n" +
+	"\x10courier_location\x18\b \x01(\v2\x13.orders.v1.GeoPointR\x0fcourierLocation2\x8c\x0e\n" +
+	"\fOrderService\x12>\n" +
+	"\vCreateOrder\x12\x1d.orders.v1.CreateOrderRequest\x1a\x10.orders.v1.Order\x12B\n" +
+	"\n" +
+	"QuoteOrder\x12\x1d.orders.v1.CreateOrderRequest\x1a\x15.orders.v1.OrderQuote\x128\n" +
+	"\bPayOrder\x12\x1a.orders.v1.PayOrderRequest\x1a\x10.orders.v1.Order\x128\n" +
+	"\bGetOrder\x12\x1a.orders.v1.GetOrderRequest\x1a\x10.orders.v1.Order\x12F\n" +
+	"\x0fGetOrderHistory\x12\x1a.orders.v1.GetOrderRequest\x1a\x17.orders.v1.OrderHistory\x12@\n" +
+	"\fGetOrderSaga\x12\x1a.orders.v1.GetOrderRequest\x1a\x14.orders.v1.OrderSaga\x12I\n" +
+	"\n" +
+	"ListOrders\x12\x1c.orders.v1.ListOrdersRequest\x1a\x1d.orders.v1.ListOrdersResponse\x12D\n" +
+	"\rSendToKitchen\x12!.orders.v1.OrderTransitionRequest\x1a\x10.orders.v1.Order\x12@\n" +
+	"\tMarkReady\x12!.orders.v1.OrderTransitionRequest\x1a\x10.orders.v1.Order\x12E\n" +
+	"\x0eShipToDelivery\x12!.orders.v1.OrderTransitionRequest\x1a\x10.orders.v1.Order\x12G\n" +
+	"\x10CompleteDelivery\x12!.orders.v1.OrderTransitionRequest\x1a\x10.orders.v1.Order\x12>\n" +
+	"\vCancelOrder\x12\x1d.orders.v1.CancelOrderRequest\x1a\x10.orders.v1.Order\x121\n" +
+	"\vCreatePromo\x12\x10.orders.v1.Promo\x1a\x10.orders.v1.Promo\x121\n" +
+	"\vUpdatePromo\x12\x10.orders.v1.Promo\x1a\x10.orders.v1.Promo\x12D\n" +
+	"\rValidatePromo\x12\x17.orders.v1.PromoRequest\x1a\x1a.orders.v1.PromoValidation\x127\n" +
+	"\n" +
+	"ApplyPromo\x12\x17.orders.v1.PromoRequest\x1a\x10.orders.v1.Order\x126\n" +
+	"\aAddItem\x12\x19.orders.v1.AddItemRequest\x1a\x10.orders.v1.Order\x12<\n" +
+	"\n" +
+	"UpdateItem\x12\x1c.orders.v1.UpdateItemRequest\x1a\x10.orders.v1.Order\x12<\n" +
+	"\n" +
+	"RemoveItem\x12\x1c.orders.v1.RemoveItemRequest\x1a\x10.orders.v1.Order\x124\n" +
+	"\x06SetTip\x12\x18.orders.v1.SetTipRequest\x1a\x10.orders.v1.Order\x12@\n" +
+	"\aReorder\x12\x19.orders.v1.ReorderRequest\x1a\x1a.orders.v1.ReorderResponse\x12C\n" +
+	"\n" +
+	"AddAddress\x12\x1c.orders.v1.AddAddressRequest\x1a\x17.orders.v1.SavedAddress\x12I\n" +
+	"\rUpdateAddress\x12\x1f.orders.v1.UpdateAddressRequest\x1a\x17.orders.v1.SavedAddress\x12R\n" +
+	"\rListAddresses\x12\x1f.orders.v1.ListAddressesRequest\x1a .orders.v1.ListAddressesResponse\x12L\n" +
+	"\rDeleteAddress\x12\x19.orders.v1.AddressRequest\x1a .orders.v1.ListAddressesResponse\x12G\n" +
+	"\x11SetDefaultAddress\x12\x19.orders.v1.AddressRequest\x1a\x17.orders.v1.SavedAddress\x12K\n" +
+	"\n" +
+	"WatchOrder\x12\x1c.orders.v1.WatchOrderRequest\x1a\x1d.orders.v1.OrderTrackingEvent0\x01B\x10Z\x0e./pb;orders_pbb\x06proto3"
 
 var (
 	file_order_proto_rawDescOnce sync.Once
@@ -347,26 +3453,149 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_order_proto_goTypes = []any{
-	(*Address)(nil),            // 0: orders.v1.Address
-	(*OrderItem)(nil),          // 1: orders.v1.OrderItem
-	(*CreateOrderRequest)(nil), // 2: orders.v1.CreateOrderRequest
-	(*PayOrderRequest)(nil),    // 3: orders.v1.PayOrderRequest
-	(*OrderResponse)(nil),      // 4: orders.v1.OrderResponse
+	(*Address)(nil),                // 0: orders.v1.Address
+	(*GeoPoint)(nil),               // 1: orders.v1.GeoPoint
+	(*OrderItem)(nil),              // 2: orders.v1.OrderItem
+	(*CreateOrderRequest)(nil),     // 3: orders.v1.CreateOrderRequest
+	(*PayOrderRequest)(nil),        // 4: orders.v1.PayOrderRequest
+	(*GetOrderRequest)(nil),        // 5: orders.v1.GetOrderRequest
+	(*ListOrdersRequest)(nil),      // 6: orders.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),     // 7: orders.v1.ListOrdersResponse
+	(*OrderTransitionRequest)(nil), // 8: orders.v1.OrderTransitionRequest
+	(*CancelOrderRequest)(nil),     // 9: orders.v1.CancelOrderRequest
+	(*Cancellation)(nil),           // 10: orders.v1.Cancellation
+	(*Topping)(nil),                // 11: orders.v1.Topping
+	(*OrderLine)(nil),              // 12: orders.v1.OrderLine
+	(*ItemOption)(nil),             // 13: orders.v1.ItemOption
+	(*PizzaHalf)(nil),              // 14: orders.v1.PizzaHalf
+	(*Order)(nil),                  // 15: orders.v1.Order
+	(*PriceLine)(nil),              // 16: orders.v1.PriceLine
+	(*Promo)(nil),                  // 17: orders.v1.Promo
+	(*PromoRequest)(nil),           // 18: orders.v1.PromoRequest
+	(*PromoValidation)(nil),        // 19: orders.v1.PromoValidation
+	(*DeliveryQuote)(nil),          // 20: orders.v1.DeliveryQuote
+	(*OrderQuote)(nil),             // 21: orders.v1.OrderQuote
+	(*AddItemRequest)(nil),         // 22: orders.v1.AddItemRequest
+	(*UpdateItemRequest)(nil),      // 23: orders.v1.UpdateItemRequest
+	(*RemoveItemRequest)(nil),      // 24: orders.v1.RemoveItemRequest
+	(*SetTipRequest)(nil),          // 25: orders.v1.SetTipRequest
+	(*StatusChange)(nil),           // 26: orders.v1.StatusChange
+	(*OrderHistory)(nil),           // 27: orders.v1.OrderHistory
+	(*OrderSaga)(nil),              // 28: orders.v1.OrderSaga
+	(*SagaTicket)(nil),             // 29: orders.v1.SagaTicket
+	(*ReorderRequest)(nil),         // 30: orders.v1.ReorderRequest
+	(*ReorderChange)(nil),          // 31: orders.v1.ReorderChange
+	(*ReorderResponse)(nil),        // 32: orders.v1.ReorderResponse
+	(*SavedAddress)(nil),           // 33: orders.v1.SavedAddress
+	(*AddAddressRequest)(nil),      // 34: orders.v1.AddAddressRequest
+	(*UpdateAddressRequest)(nil),   // 35: orders.v1.UpdateAddressRequest
+	(*ListAddressesRequest)(nil),   // 36: orders.v1.ListAddressesRequest
+	(*ListAddressesResponse)(nil),  // 37: orders.v1.ListAddressesResponse
+	(*AddressRequest)(nil),         // 38: orders.v1.AddressRequest
+	(*WatchOrderRequest)(nil),      // 39: orders.v1.WatchOrderRequest
+	(*OrderTrackingEvent)(nil),     // 40: orders.v1.OrderTrackingEvent
+	(*timestamppb.Timestamp)(nil),  // 41: google.protobuf.Timestamp
 }
 var file_order_proto_depIdxs = []int32{
-	0, // 0: orders.v1.CreateOrderRequest.address:type_name -> orders.v1.Address
-	1, // 1: orders.v1.CreateOrderRequest.items:type_name -> orders.v1.OrderItem
-	2, // 2: orders.v1.OrderService.CreateOrder:input_type -> orders.v1.CreateOrderRequest
-	3, // 3: orders.v1.OrderService.PayOrder:input_type -> orders.v1.PayOrderRequest
-	4, // 4: orders.v1.OrderService.CreateOrder:output_type -> orders.v1.OrderResponse
-	4, // 5: orders.v1.OrderService.PayOrder:output_type -> orders.v1.OrderResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: orders.v1.Address.location:type_name -> orders.v1.GeoPoint
+	0,  // 1: orders.v1.CreateOrderRequest.address:type_name -> orders.v1.Address
+	2,  // 2: orders.v1.CreateOrderRequest.items:type_name -> orders.v1.OrderItem
+	41, // 3: orders.v1.CreateOrderRequest.deliver_at:type_name -> google.protobuf.Timestamp
+	41, // 4: orders.v1.ListOrdersRequest.created_from:type_name -> google.protobuf.Timestamp
+	41, // 5: orders.v1.ListOrdersRequest.created_to:type_name -> google.protobuf.Timestamp
+	15, // 6: orders.v1.ListOrdersResponse.orders:type_name -> orders.v1.Order
+	41, // 7: orders.v1.Cancellation.canceled_at:type_name -> google.protobuf.Timestamp
+	11, // 8: orders.v1.OrderLine.toppings:type_name -> orders.v1.Topping
+	13, // 9: orders.v1.OrderLine.size:type_name -> orders.v1.ItemOption
+	13, // 10: orders.v1.OrderLine.crust:type_name -> orders.v1.ItemOption
+	14, // 11: orders.v1.OrderLine.halves:type_name -> orders.v1.PizzaHalf
+	0,  // 12: orders.v1.Order.address:type_name -> orders.v1.Address
+	12, // 13: orders.v1.Order.items:type_name -> orders.v1.OrderLine
+	41, // 14: orders.v1.Order.created_at:type_name -> google.protobuf.Timestamp
+	10, // 15: orders.v1.Order.cancellation:type_name -> orders.v1.Cancellation
+	41, // 16: orders.v1.Order.scheduled_for:type_name -> google.protobuf.Timestamp
+	16, // 17: orders.v1.Order.price_breakdown:type_name -> orders.v1.PriceLine
+	41, // 18: orders.v1.Promo.valid_from:type_name -> google.protobuf.Timestamp
+	41, // 19: orders.v1.Promo.valid_to:type_name -> google.protobuf.Timestamp
+	12, // 20: orders.v1.OrderQuote.items:type_name -> orders.v1.OrderLine
+	20, // 21: orders.v1.OrderQuote.delivery:type_name -> orders.v1.DeliveryQuote
+	16, // 22: orders.v1.OrderQuote.price_breakdown:type_name -> orders.v1.PriceLine
+	2,  // 23: orders.v1.AddItemRequest.item:type_name -> orders.v1.OrderItem
+	41, // 24: orders.v1.StatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	26, // 25: orders.v1.OrderHistory.entries:type_name -> orders.v1.StatusChange
+	41, // 26: orders.v1.OrderSaga.deadline:type_name -> google.protobuf.Timestamp
+	41, // 27: orders.v1.OrderSaga.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 28: orders.v1.OrderSaga.tickets:type_name -> orders.v1.SagaTicket
+	15, // 29: orders.v1.ReorderResponse.order:type_name -> orders.v1.Order
+	31, // 30: orders.v1.ReorderResponse.changes:type_name -> orders.v1.ReorderChange
+	0,  // 31: orders.v1.SavedAddress.address:type_name -> orders.v1.Address
+	41, // 32: orders.v1.SavedAddress.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 33: orders.v1.AddAddressRequest.address:type_name -> orders.v1.Address
+	0,  // 34: orders.v1.UpdateAddressRequest.address:type_name -> orders.v1.Address
+	33, // 35: orders.v1.ListAddressesResponse.addresses:type_name -> orders.v1.SavedAddress
+	41, // 36: orders.v1.OrderTrackingEvent.at:type_name -> google.protobuf.Timestamp
+	1,  // 37: orders.v1.OrderTrackingEvent.courier_location:type_name -> orders.v1.GeoPoint
+	3,  // 38: orders.v1.OrderService.CreateOrder:input_type -> orders.v1.CreateOrderRequest
+	3,  // 39: orders.v1.OrderService.QuoteOrder:input_type -> orders.v1.CreateOrderRequest
+	4,  // 40: orders.v1.OrderService.PayOrder:input_type -> orders.v1.PayOrderRequest
+	5,  // 41: orders.v1.OrderService.GetOrder:input_type -> orders.v1.GetOrderRequest
+	5,  // 42: orders.v1.OrderService.GetOrderHistory:input_type -> orders.v1.GetOrderRequest
+	5,  // 43: orders.v1.OrderService.GetOrderSaga:input_type -> orders.v1.GetOrderRequest
+	6,  // 44: orders.v1.OrderService.ListOrders:input_type -> orders.v1.ListOrdersRequest
+	8,  // 45: orders.v1.OrderService.SendToKitchen:input_type -> orders.v1.OrderTransitionRequest
+	8,  // 46: orders.v1.OrderService.MarkReady:input_type -> orders.v1.OrderTransitionRequest
+	8,  // 47: orders.v1.OrderService.ShipToDelivery:input_type -> orders.v1.OrderTransitionRequest
+	8,  // 48: orders.v1.OrderService.CompleteDelivery:input_type -> orders.v1.OrderTransitionRequest
+	9,  // 49: orders.v1.OrderService.CancelOrder:input_type -> orders.v1.CancelOrderRequest
+	17, // 50: orders.v1.OrderService.CreatePromo:input_type -> orders.v1.Promo
+	17, // 51: orders.v1.OrderService.UpdatePromo:input_type -> orders.v1.Promo
+	18, // 52: orders.v1.OrderService.ValidatePromo:input_type -> orders.v1.PromoRequest
+	18, // 53: orders.v1.OrderService.ApplyPromo:input_type -> orders.v1.PromoRequest
+	22, // 54: orders.v1.OrderService.AddItem:input_type -> orders.v1.AddItemRequest
+	23, // 55: orders.v1.OrderService.UpdateItem:input_type -> orders.v1.UpdateItemRequest
+	24, // 56: orders.v1.OrderService.RemoveItem:input_type -> orders.v1.RemoveItemRequest
+	25, // 57: orders.v1.OrderService.SetTip:input_type -> orders.v1.SetTipRequest
+	30, // 58: orders.v1.OrderService.Reorder:input_type -> orders.v1.ReorderRequest
+	34, // 59: orders.v1.OrderService.AddAddress:input_type -> orders.v1.AddAddressRequest
+	35, // 60: orders.v1.OrderService.UpdateAddress:input_type -> orders.v1.UpdateAddressRequest
+	36, // 61: orders.v1.OrderService.ListAddresses:input_type -> orders.v1.ListAddressesRequest
+	38, // 62: orders.v1.OrderService.DeleteAddress:input_type -> orders.v1.AddressRequest
+	38, // 63: orders.v1.OrderService.SetDefaultAddress:input_type -> orders.v1.AddressRequest
+	39, // 64: orders.v1.OrderService.WatchOrder:input_type -> orders.v1.WatchOrderRequest
+	15, // 65: orders.v1.OrderService.CreateOrder:output_type -> orders.v1.Order
+	21, // 66: orders.v1.OrderService.QuoteOrder:output_type -> orders.v1.OrderQuote
+	15, // 67: orders.v1.OrderService.PayOrder:output_type -> orders.v1.Order
+	15, // 68: orders.v1.OrderService.GetOrder:output_type -> orders.v1.Order
+	27, // 69: orders.v1.OrderService.GetOrderHistory:output_type -> orders.v1.OrderHistory
+	28, // 70: orders.v1.OrderService.GetOrderSaga:output_type -> orders.v1.OrderSaga
+	7,  // 71: orders.v1.OrderService.ListOrders:output_type -> orders.v1.ListOrdersResponse
+	15, // 72: orders.v1.OrderService.SendToKitchen:output_type -> orders.v1.Order
+	15, // 73: orders.v1.OrderService.MarkReady:output_type -> orders.v1.Order
+	15, // 74: orders.v1.OrderService.ShipToDelivery:output_type -> orders.v1.Order
+	15, // 75: orders.v1.OrderService.CompleteDelivery:output_type -> orders.v1.Order
+	15, // 76: orders.v1.OrderService.CancelOrder:output_type -> orders.v1.Order
+	17, // 77: orders.v1.OrderService.CreatePromo:output_type -> orders.v1.Promo
+	17, // 78: orders.v1.OrderService.UpdatePromo:output_type -> orders.v1.Promo
+	19, // 79: orders.v1.OrderService.ValidatePromo:output_type -> orders.v1.PromoValidation
+	15, // 80: orders.v1.OrderService.ApplyPromo:output_type -> orders.v1.Order
+	15, // 81: orders.v1.OrderService.AddItem:output_type -> orders.v1.Order
+	15, // 82: orders.v1.OrderService.UpdateItem:output_type -> orders.v1.Order
+	15, // 83: orders.v1.OrderService.RemoveItem:output_type -> orders.v1.Order
+	15, // 84: orders.v1.OrderService.SetTip:output_type -> orders.v1.Order
+	32, // 85: orders.v1.OrderService.Reorder:output_type -> orders.v1.ReorderResponse
+	33, // 86: orders.v1.OrderService.AddAddress:output_type -> orders.v1.SavedAddress
+	33, // 87: orders.v1.OrderService.UpdateAddress:output_type -> orders.v1.SavedAddress
+	37, // 88: orders.v1.OrderService.ListAddresses:output_type -> orders.v1.ListAddressesResponse
+	37, // 89: orders.v1.OrderService.DeleteAddress:output_type -> orders.v1.ListAddressesResponse
+	33, // 90: orders.v1.OrderService.SetDefaultAddress:output_type -> orders.v1.SavedAddress
+	40, // 91: orders.v1.OrderService.WatchOrder:output_type -> orders.v1.OrderTrackingEvent
+	65, // [65:92] is the sub-list for method output_type
+	38, // [38:65] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -380,7 +3609,7 @@ func file_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
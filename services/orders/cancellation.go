@@ -0,0 +1,143 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type CancelReason string
+
+const (
+	CancelReasonCustomerRequest CancelReason = "customer_request"
+	CancelReasonPaymentFailed   CancelReason = "payment_failed"
+	CancelReasonOutOfStock      CancelReason = "out_of_stock"
+	CancelReasonKitchenFailure  CancelReason = "kitchen_failure"
+	CancelReasonDeliveryFailed  CancelReason = "delivery_failed"
+	CancelReasonFraud           CancelReason = "fraud"
+	CancelReasonOther           CancelReason = "other"
+)
+
+func (r CancelReason) Valid() bool {
+	switch r {
+	case CancelReasonCustomerRequest, CancelReasonPaymentFailed, CancelReasonOutOfStock,
+		CancelReasonKitchenFailure, CancelReasonDeliveryFailed, CancelReasonFraud, CancelReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+type ActorRole string
+
+const (
+	RoleCustomer ActorRole = "customer"
+	RoleOperator ActorRole = "operator"
+	RoleManager  ActorRole = "manager"
+	RoleSystem   ActorRole = "system"
+)
+
+func ParseActorRole(s string) (ActorRole, error) {
+	switch r := ActorRole(s); r {
+	case RoleCustomer, RoleOperator, RoleManager, RoleSystem:
+		return r, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownRole, s)
+	}
+}
+
+// Actor - кто выполняет операцию над заказом.
+type Actor struct {
+	ID   string
+	Role ActorRole
+}
+
+// SystemActor - автоматические операции без участия человека.
+var SystemActor = Actor{ID: "system", Role: RoleSystem}
+
+// Cancellation - запись об отмене заказа.
+type Cancellation struct {
+	Reason         CancelReason
+	Comment        string
+	Actor          Actor
+	CanceledAt     time.Time
+	PreviousStatus OrderStatus
+}
+
+// RequiresRefund - заказ был оплачен, деньги нужно вернуть через treasury.
+func (c Cancellation) RequiresRefund() bool {
+	return c.PreviousStatus >= StatusPaid && c.PreviousStatus <= StatusDelivering
+}
+
+// RequiresTicketWithdrawal - на кухне уже есть тикет, его нужно снять.
+func (c Cancellation) RequiresTicketWithdrawal() bool {
+	return c.PreviousStatus == StatusCooking || c.PreviousStatus == StatusReady
+}
+
+var (
+	ErrInvalidCancelReason = errors.New("invalid cancel reason")
+	ErrUnknownRole         = errors.New("unknown actor role")
+	ErrCancelForbidden     = errors.New("actor is not allowed to cancel order")
+)
+
+// Cancel - отмена заказа.
+// До оплаты и сразу после нее заказ может отменить сам клиент,
+// во время готовки - только персонал, а в доставке - только менеджер.
+func (o *Order) Cancel(reason CancelReason, comment string, actor Actor) error {
+	if !reason.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidCancelReason, reason)
+	}
+
+	switch o.status {
+	case StatusCompleted, StatusCanceled:
+		return fmt.Errorf("%w: cannot cancel order in status %s", ErrInvalidTransition, o.status)
+	}
+
+	if err := o.checkCancelPermission(actor); err != nil {
+		return err
+	}
+
+	o.cancellation = &Cancellation{
+		Reason:         reason,
+		Comment:        comment,
+		Actor:          actor,
+		CanceledAt:     time.Now(),
+		PreviousStatus: o.status,
+	}
+
+	note := string(reason)
+	if comment != "" {
+		note += ": " + comment
+	}
+	o.setStatus(StatusCanceled, actor, note)
+	return nil
+}
+
+func (o *Order) checkCancelPermission(actor Actor) error {
+	switch actor.Role {
+	case RoleCustomer:
+		if actor.ID != o.customerID {
+			return fmt.Errorf("%w: order belongs to another customer", ErrCancelForbidden)
+		}
+		if o.status != StatusCreated && o.status != StatusPaid {
+			return fmt.Errorf("%w: order is already %s", ErrCancelForbidden, o.status)
+		}
+	case RoleOperator, RoleSystem:
+		if o.status == StatusDelivering {
+			return fmt.Errorf("%w: order in delivery can be canceled only by manager", ErrCancelForbidden)
+		}
+	case RoleManager:
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownRole, actor.Role)
+	}
+	return nil
+}
+
+// Cancellation - сведения об отмене, nil если заказ не отменен.
+func (o *Order) Cancellation() *Cancellation {
+	if o.cancellation == nil {
+		return nil
+	}
+	c := *o.cancellation
+	return &c
+}
@@ -23,7 +23,10 @@ func main() {
 }
 
 func RunServer(lc fx.Lifecycle, handler *grpc.OrdersHandler, logger *zap.Logger) {
-	server := stdgrpc.NewServer()
+	server := stdgrpc.NewServer(
+		stdgrpc.ChainUnaryInterceptor(grpc.UnaryErrorInterceptor),
+		stdgrpc.ChainStreamInterceptor(grpc.StreamErrorInterceptor),
+	)
 	handler.Register(server)
 	reflection.Register(server)
 
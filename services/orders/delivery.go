@@ -0,0 +1,212 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var (
+	ErrInvalidTariff    = errors.New("invalid delivery tariff")
+	ErrAddressNotServed = errors.New("address is outside delivery zones")
+	ErrBelowZoneMinimum = errors.New("order value is below zone minimum")
+	ErrInvalidTimeOfDay = errors.New("invalid time of day")
+)
+
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+// DeliveryZone - зона доставки. Задается либо полигоном по координатам,
+// либо городом и (необязательно) списком районов. Пустой город - любой город.
+type DeliveryZone struct {
+	ID        string
+	Name      string
+	City      string
+	Districts []string
+	Polygon   []GeoPoint
+
+	BasePrice common.Money
+	// FreeDeliveryFrom - сумма позиций, начиная с которой доставка бесплатна (ноль - никогда).
+	FreeDeliveryFrom common.Money
+	MinOrderValue    common.Money
+}
+
+// Contains - попадает ли адрес в зону. Полигональные зоны требуют координат.
+func (z DeliveryZone) Contains(addr DeliveryAddress) bool {
+	if len(z.Polygon) > 0 {
+		return addr.Location != nil && pointInPolygon(*addr.Location, z.Polygon)
+	}
+	if z.City != "" && !strings.EqualFold(z.City, addr.City) {
+		return false
+	}
+	if len(z.Districts) == 0 {
+		return true
+	}
+	for _, d := range z.Districts {
+		if strings.EqualFold(d, addr.District) {
+			return true
+		}
+	}
+	return false
+}
+
+// pointInPolygon - метод трассировки луча, для зон в пределах города плоской проекции достаточно.
+func pointInPolygon(p GeoPoint, polygon []GeoPoint) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// TimeOfDay - минуты от начала суток.
+type TimeOfDay int
+
+// ParseTimeOfDay - разбирает время в формате "15:04".
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidTimeOfDay, s)
+	}
+	return TimeOfDay(t.Hour()*60 + t.Minute()), nil
+}
+
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay(t.Hour()*60 + t.Minute())
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+// SurgeRule - надбавка к доставке в часы пик. Интервал [From, To) может переходить через полночь.
+type SurgeRule struct {
+	// Weekdays - дни действия, пустой список - все дни.
+	Weekdays  []time.Weekday
+	From      TimeOfDay
+	To        TimeOfDay
+	Surcharge common.Money
+}
+
+func (r SurgeRule) Applies(at time.Time) bool {
+	if len(r.Weekdays) > 0 {
+		found := false
+		for _, d := range r.Weekdays {
+			if d == at.Weekday() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	now := TimeOfDayOf(at)
+	if r.From <= r.To {
+		return now >= r.From && now < r.To
+	}
+	return now >= r.From || now < r.To
+}
+
+// DeliveryTariff - зоны доставки и надбавки. Зоны проверяются по порядку, выигрывает первая подходящая.
+type DeliveryTariff struct {
+	Zones  []DeliveryZone
+	Surges []SurgeRule
+	// Location - часовой пояс, в котором задано время надбавок.
+	Location *time.Location
+}
+
+func (t *DeliveryTariff) Validate() error {
+	if len(t.Zones) == 0 {
+		return fmt.Errorf("%w: no zones", ErrInvalidTariff)
+	}
+	for _, z := range t.Zones {
+		if z.ID == "" {
+			return fmt.Errorf("%w: zone ID is required", ErrInvalidTariff)
+		}
+		if len(z.Polygon) > 0 && len(z.Polygon) < 3 {
+			return fmt.Errorf("%w: polygon of zone %s needs at least 3 points", ErrInvalidTariff, z.ID)
+		}
+		if z.BasePrice.IsNegative() || z.FreeDeliveryFrom.IsNegative() || z.MinOrderValue.IsNegative() {
+			return fmt.Errorf("%w: negative amounts in zone %s", ErrInvalidTariff, z.ID)
+		}
+	}
+	for _, s := range t.Surges {
+		if !s.Surcharge.IsPositive() {
+			return fmt.Errorf("%w: surge surcharge must be positive", ErrInvalidTariff)
+		}
+	}
+	return nil
+}
+
+// DeliveryQuote - расчет стоимости доставки.
+type DeliveryQuote struct {
+	ZoneID           string
+	ZoneName         string
+	BasePrice        common.Money
+	Surcharge        common.Money
+	Price            common.Money
+	FreeDeliveryFrom common.Money
+	MinOrderValue    common.Money
+}
+
+// DeliveryPricer - расчет стоимости доставки по адресу и сумме позиций.
+type DeliveryPricer interface {
+	QuoteDelivery(addr DeliveryAddress, itemsTotal common.Money, at time.Time) (DeliveryQuote, error)
+}
+
+func (t *DeliveryTariff) QuoteDelivery(addr DeliveryAddress, itemsTotal common.Money, at time.Time) (DeliveryQuote, error) {
+	zone, ok := t.findZone(addr)
+	if !ok {
+		return DeliveryQuote{}, fmt.Errorf("%w: %s, %s", ErrAddressNotServed, addr.City, addr.Street)
+	}
+	if itemsTotal.LessThan(zone.MinOrderValue) {
+		return DeliveryQuote{}, fmt.Errorf("%w: zone %s requires %s", ErrBelowZoneMinimum, zone.Name, zone.MinOrderValue.StringFixed(2))
+	}
+
+	quote := DeliveryQuote{
+		ZoneID:           zone.ID,
+		ZoneName:         zone.Name,
+		BasePrice:        zone.BasePrice,
+		Surcharge:        common.ZeroMoney(),
+		FreeDeliveryFrom: zone.FreeDeliveryFrom,
+		MinOrderValue:    zone.MinOrderValue,
+	}
+
+	// Бесплатная доставка отменяет и надбавку часов пик
+	if zone.FreeDeliveryFrom.IsPositive() && !itemsTotal.LessThan(zone.FreeDeliveryFrom) {
+		quote.Price = common.ZeroMoney()
+		return quote, nil
+	}
+
+	if t.Location != nil {
+		at = at.In(t.Location)
+	}
+	for _, s := range t.Surges {
+		if s.Applies(at) {
+			quote.Surcharge = quote.Surcharge.Add(s.Surcharge)
+		}
+	}
+
+	quote.Price = quote.BasePrice.Add(quote.Surcharge)
+	return quote, nil
+}
+
+func (t *DeliveryTariff) findZone(addr DeliveryAddress) (DeliveryZone, bool) {
+	for _, z := range t.Zones {
+		if z.Contains(addr) {
+			return z, true
+		}
+	}
+	return DeliveryZone{}, false
+}
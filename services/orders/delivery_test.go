@@ -0,0 +1,88 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestDeliveryTariff_QuoteDelivery(t *testing.T) {
+	tariff := &DeliveryTariff{
+		Zones: []DeliveryZone{
+			{
+				ID:   "center",
+				Name: "Center",
+				// Квадрат вокруг центра Москвы
+				Polygon: []GeoPoint{
+					{Lat: 55.70, Lng: 37.55}, {Lat: 55.70, Lng: 37.70},
+					{Lat: 55.80, Lng: 37.70}, {Lat: 55.80, Lng: 37.55},
+				},
+				BasePrice:        common.NewMoney(99),
+				FreeDeliveryFrom: common.NewMoney(1000),
+			},
+			{ID: "south", Name: "South", City: "Moscow", Districts: []string{"Butovo"}, BasePrice: common.NewMoney(300), MinOrderValue: common.NewMoney(800)},
+			{ID: "moscow", Name: "Moscow", City: "Moscow", BasePrice: common.NewMoney(200)},
+		},
+		Surges: []SurgeRule{
+			{Weekdays: []time.Weekday{time.Friday, time.Saturday}, From: 18 * 60, To: 21 * 60, Surcharge: common.NewMoney(50)},
+			{From: 23 * 60, To: 2 * 60, Surcharge: common.NewMoney(100)},
+		},
+	}
+	if err := tariff.Validate(); err != nil {
+		t.Fatalf("invalid tariff: %v", err)
+	}
+
+	friday := time.Date(2026, 10, 16, 19, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	night := time.Date(2026, 10, 20, 1, 30, 0, 0, time.UTC)
+	center := &GeoPoint{Lat: 55.75, Lng: 37.62}
+
+	tests := []struct {
+		name     string
+		addr     DeliveryAddress
+		total    common.Money
+		at       time.Time
+		wantZone string
+		want     common.Money
+		wantErr  error
+	}{
+		{"polygon", DeliveryAddress{City: "Moscow", Location: center}, common.NewMoney(500), monday, "center", common.NewMoney(99), nil},
+		{"polygon free", DeliveryAddress{City: "Moscow", Location: center}, common.NewMoney(1000), friday, "center", common.ZeroMoney(), nil},
+		{"district", DeliveryAddress{City: "moscow", District: "Butovo"}, common.NewMoney(900), monday, "south", common.NewMoney(300), nil},
+		{"district minimum", DeliveryAddress{City: "Moscow", District: "Butovo"}, common.NewMoney(500), monday, "", common.Money{}, ErrBelowZoneMinimum},
+		{"city fallback", DeliveryAddress{City: "Moscow", District: "Khamovniki"}, common.NewMoney(500), monday, "moscow", common.NewMoney(200), nil},
+		{"peak hours", DeliveryAddress{City: "Moscow"}, common.NewMoney(500), friday, "moscow", common.NewMoney(250), nil},
+		{"surge over midnight", DeliveryAddress{City: "Moscow"}, common.NewMoney(500), night, "moscow", common.NewMoney(300), nil},
+		{"not served", DeliveryAddress{City: "Kazan"}, common.NewMoney(500), monday, "", common.Money{}, ErrAddressNotServed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := tariff.QuoteDelivery(tt.addr, tt.total, tt.at)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if q.ZoneID != tt.wantZone || !q.Price.Equal(tt.want) {
+				t.Errorf("expected %s/%v, got %s/%v", tt.wantZone, tt.want, q.ZoneID, q.Price)
+			}
+		})
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tod, err := ParseTimeOfDay("18:30")
+	if err != nil || tod != 18*60+30 || tod.String() != "18:30" {
+		t.Errorf("unexpected result %v (%v)", tod, err)
+	}
+	if _, err := ParseTimeOfDay("25:00"); !errors.Is(err, ErrInvalidTimeOfDay) {
+		t.Errorf("expected ErrInvalidTimeOfDay, got %v", err)
+	}
+}
@@ -28,11 +28,14 @@ const (
 
 type DeliveryAddress struct {
 	City      string
+	District  string
 	Street    string
 	House     string
 	Apartment string
 	Floor     string
 	Comment   string
+	// Location - координаты адреса, nil если адрес не геокодирован.
+	Location *GeoPoint
 }
 
 type Topping struct {
@@ -61,19 +64,38 @@ func (s OrderStatus) String() string {
 	}
 }
 
+// ParseOrderStatus - обратное преобразование для String().
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	for st := StatusCreated; st <= StatusCanceled; st++ {
+		if st.String() == s {
+			return st, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownStatus, s)
+}
+
 // --- Entities ---
 
 type OrderItem struct {
-	productID      string
-	productName    string
-	quantity       int
-	basePrice      common.Money
-	sizeMultiplier float64
-	toppings       []Topping
+	// id - стабильный идентификатор строки корзины.
+	id          string
+	productID   string
+	productName string
+	quantity    int
+	basePrice   common.Money
+	size        SizeOption
+	crust       CrustOption
+	// halves - состав пиццы "пополам", basePrice уже посчитана по ценам половин.
+	halves   []PizzaHalf
+	toppings []Topping
+	// storeID - точка, которая готовит позицию, пусто - еще не назначена.
+	storeID string
 }
 
 func (i *OrderItem) CalculateTotal() common.Money {
-	sizedPrice := i.basePrice.Mul(decimal.NewFromFloat(i.sizeMultiplier))
+	sizedPrice := i.basePrice.
+		Mul(decimal.NewFromFloat(i.size.Multiplier)).
+		Mul(decimal.NewFromFloat(i.crust.Multiplier))
 
 	toppingsPrice := common.ZeroMoney()
 	for _, t := range i.toppings {
@@ -84,12 +106,21 @@ func (i *OrderItem) CalculateTotal() common.Money {
 	return unitPrice.Mul(decimal.NewFromInt(int64(i.quantity)))
 }
 
+func (i *OrderItem) ID() string              { return i.id }
 func (i *OrderItem) ProductID() string       { return i.productID }
 func (i *OrderItem) ProductName() string     { return i.productName }
 func (i *OrderItem) Quantity() int           { return i.quantity }
 func (i *OrderItem) BasePrice() common.Money { return i.basePrice }
-func (i *OrderItem) Size() float64           { return i.sizeMultiplier }
+func (i *OrderItem) Size() SizeOption        { return i.size }
+func (i *OrderItem) Crust() CrustOption      { return i.crust }
+func (i *OrderItem) Halves() []PizzaHalf     { return i.halves }
 func (i *OrderItem) Toppings() []Topping     { return i.toppings }
+func (i *OrderItem) StoreID() string         { return i.storeID }
+
+// Options - размер, тесто и половины позиции, например для повторного заказа.
+func (i *OrderItem) Options() ItemOptions {
+	return ItemOptions{Size: i.size, Crust: i.crust, Halves: append([]PizzaHalf(nil), i.halves...)}
+}
 
 // --- Aggregate Root ---
 
@@ -107,25 +138,180 @@ type Order struct {
 	discount      common.Money
 	promoCode     string
 
+	paymentMethod PaymentMethod
+	packagingFee  common.Money
+	serviceFee    common.Money
+	// tip - чаевые курьеру, не входят в базу для скидки и сборов.
+	tip common.Money
+
 	finalPrice common.Money
+
+	cancellation *Cancellation
+	// scheduledFor - запрошенное время доставки предзаказа, нулевое - как можно скорее.
+	scheduledFor time.Time
+	// checkoutStarted - оплата запущена на FinalPrice, корзину и сумму больше менять нельзя.
+	checkoutStarted bool
+
+	statusChanges []StatusChange
+	events        []DomainEvent
+
+	// version - число сохранений заказа, 0 - еще не сохранялся. Хранилище отклоняет
+	// запись, если заказ успели сохранить после того, как его прочитали.
+	version int
 }
 
 // --- Factory ---
 
 func NewOrder(customerID string, address DeliveryAddress) *Order {
 	id, _ := uuid.NewV7()
-	return &Order{
-		id:          id.String(),
-		orderNumber: generateOrderNumber(),
-		customerID:  customerID,
-		status:      StatusCreated,
-		createdAt:   time.Now(),
-		address:     address,
-		items:       make([]*OrderItem, 0),
+	o := &Order{
+		id:            id.String(),
+		orderNumber:   generateOrderNumber(),
+		customerID:    customerID,
+		status:        StatusCreated,
+		createdAt:     time.Now(),
+		address:       address,
+		items:         make([]*OrderItem, 0),
 		deliveryPrice: common.ZeroMoney(),
 		discount:      common.ZeroMoney(),
+		paymentMethod: DefaultPaymentMethod,
+		packagingFee:  common.ZeroMoney(),
+		serviceFee:    common.ZeroMoney(),
+		tip:           common.ZeroMoney(),
 		finalPrice:    common.ZeroMoney(),
 	}
+	o.recordStatus(o.createdAt, Actor{ID: customerID, Role: RoleCustomer}, "")
+	return o
+}
+
+// OrderSnapshot - состояние заказа, сохраненное в хранилище.
+type OrderSnapshot struct {
+	ID            string
+	OrderNumber   string
+	CustomerID    string
+	Status        OrderStatus
+	CreatedAt     time.Time
+	Address       DeliveryAddress
+	Items         []OrderItemSnapshot
+	DeliveryPrice common.Money
+	Discount      common.Money
+	PromoCode     string
+	PaymentMethod PaymentMethod
+	PackagingFee  common.Money
+	ServiceFee    common.Money
+	Tip           common.Money
+	Cancellation  *Cancellation
+	ScheduledFor  time.Time
+	Version       int
+	// CheckoutStarted - оплата запущена, заказ закрыт для правок.
+	CheckoutStarted bool
+}
+
+type OrderItemSnapshot struct {
+	ID          string
+	ProductID   string
+	ProductName string
+	Quantity    int
+	BasePrice   common.Money
+	Size        SizeOption
+	Crust       CrustOption
+	Halves      []PizzaHalf
+	Toppings    []Topping
+	StoreID     string
+}
+
+// RestoreOrder - восстанавливает агрегат из хранилища.
+// Итоговая цена пересчитывается, а не берется из снимка.
+func RestoreOrder(s OrderSnapshot) *Order {
+	items := make([]*OrderItem, 0, len(s.Items))
+	for _, it := range s.Items {
+		toppings := make([]Topping, len(it.Toppings))
+		copy(toppings, it.Toppings)
+
+		items = append(items, &OrderItem{
+			id:          it.ID,
+			productID:   it.ProductID,
+			productName: it.ProductName,
+			quantity:    it.Quantity,
+			basePrice:   it.BasePrice,
+			size:        it.Size,
+			crust:       it.Crust,
+			halves:      append([]PizzaHalf(nil), it.Halves...),
+			toppings:    toppings,
+			storeID:     it.StoreID,
+		})
+	}
+
+	o := &Order{
+		id:              s.ID,
+		orderNumber:     s.OrderNumber,
+		customerID:      s.CustomerID,
+		status:          s.Status,
+		createdAt:       s.CreatedAt,
+		address:         s.Address,
+		items:           items,
+		deliveryPrice:   s.DeliveryPrice,
+		discount:        s.Discount,
+		promoCode:       s.PromoCode,
+		paymentMethod:   s.PaymentMethod,
+		packagingFee:    s.PackagingFee,
+		serviceFee:      s.ServiceFee,
+		tip:             s.Tip,
+		cancellation:    s.Cancellation,
+		scheduledFor:    s.ScheduledFor,
+		version:         s.Version,
+		checkoutStarted: s.CheckoutStarted,
+	}
+	o.recalculate()
+	return o
+}
+
+// Snapshot - состояние заказа для сохранения в хранилище.
+func (o *Order) Snapshot() OrderSnapshot {
+	items := make([]OrderItemSnapshot, 0, len(o.items))
+	for _, it := range o.items {
+		toppings := make([]Topping, len(it.toppings))
+		copy(toppings, it.toppings)
+
+		items = append(items, OrderItemSnapshot{
+			ID:          it.id,
+			ProductID:   it.productID,
+			ProductName: it.productName,
+			Quantity:    it.quantity,
+			BasePrice:   it.basePrice,
+			Size:        it.size,
+			Crust:       it.crust,
+			Halves:      append([]PizzaHalf(nil), it.halves...),
+			Toppings:    toppings,
+			StoreID:     it.storeID,
+		})
+	}
+
+	return OrderSnapshot{
+		ID:              o.id,
+		OrderNumber:     o.orderNumber,
+		CustomerID:      o.customerID,
+		Status:          o.status,
+		CreatedAt:       o.createdAt,
+		Address:         o.address,
+		Items:           items,
+		DeliveryPrice:   o.deliveryPrice,
+		Discount:        o.discount,
+		PromoCode:       o.promoCode,
+		PaymentMethod:   o.paymentMethod,
+		PackagingFee:    o.packagingFee,
+		ServiceFee:      o.serviceFee,
+		Tip:             o.tip,
+		Cancellation:    o.Cancellation(),
+		ScheduledFor:    o.scheduledFor,
+		Version:         o.version,
+		CheckoutStarted: o.checkoutStarted,
+	}
+}
+
+// MarkSaved - хранилище вызывает после успешной записи, следующий Save ждет новую версию.
+func (o *Order) MarkSaved() {
+	o.version++
 }
 
 // --- Errors ---
@@ -136,12 +322,18 @@ var (
 	ErrInvalidDiscount   = errors.New("invalid discount")
 	ErrInvalidTransition = errors.New("invalid status transition")
 	ErrOrderNotFound     = errors.New("order not found")
+	ErrUnknownStatus     = errors.New("unknown order status")
+	ErrItemNotFound      = errors.New("order item not found")
+	ErrLastItem          = errors.New("cannot remove the last order item")
+	// ErrConcurrentModification - заказ сохранили после того, как его прочитали, запись отклонена.
+	ErrConcurrentModification = errors.New("order was modified concurrently")
 )
 
 // --- Business Logic ---
 
-func (o *Order) AddItem(productID, name string, qty int, productBasePrice common.Money, sizeMult float64, toppings []Topping) error {
-	if o.status != StatusCreated {
+// AddItem - добавляет позицию. Для пиццы "пополам" productBasePrice - цена, уже посчитанная по половинам.
+func (o *Order) AddItem(productID, name string, qty int, productBasePrice common.Money, opts ItemOptions, toppings []Topping) error {
+	if !o.Editable() {
 		return ErrOrderLocked
 	}
 	if qty <= 0 {
@@ -150,22 +342,136 @@ func (o *Order) AddItem(productID, name string, qty int, productBasePrice common
 
 	toppingsCopy := make([]Topping, len(toppings))
 	copy(toppingsCopy, toppings)
+	opts = opts.withDefaults()
 
+	// UUIDv7 монотонны, порядок строк сохраняется при сортировке по ID
+	lineID, _ := uuid.NewV7()
 	o.items = append(o.items, &OrderItem{
-		productID:      productID,
-		productName:    name,
-		quantity:       qty,
-		basePrice:      productBasePrice,
-		sizeMultiplier: sizeMult,
-		toppings:       toppingsCopy,
+		id:          lineID.String(),
+		productID:   productID,
+		productName: name,
+		quantity:    qty,
+		basePrice:   productBasePrice,
+		size:        opts.Size,
+		crust:       opts.Crust,
+		halves:      opts.Halves,
+		toppings:    toppingsCopy,
 	})
 
 	o.recalculate()
 	return nil
 }
 
+func (o *Order) UpdateItemQuantity(lineID string, qty int) error {
+	item, err := o.editableItem(lineID)
+	if err != nil {
+		return err
+	}
+	if qty <= 0 {
+		return ErrInvalidQty
+	}
+
+	item.quantity = qty
+	o.recalculate()
+	return nil
+}
+
+// RemoveItem - удаляет строку корзины. Последнюю строку удалить нельзя, заказ нужно отменить.
+func (o *Order) RemoveItem(lineID string) error {
+	if _, err := o.editableItem(lineID); err != nil {
+		return err
+	}
+	if len(o.items) == 1 {
+		return ErrLastItem
+	}
+
+	for i, item := range o.items {
+		if item.id == lineID {
+			o.items = append(o.items[:i], o.items[i+1:]...)
+			break
+		}
+	}
+	o.recalculate()
+	return nil
+}
+
+func (o *Order) ReplaceToppings(lineID string, toppings []Topping) error {
+	item, err := o.editableItem(lineID)
+	if err != nil {
+		return err
+	}
+
+	toppingsCopy := make([]Topping, len(toppings))
+	copy(toppingsCopy, toppings)
+	item.toppings = toppingsCopy
+	o.recalculate()
+	return nil
+}
+
+// Item - строка корзины по ID.
+func (o *Order) Item(lineID string) (*OrderItem, bool) {
+	for _, item := range o.items {
+		if item.id == lineID {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// AssignStores - назначает точки приготовления всем строкам корзины (ID строки -> ID точки).
+func (o *Order) AssignStores(byLine map[string]string) error {
+	if !o.Editable() {
+		return ErrOrderLocked
+	}
+	for _, item := range o.items {
+		if byLine[item.id] == "" {
+			return fmt.Errorf("%w: no store for line %s", ErrItemNotFound, item.id)
+		}
+	}
+	for _, item := range o.items {
+		item.storeID = byLine[item.id]
+	}
+	return nil
+}
+
+// StoreIDs - точки, которые готовят заказ, в порядке строк. Больше одной - заказ разделен.
+func (o *Order) StoreIDs() []string {
+	var ids []string
+	seen := make(map[string]struct{})
+	for _, item := range o.items {
+		if _, ok := seen[item.storeID]; ok || item.storeID == "" {
+			continue
+		}
+		seen[item.storeID] = struct{}{}
+		ids = append(ids, item.storeID)
+	}
+	return ids
+}
+
+// ItemsForStore - часть заказа, которую готовит точка.
+func (o *Order) ItemsForStore(storeID string) []*OrderItem {
+	var result []*OrderItem
+	for _, item := range o.items {
+		if item.storeID == storeID {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (o *Order) editableItem(lineID string) (*OrderItem, error) {
+	if !o.Editable() {
+		return nil, ErrOrderLocked
+	}
+	item, ok := o.Item(lineID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrItemNotFound, lineID)
+	}
+	return item, nil
+}
+
 func (o *Order) ApplyPromoCode(code string, discountAmount common.Money) error {
-	if o.status != StatusCreated {
+	if !o.Editable() {
 		return ErrOrderLocked
 	}
 	if discountAmount.IsNegative() {
@@ -178,62 +484,158 @@ func (o *Order) ApplyPromoCode(code string, discountAmount common.Money) error {
 	return nil
 }
 
-func (o *Order) SetDeliveryPrice(price common.Money) {
+// ScheduleDelivery - делает заказ предзаказом на указанное время.
+// Проверка времени по расписанию заведения - забота вызывающего кода.
+func (o *Order) ScheduleDelivery(at time.Time) error {
+	if !o.Editable() {
+		return ErrOrderLocked
+	}
+	o.scheduledFor = at
+	return nil
+}
+
+// ClearPromoCode - снимает промокод, например когда после правки корзины он перестал подходить.
+func (o *Order) ClearPromoCode() error {
+	if !o.Editable() {
+		return ErrOrderLocked
+	}
+
+	o.promoCode = ""
+	o.discount = common.ZeroMoney()
+	o.recalculate()
+	return nil
+}
+
+func (o *Order) SetDeliveryPrice(price common.Money) error {
+	if !o.Editable() {
+		return ErrOrderLocked
+	}
 	o.deliveryPrice = price
 	o.recalculate()
+	return nil
 }
 
-func (o *Order) recalculate() {
+// ItemsTotal - стоимость позиций без доставки и скидки.
+func (o *Order) ItemsTotal() common.Money {
 	total := common.ZeroMoney()
 	for _, item := range o.items {
 		total = total.Add(item.CalculateTotal())
 	}
+	return total
+}
 
-	o.finalPrice = total.Add(o.deliveryPrice).Sub(o.discount)
+// SetFees - способ оплаты и сборы по нему, считает FeePolicy.Quote.
+func (o *Order) SetFees(method PaymentMethod, fees OrderFees) error {
+	if !o.Editable() {
+		return ErrOrderLocked
+	}
+	o.paymentMethod = method
+	o.packagingFee = fees.Packaging
+	o.serviceFee = fees.Service
+	o.recalculate()
+	return nil
+}
+
+// SetTip - чаевые курьеру, можно поменять до оплаты.
+func (o *Order) SetTip(tip common.Money) error {
+	if !o.Editable() {
+		return ErrOrderLocked
+	}
+	if tip.IsNegative() {
+		return ErrInvalidTip
+	}
+	o.tip = tip
+	o.recalculate()
+	return nil
+}
+
+// PriceBreakdown - из чего складывается итоговая цена. Сумма строк равна FinalPrice,
+// нулевые сборы и скидка опускаются.
+func (o *Order) PriceBreakdown() []PriceLine {
+	items := o.ItemsTotal()
+	lines := []PriceLine{
+		{Kind: PriceItems, Amount: items},
+		{Kind: PriceDelivery, Amount: o.deliveryPrice},
+	}
+	// Скидка не больше суммы позиций и доставки, как и в recalculate
+	if discount := decimal.Min(o.discount, items.Add(o.deliveryPrice)); discount.IsPositive() {
+		lines = append(lines, PriceLine{Kind: PriceDiscount, Amount: discount.Neg()})
+	}
+	for _, l := range []PriceLine{
+		{Kind: PricePackaging, Amount: o.packagingFee},
+		{Kind: PriceServiceFee, Amount: o.serviceFee},
+		{Kind: PriceTip, Amount: o.tip},
+	} {
+		if l.Amount.IsPositive() {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// recalculate - скидка уменьшает только позиции и доставку, сборы и чаевые добавляются сверху.
+func (o *Order) recalculate() {
+	o.finalPrice = o.ItemsTotal().Add(o.deliveryPrice).Sub(o.discount)
 	if o.finalPrice.IsNegative() {
 		o.finalPrice = common.ZeroMoney()
 	}
+	o.finalPrice = o.finalPrice.Add(o.packagingFee).Add(o.serviceFee).Add(o.tip)
+}
+
+// Editable - корзину, промокод и чаевые можно менять: заказ создан и оплата еще не запущена.
+func (o *Order) Editable() bool {
+	return o.status == StatusCreated && !o.checkoutStarted
+}
+
+// StartCheckout - фиксирует заказ перед оплатой: дальше FinalPrice не меняется,
+// иначе оплаченная сумма разойдется с суммой заказа.
+func (o *Order) StartCheckout() error {
+	if o.status != StatusCreated {
+		return fmt.Errorf("%w: cannot check out order in status %s", ErrInvalidTransition, o.status)
+	}
+	o.checkoutStarted = true
+	return nil
 }
 
 // --- State Machine ---
 
-func (o *Order) MarkPaid() error {
+func (o *Order) MarkPaid(actor Actor, note string) error {
 	if o.status != StatusCreated {
 		return fmt.Errorf("%w: cannot pay for order in status %s", ErrInvalidTransition, o.status)
 	}
-	o.status = StatusPaid
+	o.setStatus(StatusPaid, actor, note)
 	return nil
 }
 
-func (o *Order) SendToKitchen() error {
+func (o *Order) SendToKitchen(actor Actor, note string) error {
 	if o.status != StatusPaid {
 		return fmt.Errorf("%w: order must be paid", ErrInvalidTransition)
 	}
-	o.status = StatusCooking
+	o.setStatus(StatusCooking, actor, note)
 	return nil
 }
 
-func (o *Order) MarkReady() error {
+func (o *Order) MarkReady(actor Actor, note string) error {
 	if o.status != StatusCooking {
 		return fmt.Errorf("%w: order is not cooking", ErrInvalidTransition)
 	}
-	o.status = StatusReady
+	o.setStatus(StatusReady, actor, note)
 	return nil
 }
 
-func (o *Order) ShipToDelivery() error {
+func (o *Order) ShipToDelivery(actor Actor, note string) error {
 	if o.status != StatusReady {
 		return fmt.Errorf("%w: order is not ready", ErrInvalidTransition)
 	}
-	o.status = StatusDelivering
+	o.setStatus(StatusDelivering, actor, note)
 	return nil
 }
 
-func (o *Order) CompleteDelivery() error {
+func (o *Order) CompleteDelivery(actor Actor, note string) error {
 	if o.status != StatusDelivering {
 		return fmt.Errorf("%w: order is not in delivery", ErrInvalidTransition)
 	}
-	o.status = StatusCompleted
+	o.setStatus(StatusCompleted, actor, note)
 	return nil
 }
 
@@ -247,17 +649,69 @@ func (o *Order) Items() []*OrderItem {
 	copy(result, o.items)
 	return result
 }
-func (o *Order) Address() DeliveryAddress    { return o.address }
-func (o *Order) DeliveryPrice() common.Money { return o.deliveryPrice }
-func (o *Order) Discount() common.Money      { return o.discount }
-func (o *Order) FinalPrice() common.Money    { return o.finalPrice }
+func (o *Order) Address() DeliveryAddress     { return o.address }
+func (o *Order) DeliveryPrice() common.Money  { return o.deliveryPrice }
+func (o *Order) Discount() common.Money       { return o.discount }
+func (o *Order) PromoCode() string            { return o.promoCode }
+func (o *Order) FinalPrice() common.Money     { return o.finalPrice }
+func (o *Order) PaymentMethod() PaymentMethod { return o.paymentMethod }
+func (o *Order) PackagingFee() common.Money   { return o.packagingFee }
+func (o *Order) ServiceFee() common.Money     { return o.serviceFee }
+func (o *Order) Tip() common.Money            { return o.tip }
+func (o *Order) ScheduledFor() time.Time      { return o.scheduledFor }
+func (o *Order) Version() int                 { return o.version }
+func (o *Order) IsPreOrder() bool             { return !o.scheduledFor.IsZero() }
+func (o *Order) CheckoutStarted() bool        { return o.checkoutStarted }
 
 func generateOrderNumber() string {
 	id, _ := uuid.NewV7()
 	return fmt.Sprintf("PG-%s-%s", time.Now().Format("2006.01.02"), id.String()[:4])
 }
 
+// OrderFilter - условия выборки заказов. Нулевые поля не ограничивают выборку.
+type OrderFilter struct {
+	CustomerID  string
+	Status      *OrderStatus
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// ScheduledFrom и ScheduledTo отбирают только предзаказы по времени доставки.
+	ScheduledFrom time.Time
+	ScheduledTo   time.Time
+	Limit         int
+	Offset        int
+}
+
+// Match - проверка заказа на соответствие фильтру (без учета пагинации).
+func (f OrderFilter) Match(o *Order) bool {
+	if f.CustomerID != "" && o.customerID != f.CustomerID {
+		return false
+	}
+	if f.Status != nil && o.status != *f.Status {
+		return false
+	}
+	if !f.CreatedFrom.IsZero() && o.createdAt.Before(f.CreatedFrom) {
+		return false
+	}
+	if !f.CreatedTo.IsZero() && !o.createdAt.Before(f.CreatedTo) {
+		return false
+	}
+	if (!f.ScheduledFrom.IsZero() || !f.ScheduledTo.IsZero()) && !o.IsPreOrder() {
+		return false
+	}
+	if !f.ScheduledFrom.IsZero() && o.scheduledFor.Before(f.ScheduledFrom) {
+		return false
+	}
+	if !f.ScheduledTo.IsZero() && !o.scheduledFor.Before(f.ScheduledTo) {
+		return false
+	}
+	return true
+}
+
 type OrderRepository interface {
 	Save(ctx context.Context, o *Order) error
 	FindByID(ctx context.Context, id string) (*Order, error)
+	// List - заказы по фильтру, новые первыми.
+	List(ctx context.Context, filter OrderFilter) ([]*Order, error)
+	// History - история статусов заказа в хронологическом порядке.
+	History(ctx context.Context, orderID string) ([]StatusChange, error)
 }
@@ -1,8 +1,10 @@
 package orders
 
 import (
-	"github.com/versoit/diploma/pkg/common"
+	"errors"
 	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
 )
 
 func TestOrder_AddItem_CalculatesPriceCorrectly(t *testing.T) {
@@ -11,7 +13,7 @@ func TestOrder_AddItem_CalculatesPriceCorrectly(t *testing.T) {
 	order := NewOrder("cust-123", addr)
 
 	basePrice := common.NewMoney(100.0)
-	sizeMult := 1.2 // +20%
+	size := SizeOption{Code: "large", Name: "Большая", Multiplier: 1.2} // +20%
 	qty := 2
 	toppings := []Topping{
 		{Name: "Cheese", Price: common.NewMoney(10.0)},
@@ -24,7 +26,7 @@ func TestOrder_AddItem_CalculatesPriceCorrectly(t *testing.T) {
 	// Total Item Price = 135 * 2 = 270
 
 	// Act
-	err := order.AddItem("prod-1", "Pizza", qty, basePrice, sizeMult, toppings)
+	err := order.AddItem("prod-1", "Pizza", qty, basePrice, ItemOptions{Size: size}, toppings)
 
 	// Assert
 	if err != nil {
@@ -39,7 +41,7 @@ func TestOrder_AddItem_CalculatesPriceCorrectly(t *testing.T) {
 
 func TestOrder_ApplyPromoCode(t *testing.T) {
 	order := NewOrder("cust-1", DeliveryAddress{})
-	_ = order.AddItem("p1", "Item", 1, common.NewMoney(100), 1.0, nil) // Total 100
+	_ = order.AddItem("p1", "Item", 1, common.NewMoney(100), ItemOptions{}, nil) // Total 100
 
 	err := order.ApplyPromoCode("PROMO10", common.NewMoney(10.0))
 	if err != nil {
@@ -56,7 +58,7 @@ func TestOrder_StateTransitions(t *testing.T) {
 	order := NewOrder("c1", DeliveryAddress{})
 
 	// Created -> Paid
-	if err := order.MarkPaid(); err != nil {
+	if err := order.MarkPaid(SystemActor, ""); err != nil {
 		t.Errorf("failed to mark paid: %v", err)
 	}
 	if order.status != StatusPaid {
@@ -64,22 +66,22 @@ func TestOrder_StateTransitions(t *testing.T) {
 	}
 
 	// Paid -> Cooking
-	if err := order.SendToKitchen(); err != nil {
+	if err := order.SendToKitchen(SystemActor, ""); err != nil {
 		t.Errorf("failed to send to kitchen: %v", err)
 	}
 
 	// Cooking -> Ready
-	if err := order.MarkReady(); err != nil {
+	if err := order.MarkReady(SystemActor, ""); err != nil {
 		t.Errorf("failed to mark ready: %v", err)
 	}
 
 	// Ready -> Delivering
-	if err := order.ShipToDelivery(); err != nil {
+	if err := order.ShipToDelivery(SystemActor, ""); err != nil {
 		t.Errorf("failed to ship: %v", err)
 	}
 
 	// Delivering -> Completed
-	if err := order.CompleteDelivery(); err != nil {
+	if err := order.CompleteDelivery(SystemActor, ""); err != nil {
 		t.Errorf("failed to complete: %v", err)
 	}
 
@@ -90,10 +92,191 @@ func TestOrder_StateTransitions(t *testing.T) {
 
 func TestOrder_CannotAddItem_WhenLocked(t *testing.T) {
 	order := NewOrder("c1", DeliveryAddress{})
-	_ = order.MarkPaid() // Lock order
+	_ = order.MarkPaid(SystemActor, "") // Lock order
 
-	err := order.AddItem("p1", "Item", 1, common.NewMoney(100), 1, nil)
+	err := order.AddItem("p1", "Item", 1, common.NewMoney(100), ItemOptions{}, nil)
 	if err != ErrOrderLocked {
 		t.Errorf("expected ErrOrderLocked, got %v", err)
 	}
 }
+
+func TestOrder_PriceLockedAfterCheckout(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	_ = order.AddItem("p1", "Pizza", 1, common.NewMoney(500), ItemOptions{}, nil)
+	if err := order.StartCheckout(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	charged := order.FinalPrice()
+
+	if err := order.SetDeliveryPrice(common.NewMoney(150)); !errors.Is(err, ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked on delivery price, got %v", err)
+	}
+	if err := order.SetFees(PaymentCash, OrderFees{Packaging: common.NewMoney(30), Service: common.NewMoney(20)}); !errors.Is(err, ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked on fees, got %v", err)
+	}
+	if !order.FinalPrice().Equal(charged) {
+		t.Errorf("price changed after checkout: %s, charged %s", order.FinalPrice(), charged)
+	}
+}
+
+func TestOrder_EditCart(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	_ = order.AddItem("p1", "Pizza", 1, common.NewMoney(500), ItemOptions{}, nil)
+	_ = order.AddItem("p2", "Cola", 2, common.NewMoney(100), ItemOptions{}, nil)
+	pizza, cola := order.Items()[0].ID(), order.Items()[1].ID()
+	if pizza == "" || pizza == cola {
+		t.Fatalf("expected distinct line IDs, got %q and %q", pizza, cola)
+	}
+
+	if err := order.UpdateItemQuantity(pizza, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := order.ReplaceToppings(pizza, []Topping{{Name: "Cheese", Price: common.NewMoney(50)}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := order.RemoveItem(cola); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(order.Items()) != 1 || order.Items()[0].ID() != pizza {
+		t.Fatalf("expected only pizza line to remain")
+	}
+	if !order.FinalPrice().Equal(common.NewMoney(1650)) { // (500 + 50) * 3
+		t.Errorf("expected 1650, got %v", order.FinalPrice())
+	}
+
+	if err := order.UpdateItemQuantity(pizza, 0); !errors.Is(err, ErrInvalidQty) {
+		t.Errorf("expected ErrInvalidQty, got %v", err)
+	}
+	if err := order.RemoveItem(cola); !errors.Is(err, ErrItemNotFound) {
+		t.Errorf("expected ErrItemNotFound, got %v", err)
+	}
+	if err := order.RemoveItem(pizza); !errors.Is(err, ErrLastItem) {
+		t.Errorf("expected ErrLastItem, got %v", err)
+	}
+
+	_ = order.MarkPaid(SystemActor, "")
+	if err := order.UpdateItemQuantity(pizza, 1); !errors.Is(err, ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked, got %v", err)
+	}
+	if err := order.ReplaceToppings(pizza, nil); !errors.Is(err, ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked, got %v", err)
+	}
+}
+
+func TestRestoreOrder_RecalculatesPrice(t *testing.T) {
+	order := RestoreOrder(OrderSnapshot{
+		ID:          "o1",
+		OrderNumber: "PG-1",
+		CustomerID:  "c1",
+		Status:      StatusPaid,
+		Items: []OrderItemSnapshot{
+			{ProductID: "p1", ProductName: "Pizza", Quantity: 2, BasePrice: common.NewMoney(100), Size: DefaultSize, Crust: DefaultCrust,
+				Toppings: []Topping{{Name: "Cheese", Price: common.NewMoney(10)}}},
+		},
+		DeliveryPrice: common.NewMoney(50),
+		Discount:      common.NewMoney(20),
+		PromoCode:     "SALE",
+	})
+
+	expected := common.NewMoney(250) // (100 + 10) * 2 + 50 - 20
+	if !order.FinalPrice().Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, order.FinalPrice())
+	}
+	if order.Status() != StatusPaid || order.PromoCode() != "SALE" {
+		t.Errorf("state not restored: %v %s", order.Status(), order.PromoCode())
+	}
+}
+
+func TestParseOrderStatus(t *testing.T) {
+	for st := StatusCreated; st <= StatusCanceled; st++ {
+		parsed, err := ParseOrderStatus(st.String())
+		if err != nil || parsed != st {
+			t.Errorf("round trip failed for %v: got %v, %v", st, parsed, err)
+		}
+	}
+	if _, err := ParseOrderStatus("baking"); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
+
+func TestOrder_Cancel_Rules(t *testing.T) {
+	customer := Actor{ID: "c1", Role: RoleCustomer}
+	operator := Actor{ID: "op1", Role: RoleOperator}
+	manager := Actor{ID: "m1", Role: RoleManager}
+
+	advance := func(o *Order, to OrderStatus) {
+		steps := []func(Actor, string) error{o.MarkPaid, o.SendToKitchen, o.MarkReady, o.ShipToDelivery, o.CompleteDelivery}
+		for i := 0; i < int(to); i++ {
+			if err := steps[i](SystemActor, ""); err != nil {
+				t.Fatalf("failed to advance order: %v", err)
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		status  OrderStatus
+		actor   Actor
+		wantErr error
+	}{
+		{"customer cancels created", StatusCreated, customer, nil},
+		{"customer cancels paid", StatusPaid, customer, nil},
+		{"customer cannot cancel cooking", StatusCooking, customer, ErrCancelForbidden},
+		{"other customer", StatusCreated, Actor{ID: "c2", Role: RoleCustomer}, ErrCancelForbidden},
+		{"operator cancels ready", StatusReady, operator, nil},
+		{"operator cannot cancel delivering", StatusDelivering, operator, ErrCancelForbidden},
+		{"manager cancels delivering", StatusDelivering, manager, nil},
+		{"nobody cancels completed", StatusCompleted, manager, ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := NewOrder("c1", DeliveryAddress{})
+			advance(order, tt.status)
+
+			err := order.Cancel(CancelReasonCustomerRequest, "", tt.actor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && order.Status() != StatusCanceled {
+				t.Errorf("expected status canceled, got %v", order.Status())
+			}
+			if tt.wantErr != nil && order.Cancellation() != nil {
+				t.Error("cancellation must not be recorded on failure")
+			}
+		})
+	}
+}
+
+func TestOrder_Cancel_RecordsCompensation(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	_ = order.MarkPaid(SystemActor, "")
+	_ = order.SendToKitchen(SystemActor, "")
+
+	if err := order.Cancel(CancelReasonOutOfStock, "no mozzarella", Actor{ID: "op1", Role: RoleOperator}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c := order.Cancellation()
+	if c == nil {
+		t.Fatal("cancellation is not recorded")
+	}
+	if c.Reason != CancelReasonOutOfStock || c.PreviousStatus != StatusCooking || c.CanceledAt.IsZero() {
+		t.Errorf("unexpected cancellation record: %+v", c)
+	}
+	if !c.RequiresRefund() || !c.RequiresTicketWithdrawal() {
+		t.Error("cooking order requires refund and ticket withdrawal")
+	}
+
+	if err := order.Cancel(CancelReasonOther, "", Actor{ID: "m1", Role: RoleManager}); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("expected ErrInvalidTransition on repeated cancel, got %v", err)
+	}
+}
+
+func TestOrder_Cancel_InvalidReason(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	if err := order.Cancel("bored", "", Actor{ID: "c1", Role: RoleCustomer}); !errors.Is(err, ErrInvalidCancelReason) {
+		t.Errorf("expected ErrInvalidCancelReason, got %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+package orders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/versoit/diploma/pkg/common"
+)
+
+// EventType - тип доменного события, он же тема в брокере.
+type EventType string
+
+const (
+	EventOrderCreated       EventType = "order.created"
+	EventOrderPaid          EventType = "order.paid"
+	EventOrderSentToKitchen EventType = "order.sent_to_kitchen"
+	EventOrderReady         EventType = "order.ready"
+	EventOrderShipped       EventType = "order.shipped"
+	EventOrderDelivered     EventType = "order.delivered"
+	EventOrderCanceled      EventType = "order.canceled"
+)
+
+var statusEvents = map[OrderStatus]EventType{
+	StatusCreated:    EventOrderCreated,
+	StatusPaid:       EventOrderPaid,
+	StatusCooking:    EventOrderSentToKitchen,
+	StatusReady:      EventOrderReady,
+	StatusDelivering: EventOrderShipped,
+	StatusCompleted:  EventOrderDelivered,
+	StatusCanceled:   EventOrderCanceled,
+}
+
+// DomainEvent - событие, записанное агрегатом заказа.
+type DomainEvent struct {
+	ID         string
+	Type       EventType
+	OrderID    string
+	OccurredAt time.Time
+	Actor      Actor
+	Note       string
+}
+
+func (o *Order) recordEvent(t EventType, at time.Time, actor Actor, note string) {
+	id, _ := uuid.NewV7()
+	o.events = append(o.events, DomainEvent{
+		ID:         id.String(),
+		Type:       t,
+		OrderID:    o.id,
+		OccurredAt: at,
+		Actor:      actor,
+		Note:       note,
+	})
+}
+
+// PendingEvents - события, появившиеся после загрузки заказа. Хранилище пишет их
+// в outbox в той же транзакции, что и сам заказ; повторная запись события игнорируется.
+func (o *Order) PendingEvents() []DomainEvent {
+	res := make([]DomainEvent, len(o.events))
+	copy(res, o.events)
+	return res
+}
+
+// OrderEventPayload - тело события в брокере.
+type OrderEventPayload struct {
+	EventID      string       `json:"event_id"`
+	Type         EventType    `json:"type"`
+	OccurredAt   time.Time    `json:"occurred_at"`
+	OrderID      string       `json:"order_id"`
+	OrderNumber  string       `json:"order_number"`
+	CustomerID   string       `json:"customer_id"`
+	Status       string       `json:"status"`
+	FinalPrice   common.Money `json:"final_price"`
+	ActorID      string       `json:"actor_id"`
+	ActorRole    ActorRole    `json:"actor_role"`
+	Note         string       `json:"note,omitempty"`
+	CancelReason CancelReason `json:"cancel_reason,omitempty"`
+	ScheduledFor *time.Time   `json:"scheduled_for,omitempty"`
+}
+
+// OutboxMessage - событие, ожидающее публикации в брокер.
+type OutboxMessage struct {
+	ID string
+	// Topic - тип события, Key - ID заказа: события одного заказа публикуются по порядку.
+	Topic     EventType
+	Key       string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// NewOutboxMessage - сообщение outbox по событию с состоянием заказа на момент сохранения.
+func NewOutboxMessage(o *Order, e DomainEvent) (OutboxMessage, error) {
+	payload := OrderEventPayload{
+		EventID:     e.ID,
+		Type:        e.Type,
+		OccurredAt:  e.OccurredAt,
+		OrderID:     o.ID(),
+		OrderNumber: o.OrderNumber(),
+		CustomerID:  o.CustomerID(),
+		Status:      o.Status().String(),
+		FinalPrice:  o.FinalPrice(),
+		ActorID:     e.Actor.ID,
+		ActorRole:   e.Actor.Role,
+		Note:        e.Note,
+	}
+	if c := o.Cancellation(); c != nil {
+		payload.CancelReason = c.Reason
+	}
+	if o.IsPreOrder() {
+		at := o.ScheduledFor()
+		payload.ScheduledFor = &at
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return OutboxMessage{}, fmt.Errorf("failed to encode event %s: %w", e.ID, err)
+	}
+	return OutboxMessage{
+		ID:        e.ID,
+		Topic:     e.Type,
+		Key:       o.ID(),
+		Payload:   raw,
+		CreatedAt: e.OccurredAt,
+	}, nil
+}
+
+// Outbox - очередь неопубликованных событий.
+type Outbox interface {
+	// FetchPending - неопубликованные сообщения в порядке записи.
+	FetchPending(ctx context.Context, limit int) ([]OutboxMessage, error)
+	MarkPublished(ctx context.Context, id string, at time.Time) error
+	// MarkFailed - учитывает неудачную попытку, сообщение остается в очереди.
+	MarkFailed(ctx context.Context, id string, cause error) error
+}
+
+// EventPublisher - брокер сообщений. Доставка не менее одного раза:
+// получатели отбрасывают дубликаты по ID события.
+type EventPublisher interface {
+	Publish(ctx context.Context, msg OutboxMessage) error
+}
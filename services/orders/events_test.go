@@ -0,0 +1,50 @@
+package orders
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestOrder_DomainEvents(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	if err := order.AddItem("p1", "Pizza", 1, common.NewMoney(500), ItemOptions{}, nil); err != nil {
+		t.Fatalf("failed to add item: %v", err)
+	}
+	_ = order.MarkPaid(SystemActor, "payment 42")
+	_ = order.MarkReady(SystemActor, "") // недопустимый переход события не порождает
+	_ = order.Cancel(CancelReasonOutOfStock, "no dough", Actor{ID: "m1", Role: RoleManager})
+
+	events := order.PendingEvents()
+	want := []EventType{EventOrderCreated, EventOrderPaid, EventOrderCanceled}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(events))
+	}
+	for i, typ := range want {
+		if events[i].Type != typ || events[i].OrderID != order.ID() {
+			t.Errorf("event %d: expected %s, got %s", i, typ, events[i].Type)
+		}
+	}
+
+	msg, err := NewOutboxMessage(order, events[2])
+	if err != nil {
+		t.Fatalf("failed to build outbox message: %v", err)
+	}
+	if msg.ID != events[2].ID || msg.Topic != EventOrderCanceled || msg.Key != order.ID() {
+		t.Errorf("unexpected message envelope: %+v", msg)
+	}
+
+	var payload OrderEventPayload
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if payload.Status != "canceled" || payload.CancelReason != CancelReasonOutOfStock ||
+		payload.ActorID != "m1" || !payload.FinalPrice.Equal(common.NewMoney(500)) {
+		t.Errorf("unexpected payload: %+v", payload)
+	}
+
+	if restored := RestoreOrder(order.Snapshot()); len(restored.PendingEvents()) != 0 {
+		t.Error("restored order must not carry already saved events")
+	}
+}
@@ -0,0 +1,96 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var (
+	ErrInvalidTip           = errors.New("tip must not be negative")
+	ErrUnknownPaymentMethod = errors.New("payment method is not accepted")
+	ErrInvalidFees          = errors.New("invalid fee policy")
+)
+
+// PaymentMethod - способ оплаты, от него зависит сервисный сбор.
+type PaymentMethod string
+
+const (
+	PaymentCard PaymentMethod = "card"
+	PaymentCash PaymentMethod = "cash"
+	// PaymentCardOnDelivery - картой курьеру при получении.
+	PaymentCardOnDelivery PaymentMethod = "card_on_delivery"
+)
+
+// DefaultPaymentMethod - если клиент не выбрал способ оплаты.
+const DefaultPaymentMethod = PaymentCard
+
+// PaidOnDelivery - деньги принимает курьер, онлайн-платежа нет.
+func (m PaymentMethod) PaidOnDelivery() bool {
+	return m == PaymentCash || m == PaymentCardOnDelivery
+}
+
+// ServiceFee - сбор за способ оплаты: процент от суммы позиций плюс фиксированная часть.
+type ServiceFee struct {
+	Percent float64
+	Fixed   common.Money
+}
+
+// FeePolicy - сборы сверх стоимости позиций и доставки.
+type FeePolicy struct {
+	// Packaging - упаковка, за заказ.
+	Packaging common.Money
+	// Service - сбор по способу оплаты. Способ, которого здесь нет, не принимается.
+	Service map[PaymentMethod]ServiceFee
+}
+
+func (p *FeePolicy) Validate() error {
+	if p.Packaging.IsNegative() {
+		return fmt.Errorf("%w: negative packaging fee", ErrInvalidFees)
+	}
+	if len(p.Service) == 0 {
+		return fmt.Errorf("%w: no payment methods", ErrInvalidFees)
+	}
+	for method, fee := range p.Service {
+		if fee.Percent < 0 || fee.Percent > 100 || fee.Fixed.IsNegative() {
+			return fmt.Errorf("%w: service fee of %s", ErrInvalidFees, method)
+		}
+	}
+	return nil
+}
+
+// OrderFees - сборы конкретного заказа.
+type OrderFees struct {
+	Packaging common.Money
+	Service   common.Money
+}
+
+// Quote - сборы заказа с суммой позиций itemsTotal при оплате способом method.
+func (p *FeePolicy) Quote(method PaymentMethod, itemsTotal common.Money) (OrderFees, error) {
+	fee, ok := p.Service[method]
+	if !ok {
+		return OrderFees{}, fmt.Errorf("%w: %q", ErrUnknownPaymentMethod, method)
+	}
+	service := itemsTotal.Mul(common.NewMoney(fee.Percent)).Div(common.NewMoney(100)).Round(2).Add(fee.Fixed)
+	return OrderFees{Packaging: p.Packaging, Service: service}, nil
+}
+
+// PriceLineKind - составляющая итоговой цены заказа.
+type PriceLineKind string
+
+const (
+	PriceItems      PriceLineKind = "items"
+	PriceDelivery   PriceLineKind = "delivery"
+	PriceDiscount   PriceLineKind = "discount"
+	PricePackaging  PriceLineKind = "packaging"
+	PriceServiceFee PriceLineKind = "service_fee"
+	// PriceTip - чаевые, целиком уходят курьеру.
+	PriceTip PriceLineKind = "tip"
+)
+
+// PriceLine - строка расчета цены. Скидка - отрицательная сумма.
+type PriceLine struct {
+	Kind   PriceLineKind
+	Amount common.Money
+}
@@ -0,0 +1,102 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestFeePolicy_Quote(t *testing.T) {
+	policy := &FeePolicy{
+		Packaging: common.NewMoney(30),
+		Service: map[PaymentMethod]ServiceFee{
+			PaymentCard: {Fixed: common.ZeroMoney()},
+			PaymentCash: {Percent: 3, Fixed: common.NewMoney(10)},
+		},
+	}
+	if err := policy.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fees, err := policy.Quote(PaymentCash, common.NewMoney(555))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 3% от 555 = 16.65, плюс 10
+	if !fees.Service.Equal(common.NewMoney(26.65)) || !fees.Packaging.Equal(common.NewMoney(30)) {
+		t.Errorf("unexpected fees: %+v", fees)
+	}
+
+	if _, err := policy.Quote(PaymentCardOnDelivery, common.NewMoney(555)); !errors.Is(err, ErrUnknownPaymentMethod) {
+		t.Errorf("expected ErrUnknownPaymentMethod, got %v", err)
+	}
+}
+
+func TestOrder_PriceBreakdown(t *testing.T) {
+	order := NewOrder("cust-1", DeliveryAddress{City: "Moscow", Street: "Lenina"})
+	_ = order.AddItem("p1", "Pizza", 2, common.NewMoney(500), ItemOptions{}, nil)
+	_ = order.SetDeliveryPrice(common.NewMoney(150))
+	_ = order.ApplyPromoCode("SALE", common.NewMoney(100))
+	_ = order.SetFees(PaymentCash, OrderFees{Packaging: common.NewMoney(30), Service: common.NewMoney(20)})
+	if err := order.SetTip(common.NewMoney(50)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 1000 + 150 - 100 + 30 + 20 + 50
+	if !order.FinalPrice().Equal(common.NewMoney(1150)) {
+		t.Fatalf("expected final price 1150, got %s", order.FinalPrice())
+	}
+
+	want := map[PriceLineKind]float64{
+		PriceItems: 1000, PriceDelivery: 150, PriceDiscount: -100,
+		PricePackaging: 30, PriceServiceFee: 20, PriceTip: 50,
+	}
+	lines := order.PriceBreakdown()
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %+v", len(want), lines)
+	}
+	sum := common.ZeroMoney()
+	for _, l := range lines {
+		if !l.Amount.Equal(common.NewMoney(want[l.Kind])) {
+			t.Errorf("line %s: expected %v, got %s", l.Kind, want[l.Kind], l.Amount)
+		}
+		sum = sum.Add(l.Amount)
+	}
+	if !sum.Equal(order.FinalPrice()) {
+		t.Errorf("breakdown sums to %s, final price is %s", sum, order.FinalPrice())
+	}
+}
+
+func TestOrder_DiscountDoesNotEatFeesAndTip(t *testing.T) {
+	order := NewOrder("cust-1", DeliveryAddress{City: "Moscow", Street: "Lenina"})
+	_ = order.AddItem("p1", "Pizza", 1, common.NewMoney(300), ItemOptions{}, nil)
+	_ = order.ApplyPromoCode("FREE", common.NewMoney(500))
+	_ = order.SetFees(PaymentCard, OrderFees{Packaging: common.NewMoney(30), Service: common.ZeroMoney()})
+	_ = order.SetTip(common.NewMoney(70))
+
+	if !order.FinalPrice().Equal(common.NewMoney(100)) {
+		t.Errorf("expected only packaging and tip, got %s", order.FinalPrice())
+	}
+	sum := common.ZeroMoney()
+	for _, l := range order.PriceBreakdown() {
+		sum = sum.Add(l.Amount)
+	}
+	if !sum.Equal(order.FinalPrice()) {
+		t.Errorf("breakdown sums to %s, final price is %s", sum, order.FinalPrice())
+	}
+}
+
+func TestOrder_SetTip(t *testing.T) {
+	order := NewOrder("cust-1", DeliveryAddress{City: "Moscow", Street: "Lenina"})
+	_ = order.AddItem("p1", "Pizza", 1, common.NewMoney(300), ItemOptions{}, nil)
+
+	if err := order.SetTip(common.NewMoney(-1)); !errors.Is(err, ErrInvalidTip) {
+		t.Errorf("expected ErrInvalidTip, got %v", err)
+	}
+
+	_ = order.MarkPaid(SystemActor, "")
+	if err := order.SetTip(common.NewMoney(10)); !errors.Is(err, ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked after payment, got %v", err)
+	}
+}
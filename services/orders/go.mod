@@ -4,7 +4,14 @@ go 1.24.0
 
 require (
 	github.com/google/uuid v1.6.0
+	github.com/jackc/pgx/v5 v5.7.6
+	github.com/nats-io/nats.go v1.48.0
+	github.com/shopspring/decimal v1.4.0
 	github.com/versoit/diploma/pkg v0.0.0-20260208180837-dd484a9f2455
+	github.com/versoit/diploma/services/catalog v0.0.0-00010101000000-000000000000
+	github.com/versoit/diploma/services/kitchen v0.0.0-00010101000000-000000000000
+	github.com/versoit/diploma/services/logistics v0.0.0-00010101000000-000000000000
+	github.com/versoit/diploma/services/treasury v0.0.0-00010101000000-000000000000
 	go.uber.org/fx v1.24.0
 	go.uber.org/zap v1.26.0
 	google.golang.org/grpc v1.78.0
@@ -12,11 +19,26 @@ require (
 )
 
 require (
-	github.com/shopspring/decimal v1.4.0 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/jackc/puddle/v2 v2.2.2 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
+	github.com/nats-io/nkeys v0.4.11 // indirect
+	github.com/nats-io/nuid v1.0.1 // indirect
 	go.uber.org/dig v1.19.0 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/crypto v0.44.0 // indirect
 	golang.org/x/net v0.47.0 // indirect
+	golang.org/x/sync v0.18.0 // indirect
 	golang.org/x/sys v0.38.0 // indirect
 	golang.org/x/text v0.31.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda // indirect
 )
+
+replace github.com/versoit/diploma/services/catalog => ../catalog
+
+replace github.com/versoit/diploma/services/kitchen => ../kitchen
+
+replace github.com/versoit/diploma/services/logistics => ../logistics
+
+replace github.com/versoit/diploma/services/treasury => ../treasury
@@ -0,0 +1,44 @@
+package orders
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusChange - запись истории статусов заказа.
+type StatusChange struct {
+	ID        string
+	OrderID   string
+	Status    OrderStatus
+	ChangedAt time.Time
+	Actor     Actor
+	Note      string
+}
+
+// setStatus - меняет статус и добавляет запись в историю.
+func (o *Order) setStatus(status OrderStatus, actor Actor, note string) {
+	o.status = status
+	o.recordStatus(time.Now(), actor, note)
+}
+
+func (o *Order) recordStatus(at time.Time, actor Actor, note string) {
+	id, _ := uuid.NewV7()
+	o.statusChanges = append(o.statusChanges, StatusChange{
+		ID:        id.String(),
+		OrderID:   o.id,
+		Status:    o.status,
+		ChangedAt: at,
+		Actor:     actor,
+		Note:      note,
+	})
+	o.recordEvent(statusEvents[o.status], at, actor, note)
+}
+
+// PendingStatusChanges - записи истории, появившиеся после загрузки заказа.
+// Хранилище дописывает их при Save, повторное сохранение записи не дублирует.
+func (o *Order) PendingStatusChanges() []StatusChange {
+	res := make([]StatusChange, len(o.statusChanges))
+	copy(res, o.statusChanges)
+	return res
+}
@@ -0,0 +1,49 @@
+package orders
+
+import (
+	"testing"
+)
+
+func TestOrder_StatusHistory(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	cashier := Actor{ID: "op1", Role: RoleOperator}
+
+	_ = order.MarkPaid(SystemActor, "payment 42")
+	_ = order.SendToKitchen(cashier, "")
+	_ = order.Cancel(CancelReasonOutOfStock, "no dough", Actor{ID: "m1", Role: RoleManager})
+
+	history := order.PendingStatusChanges()
+	want := []OrderStatus{StatusCreated, StatusPaid, StatusCooking, StatusCanceled}
+	if len(history) != len(want) {
+		t.Fatalf("expected %d entries, got %d", len(want), len(history))
+	}
+	for i, status := range want {
+		if history[i].Status != status || history[i].OrderID != order.ID() {
+			t.Errorf("entry %d: expected %s, got %s", i, status, history[i].Status)
+		}
+	}
+
+	if history[0].Actor.ID != "c1" || !history[0].ChangedAt.Equal(order.CreatedAt()) {
+		t.Errorf("creation entry should belong to customer at creation time: %+v", history[0])
+	}
+	if history[1].Note != "payment 42" || history[2].Actor != cashier {
+		t.Errorf("actor or note not recorded: %+v %+v", history[1], history[2])
+	}
+	if history[3].Note != "out_of_stock: no dough" {
+		t.Errorf("expected cancel reason in note, got %q", history[3].Note)
+	}
+
+	// Неудачный переход историю не меняет
+	_ = order.MarkReady(cashier, "")
+	if len(order.PendingStatusChanges()) != len(want) {
+		t.Error("failed transition must not be recorded")
+	}
+}
+
+func TestRestoreOrder_HasNoPendingHistory(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	restored := RestoreOrder(order.Snapshot())
+	if len(restored.PendingStatusChanges()) != 0 {
+		t.Error("restored order must not re-record persisted history")
+	}
+}
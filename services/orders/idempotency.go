@@ -0,0 +1,52 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyReused   = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInFlight = errors.New("request with this idempotency key is still in progress")
+)
+
+// IdempotencyRecord - результат запроса, сохраненный под ключом идемпотентности.
+// Ключи различаются в пределах операции: один ключ в CreateOrder и PayOrder - разные записи.
+type IdempotencyRecord struct {
+	Operation string
+	Key       string
+	// Token - метка конкретного резерва. Complete и Release применяются только к своему резерву:
+	// поздний вызов от запроса, чей ключ уже перехватили, не трогает запись нового владельца.
+	Token string
+	// RequestHash - отпечаток тела запроса, по нему ловится повтор ключа с другими данными.
+	RequestHash string
+	// Response - сериализованный ответ, пустой пока запрос выполняется.
+	Response  []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// LockedUntil - до этого момента незавершенный запрос считается выполняемым. Позже
+	// резерв считается брошенным (процесс упал, ответ не сохранился) и ключ можно занять снова.
+	LockedUntil time.Time
+}
+
+func (r IdempotencyRecord) Completed() bool { return r.Response != nil }
+
+// Stale - резерв незавершенного запроса, который уже никто не выполняет.
+func (r IdempotencyRecord) Stale(now time.Time) bool {
+	return !r.Completed() && !now.Before(r.LockedUntil)
+}
+
+func (r IdempotencyRecord) Expired(now time.Time) bool { return !now.Before(r.ExpiresAt) }
+
+// IdempotencyStore - хранилище ключей идемпотентности с TTL.
+type IdempotencyStore interface {
+	// Reserve - занимает ключ под выполняемый запрос. Если живая запись уже есть,
+	// она возвращается с reserved = false, просроченная или брошенная запись перезаписывается.
+	Reserve(ctx context.Context, rec IdempotencyRecord) (existing IdempotencyRecord, reserved bool, err error)
+	Complete(ctx context.Context, operation, key, token string, response []byte) error
+	// Release - снимает резерв после неудачного запроса, чтобы клиент мог повторить его.
+	Release(ctx context.Context, operation, key, token string) error
+	// PurgeExpired - удаляет записи с истекшим TTL, возвращает число удаленных.
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/versoit/diploma/services/orders"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Заголовки с вызывающим, их проставляет шлюз после аутентификации. Тело запроса
+// для прав не используется: роль в нем клиент может указать любую.
+const (
+	actorIDHeader   = "x-actor-id"
+	actorRoleHeader = "x-actor-role"
+)
+
+// actorFromContext - аутентифицированный вызывающий из метаданных запроса.
+// Роль system зарезервирована за оркестратором внутри сервиса.
+func actorFromContext(ctx context.Context) (orders.Actor, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	id, role := firstValue(md, actorIDHeader), firstValue(md, actorRoleHeader)
+	if id == "" || role == "" {
+		return orders.Actor{}, status.Error(codes.Unauthenticated, "actor metadata is required")
+	}
+
+	r, err := orders.ParseActorRole(role)
+	if err != nil {
+		return orders.Actor{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if r == orders.RoleSystem {
+		return orders.Actor{}, status.Error(codes.PermissionDenied, "system role is not available over the API")
+	}
+	return orders.Actor{ID: id, Role: r}, nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
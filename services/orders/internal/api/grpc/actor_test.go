@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/versoit/diploma/services/orders"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestActorFromContext(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want codes.Code
+	}{
+		{"no metadata", nil, codes.Unauthenticated},
+		{"no role", metadata.Pairs(actorIDHeader, "cust1"), codes.Unauthenticated},
+		{"unknown role", metadata.Pairs(actorIDHeader, "cust1", actorRoleHeader, "admin"), codes.Unauthenticated},
+		{"system role", metadata.Pairs(actorIDHeader, "saga", actorRoleHeader, "system"), codes.PermissionDenied},
+		{"customer", metadata.Pairs(actorIDHeader, "cust1", actorRoleHeader, "customer"), codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			actor, err := actorFromContext(ctx)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("expected %s, got %s (%v)", tt.want, got, err)
+			}
+			if err == nil && actor != (orders.Actor{ID: "cust1", Role: orders.RoleCustomer}) {
+				t.Errorf("unexpected actor: %+v", actor)
+			}
+		})
+	}
+}
+
+func TestToTransitionInput_UsesMetadataActor(t *testing.T) {
+	if _, err := toTransitionInput(context.Background(), "o1", ""); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without metadata, got %v", err)
+	}
+
+	md := metadata.Pairs(actorIDHeader, "op1", actorRoleHeader, "operator")
+	in, err := toTransitionInput(metadata.NewIncomingContext(context.Background(), md), "o1", "ready early")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if in.Actor != (orders.Actor{ID: "op1", Role: orders.RoleOperator}) || in.OrderID != "o1" || in.Note != "ready early" {
+		t.Errorf("unexpected input: %+v", in)
+	}
+}
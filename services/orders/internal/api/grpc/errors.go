@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorCodes - коды gRPC для ошибок домена и сценариев. Порядок важен: ошибка
+// может оборачивать несколько, побеждает первая найденная.
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{orders.ErrConcurrentModification, codes.Aborted},
+	{orders.ErrIdempotencyKeyInFlight, codes.Aborted},
+	{orders.ErrCustomerBlocked, codes.PermissionDenied},
+	{orders.ErrCancelForbidden, codes.PermissionDenied},
+	{usecase.ErrForeignOrder, codes.PermissionDenied},
+	{orders.ErrSlotFull, codes.ResourceExhausted},
+
+	{orders.ErrPromoExists, codes.AlreadyExists},
+
+	{orders.ErrOrderNotFound, codes.NotFound},
+	{orders.ErrItemNotFound, codes.NotFound},
+	{orders.ErrAddressNotFound, codes.NotFound},
+	{orders.ErrPromoNotFound, codes.NotFound},
+	{orders.ErrSagaNotFound, codes.NotFound},
+	{orders.ErrUnknownProduct, codes.NotFound},
+
+	{orders.ErrStoreClosed, codes.FailedPrecondition},
+	{orders.ErrBelowZoneMinimum, codes.FailedPrecondition},
+	{orders.ErrAddressNotServed, codes.FailedPrecondition},
+	{orders.ErrNoStoreForZone, codes.FailedPrecondition},
+	{orders.ErrProductNotProduced, codes.FailedPrecondition},
+	{orders.ErrProductUnavailable, codes.FailedPrecondition},
+	{orders.ErrOrderLocked, codes.FailedPrecondition},
+	{orders.ErrInvalidTransition, codes.FailedPrecondition},
+	{orders.ErrLastItem, codes.FailedPrecondition},
+	{orders.ErrPreOrderHeld, codes.FailedPrecondition},
+	{orders.ErrIdempotencyKeyReused, codes.FailedPrecondition},
+	{usecase.ErrNothingToReorder, codes.FailedPrecondition},
+
+	{usecase.ErrInvalidInput, codes.InvalidArgument},
+	{orders.ErrTooManyItems, codes.InvalidArgument},
+	{orders.ErrInvalidQty, codes.InvalidArgument},
+	{orders.ErrInvalidDiscount, codes.InvalidArgument},
+	{orders.ErrInvalidTip, codes.InvalidArgument},
+	{orders.ErrUnknownPaymentMethod, codes.InvalidArgument},
+	{orders.ErrUnknownStatus, codes.InvalidArgument},
+	{orders.ErrInvalidCancelReason, codes.InvalidArgument},
+	{orders.ErrUnknownRole, codes.InvalidArgument},
+	{orders.ErrUnknownSize, codes.InvalidArgument},
+	{orders.ErrUnknownCrust, codes.InvalidArgument},
+	{orders.ErrHalvesNotAllowed, codes.InvalidArgument},
+	{orders.ErrToppingNotAllowed, codes.InvalidArgument},
+	{orders.ErrTooManyToppings, codes.InvalidArgument},
+	{orders.ErrInvalidPromo, codes.InvalidArgument},
+	{orders.ErrInvalidTimeOfDay, codes.InvalidArgument},
+	{orders.ErrDeliveryTooSoon, codes.InvalidArgument},
+	{orders.ErrDeliveryTooFar, codes.InvalidArgument},
+}
+
+// toStatus - ошибка сценария как статус gRPC. Текст ошибки сохраняется, чтобы клиент видел причину.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case orders.IsPromoRejection(err) && !errors.Is(err, orders.ErrPromoNotFound):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return status.Error(e.code, err.Error())
+		}
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// UnaryErrorInterceptor - переводит ошибки обработчиков в статусы gRPC.
+func UnaryErrorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatus(err)
+}
+
+func StreamErrorInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatus(handler(srv, ss))
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", fmt.Errorf("failed to find order x: %w", orders.ErrOrderNotFound), codes.NotFound},
+		{"invalid input", fmt.Errorf("%w: %w", usecase.ErrInvalidInput, orders.ErrUnknownPaymentMethod), codes.InvalidArgument},
+		{"store closed", fmt.Errorf("%w: 03:00", orders.ErrStoreClosed), codes.FailedPrecondition},
+		{"below minimum", orders.ErrBelowZoneMinimum, codes.FailedPrecondition},
+		{"too many items", orders.ErrTooManyItems, codes.InvalidArgument},
+		{"blocked", orders.ErrCustomerBlocked, codes.PermissionDenied},
+		{"stale write", fmt.Errorf("save: %w", orders.ErrConcurrentModification), codes.Aborted},
+		{"promo rejected", orders.ErrPromoExpired, codes.FailedPrecondition},
+		{"promo missing", orders.ErrPromoNotFound, codes.NotFound},
+		{"promo exists", fmt.Errorf("failed to create promo: %w", orders.ErrPromoExists), codes.AlreadyExists},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"upstream status", fmt.Errorf("catalog: %w", status.Error(codes.Unavailable, "down")), codes.Unavailable},
+		{"unexpected", errors.New("disk is full"), codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := status.Code(toStatus(tt.err))
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	if toStatus(nil) != nil {
+		t.Error("nil error must stay nil")
+	}
+}
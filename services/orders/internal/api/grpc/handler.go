@@ -2,64 +2,642 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
+	"github.com/shopspring/decimal"
+	"github.com/versoit/diploma/pkg/common"
 	"github.com/versoit/diploma/services/orders"
 	orders_pb "github.com/versoit/diploma/services/orders/api/proto/pb"
 	"github.com/versoit/diploma/services/orders/usecase"
-	"github.com/versoit/diploma/pkg/common"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OrdersHandler struct {
 	orders_pb.UnimplementedOrderServiceServer
-	uc *usecase.OrderUseCase
+	uc          *usecase.OrderUseCase
+	saga        *usecase.OrderSagaUseCase
+	idempotency *usecase.IdempotencyUseCase
+	tracking    *usecase.TrackingUseCase
 }
 
-func NewOrdersHandler(uc *usecase.OrderUseCase) *OrdersHandler {
-	return &OrdersHandler{uc: uc}
+func NewOrdersHandler(
+	uc *usecase.OrderUseCase,
+	saga *usecase.OrderSagaUseCase,
+	idempotency *usecase.IdempotencyUseCase,
+	tracking *usecase.TrackingUseCase,
+) *OrdersHandler {
+	return &OrdersHandler{uc: uc, saga: saga, idempotency: idempotency, tracking: tracking}
 }
 
 func (h *OrdersHandler) Register(server *grpc.Server) {
 	orders_pb.RegisterOrderServiceServer(server, h)
 }
 
-func (h *OrdersHandler) CreateOrder(ctx context.Context, req *orders_pb.CreateOrderRequest) (*orders_pb.OrderResponse, error) {
+func (h *OrdersHandler) CreateOrder(ctx context.Context, req *orders_pb.CreateOrderRequest) (*orders_pb.Order, error) {
+	return idempotent(ctx, h.idempotency, "CreateOrder", req, newOrderResponse, func() (*orders_pb.Order, error) {
+		order, err := h.uc.CreateOrder(ctx, toCreateOrderInput(req))
+		if err != nil {
+			return nil, err
+		}
+
+		return toProtoOrder(order), nil
+	})
+}
+
+func newOrderResponse() *orders_pb.Order { return &orders_pb.Order{} }
+
+func (h *OrdersHandler) QuoteOrder(ctx context.Context, req *orders_pb.CreateOrderRequest) (*orders_pb.OrderQuote, error) {
+	quote, err := h.uc.QuoteOrder(ctx, toCreateOrderInput(req))
+	if err != nil {
+		return nil, err
+	}
+
+	d := quote.Delivery
+	return &orders_pb.OrderQuote{
+		Items:      toProtoLines(quote.Order),
+		ItemsTotal: quote.Order.ItemsTotal().InexactFloat64(),
+		Delivery: &orders_pb.DeliveryQuote{
+			ZoneId:           d.ZoneID,
+			ZoneName:         d.ZoneName,
+			BasePrice:        d.BasePrice.InexactFloat64(),
+			Surcharge:        d.Surcharge.InexactFloat64(),
+			Price:            d.Price.InexactFloat64(),
+			FreeDeliveryFrom: d.FreeDeliveryFrom.InexactFloat64(),
+			MinOrderValue:    d.MinOrderValue.InexactFloat64(),
+		},
+		FinalPrice:     quote.Order.FinalPrice().InexactFloat64(),
+		PriceBreakdown: toProtoBreakdown(quote.Order),
+	}, nil
+}
+
+func toCreateOrderInput(req *orders_pb.CreateOrderRequest) usecase.CreateOrderInput {
 	items := make([]usecase.OrderItemInput, len(req.Items))
 	for i, item := range req.Items {
-		items[i] = usecase.OrderItemInput{
-			ProductID: item.ProductId,
-			Name:      item.ProductName,
-			Quantity:  int(item.Quantity),
-			BasePrice: common.ZeroMoney(),
-			SizeMult:  1.0,
+		items[i] = toItemInput(item)
+	}
+
+	input := usecase.CreateOrderInput{
+		CustomerID: req.CustomerId,
+		Address:    toDomainAddress(req.GetAddress()),
+		AddressID:  req.AddressId,
+		Items:      items,
+
+		PaymentMethod: orders.PaymentMethod(req.PaymentMethod),
+		Tip:           common.NewMoney(req.Tip),
+	}
+	if req.DeliverAt != nil {
+		input.DeliverAt = req.DeliverAt.AsTime()
+	}
+	return input
+}
+
+func toDomainAddress(a *orders_pb.Address) orders.DeliveryAddress {
+	addr := orders.DeliveryAddress{
+		City:      a.GetCity(),
+		District:  a.GetDistrict(),
+		Street:    a.GetStreet(),
+		House:     a.GetHouse(),
+		Apartment: a.GetApartment(),
+		Floor:     a.GetFloor(),
+		Comment:   a.GetComment(),
+	}
+	if loc := a.GetLocation(); loc != nil {
+		addr.Location = &orders.GeoPoint{Lat: loc.Lat, Lng: loc.Lng}
+	}
+	return addr
+}
+
+func toProtoAddress(addr orders.DeliveryAddress) *orders_pb.Address {
+	res := &orders_pb.Address{
+		City:      addr.City,
+		District:  addr.District,
+		Street:    addr.Street,
+		House:     addr.House,
+		Apartment: addr.Apartment,
+		Floor:     addr.Floor,
+		Comment:   addr.Comment,
+	}
+	if addr.Location != nil {
+		res.Location = &orders_pb.GeoPoint{Lat: addr.Location.Lat, Lng: addr.Location.Lng}
+	}
+	return res
+}
+
+func (h *OrdersHandler) Reorder(ctx context.Context, req *orders_pb.ReorderRequest) (*orders_pb.ReorderResponse, error) {
+	newResp := func() *orders_pb.ReorderResponse { return &orders_pb.ReorderResponse{} }
+	return idempotent(ctx, h.idempotency, "Reorder", req, newResp, func() (*orders_pb.ReorderResponse, error) {
+		result, err := h.uc.Reorder(ctx, usecase.ReorderInput{OrderID: req.OrderId, CustomerID: req.CustomerId})
+		if err != nil {
+			return nil, err
+		}
+
+		res := &orders_pb.ReorderResponse{
+			Order:   toProtoOrder(result.Order),
+			Changes: make([]*orders_pb.ReorderChange, 0, len(result.Changes)),
 		}
+		for _, c := range result.Changes {
+			res.Changes = append(res.Changes, &orders_pb.ReorderChange{
+				Kind:        string(c.Kind),
+				ProductId:   c.ProductID,
+				ProductName: c.ProductName,
+				Topping:     c.Topping,
+				OldPrice:    c.OldPrice.InexactFloat64(),
+				NewPrice:    c.NewPrice.InexactFloat64(),
+			})
+		}
+		return res, nil
+	})
+}
+
+func (h *OrdersHandler) AddAddress(ctx context.Context, req *orders_pb.AddAddressRequest) (*orders_pb.SavedAddress, error) {
+	saved, err := h.uc.AddAddress(ctx, usecase.AddAddressInput{
+		CustomerID:  req.CustomerId,
+		Label:       req.Label,
+		Address:     toDomainAddress(req.GetAddress()),
+		MakeDefault: req.MakeDefault,
+	})
+	if err != nil {
+		return nil, err
 	}
+	return toProtoSavedAddress(saved), nil
+}
 
-	order, err := h.uc.CreateOrder(ctx, usecase.CreateOrderInput{
+func (h *OrdersHandler) UpdateAddress(ctx context.Context, req *orders_pb.UpdateAddressRequest) (*orders_pb.SavedAddress, error) {
+	saved, err := h.uc.UpdateAddress(ctx, usecase.UpdateAddressInput{
 		CustomerID: req.CustomerId,
-		Address: orders.DeliveryAddress{
-			City:   req.Address.City,
-			Street: req.Address.Street,
-		},
-		Items: items,
+		AddressID:  req.AddressId,
+		Label:      req.Label,
+		Address:    toDomainAddress(req.GetAddress()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toProtoSavedAddress(saved), nil
+}
+
+func (h *OrdersHandler) ListAddresses(ctx context.Context, req *orders_pb.ListAddressesRequest) (*orders_pb.ListAddressesResponse, error) {
+	list, err := h.uc.ListAddresses(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &orders_pb.ListAddressesResponse{Addresses: make([]*orders_pb.SavedAddress, 0, len(list))}
+	for _, saved := range list {
+		res.Addresses = append(res.Addresses, toProtoSavedAddress(saved))
+	}
+	return res, nil
+}
+
+func (h *OrdersHandler) DeleteAddress(ctx context.Context, req *orders_pb.AddressRequest) (*orders_pb.ListAddressesResponse, error) {
+	if err := h.uc.DeleteAddress(ctx, req.CustomerId, req.AddressId); err != nil {
+		return nil, err
+	}
+	return h.ListAddresses(ctx, &orders_pb.ListAddressesRequest{CustomerId: req.CustomerId})
+}
+
+func (h *OrdersHandler) SetDefaultAddress(ctx context.Context, req *orders_pb.AddressRequest) (*orders_pb.SavedAddress, error) {
+	saved, err := h.uc.SetDefaultAddress(ctx, req.CustomerId, req.AddressId)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoSavedAddress(saved), nil
+}
+
+func toProtoSavedAddress(a *orders.SavedAddress) *orders_pb.SavedAddress {
+	return &orders_pb.SavedAddress{
+		Id:         a.ID,
+		CustomerId: a.CustomerID,
+		Label:      a.Label,
+		Address:    toProtoAddress(a.Address),
+		IsDefault:  a.IsDefault,
+		CreatedAt:  timestamppb.New(a.CreatedAt),
+	}
+}
+
+func (h *OrdersHandler) PayOrder(ctx context.Context, req *orders_pb.PayOrderRequest) (*orders_pb.Order, error) {
+	in, err := toTransitionInput(ctx, req.OrderId, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent(ctx, h.idempotency, "PayOrder", req, newOrderResponse, func() (*orders_pb.Order, error) {
+		order, err := h.saga.StartCheckout(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+
+		return toProtoOrder(order), nil
+	})
+}
+
+func (h *OrdersHandler) GetOrder(ctx context.Context, req *orders_pb.GetOrderRequest) (*orders_pb.Order, error) {
+	order, err := h.uc.GetOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) GetOrderHistory(ctx context.Context, req *orders_pb.GetOrderRequest) (*orders_pb.OrderHistory, error) {
+	history, err := h.uc.GetOrderHistory(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &orders_pb.OrderHistory{
+		OrderId: req.OrderId,
+		Entries: make([]*orders_pb.StatusChange, 0, len(history)),
+	}
+	for _, c := range history {
+		res.Entries = append(res.Entries, &orders_pb.StatusChange{
+			Status:    c.Status.String(),
+			ChangedAt: timestamppb.New(c.ChangedAt),
+			ActorId:   c.Actor.ID,
+			ActorRole: string(c.Actor.Role),
+			Note:      c.Note,
+		})
+	}
+	return res, nil
+}
+
+func (h *OrdersHandler) GetOrderSaga(ctx context.Context, req *orders_pb.GetOrderRequest) (*orders_pb.OrderSaga, error) {
+	saga, err := h.saga.GetSaga(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &orders_pb.OrderSaga{
+		OrderId:       saga.OrderID,
+		State:         string(saga.State),
+		PaymentId:     saga.PaymentID,
+		CancelReason:  string(saga.CancelReason),
+		FailureReason: saga.FailureReason,
+		UpdatedAt:     timestamppb.New(saga.UpdatedAt),
+	}
+	for _, t := range saga.Tickets {
+		res.Tickets = append(res.Tickets, &orders_pb.SagaTicket{StoreId: t.StoreID, TicketId: t.TicketID})
+	}
+	if len(saga.Tickets) > 0 {
+		res.TicketId = saga.Tickets[0].TicketID
+	}
+	if !saga.Deadline.IsZero() {
+		res.Deadline = timestamppb.New(saga.Deadline)
+	}
+	return res, nil
+}
+
+func (h *OrdersHandler) ListOrders(ctx context.Context, req *orders_pb.ListOrdersRequest) (*orders_pb.ListOrdersResponse, error) {
+	filter := orders.OrderFilter{
+		CustomerID: req.CustomerId,
+		Limit:      int(req.PageSize),
+	}
+
+	if req.Status != "" {
+		status, err := orders.ParseOrderStatus(req.Status)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", usecase.ErrInvalidInput, err)
+		}
+		filter.Status = &status
+	}
+	if req.CreatedFrom != nil {
+		filter.CreatedFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		filter.CreatedTo = req.CreatedTo.AsTime()
+	}
+	if req.PageToken != "" {
+		offset, err := strconv.Atoi(req.PageToken)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("%w: malformed page token", usecase.ErrInvalidInput)
+		}
+		filter.Offset = offset
+	}
+
+	page, err := h.uc.ListOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &orders_pb.ListOrdersResponse{
+		Orders: make([]*orders_pb.Order, 0, len(page.Orders)),
+	}
+	for _, o := range page.Orders {
+		resp.Orders = append(resp.Orders, toProtoOrder(o))
+	}
+	if page.HasMore {
+		resp.NextPageToken = strconv.Itoa(filter.Offset + len(page.Orders))
+	}
+
+	return resp, nil
+}
+
+func (h *OrdersHandler) SendToKitchen(ctx context.Context, req *orders_pb.OrderTransitionRequest) (*orders_pb.Order, error) {
+	in, err := toTransitionInput(ctx, req.OrderId, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := h.uc.SendToKitchen(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) MarkReady(ctx context.Context, req *orders_pb.OrderTransitionRequest) (*orders_pb.Order, error) {
+	in, err := toTransitionInput(ctx, req.OrderId, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := h.uc.MarkReady(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) ShipToDelivery(ctx context.Context, req *orders_pb.OrderTransitionRequest) (*orders_pb.Order, error) {
+	in, err := toTransitionInput(ctx, req.OrderId, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := h.uc.ShipToDelivery(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) CompleteDelivery(ctx context.Context, req *orders_pb.OrderTransitionRequest) (*orders_pb.Order, error) {
+	in, err := toTransitionInput(ctx, req.OrderId, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := h.uc.CompleteDelivery(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+// toTransitionInput - переход записывается в историю от имени вызывающего из метаданных,
+// actor_id и actor_role из запроса не учитываются.
+func toTransitionInput(ctx context.Context, orderID, note string) (usecase.TransitionInput, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return usecase.TransitionInput{}, err
+	}
+	return usecase.TransitionInput{OrderID: orderID, Actor: actor, Note: note}, nil
+}
+
+// CancelOrder - права на отмену проверяются по вызывающему из метаданных,
+// actor_id и actor_role из запроса не учитываются.
+func (h *OrdersHandler) CancelOrder(ctx context.Context, req *orders_pb.CancelOrderRequest) (*orders_pb.Order, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := h.uc.CancelOrder(ctx, usecase.CancelOrderInput{
+		OrderID: req.OrderId,
+		Reason:  orders.CancelReason(req.Reason),
+		Comment: req.Comment,
+		Actor:   actor,
 	})
 	if err != nil {
 		return nil, err
 	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) CreatePromo(ctx context.Context, req *orders_pb.Promo) (*orders_pb.Promo, error) {
+	saved, err := h.uc.CreatePromo(ctx, fromProtoPromo(req))
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPromo(saved), nil
+}
+
+func (h *OrdersHandler) UpdatePromo(ctx context.Context, req *orders_pb.Promo) (*orders_pb.Promo, error) {
+	saved, err := h.uc.UpdatePromo(ctx, fromProtoPromo(req))
+	if err != nil {
+		return nil, err
+	}
+	return toProtoPromo(saved), nil
+}
 
-	return &orders_pb.OrderResponse{
-		OrderId:     order.ID(),
-		Status:      order.Status().String(),
-		FinalPrice:  order.FinalPrice().InexactFloat64(),
-		OrderNumber: order.OrderNumber(),
+func fromProtoPromo(req *orders_pb.Promo) *orders.Promo {
+	promo := &orders.Promo{
+		Code:             req.Code,
+		Type:             orders.PromoType(req.Type),
+		Amount:           common.NewMoney(req.Amount),
+		Percent:          decimal.NewFromFloat(req.Percent),
+		MaxDiscount:      common.NewMoney(req.MaxDiscount),
+		BuyQty:           int(req.BuyQty),
+		FreeQty:          int(req.FreeQty),
+		Active:           req.Active,
+		MinOrderValue:    common.NewMoney(req.MinOrderValue),
+		ProductIDs:       req.ProductIds,
+		UsageLimit:       int(req.UsageLimit),
+		PerCustomerLimit: int(req.PerCustomerLimit),
+	}
+	if req.ValidFrom != nil {
+		promo.ValidFrom = req.ValidFrom.AsTime()
+	}
+	if req.ValidTo != nil {
+		promo.ValidTo = req.ValidTo.AsTime()
+	}
+	for _, c := range req.CategoryIds {
+		promo.CategoryIDs = append(promo.CategoryIDs, int(c))
+	}
+	return promo
+}
+
+func (h *OrdersHandler) ValidatePromo(ctx context.Context, req *orders_pb.PromoRequest) (*orders_pb.PromoValidation, error) {
+	quote, err := h.uc.ValidatePromo(ctx, req.OrderId, req.Code)
+	if orders.IsPromoRejection(err) {
+		return &orders_pb.PromoValidation{Valid: false, Reason: err.Error()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &orders_pb.PromoValidation{
+		Valid:      true,
+		Discount:   quote.Discount.InexactFloat64(),
+		FinalPrice: quote.FinalPrice.InexactFloat64(),
 	}, nil
 }
 
-func (h *OrdersHandler) PayOrder(ctx context.Context, req *orders_pb.PayOrderRequest) (*orders_pb.OrderResponse, error) {
-	err := h.uc.PayOrder(ctx, req.OrderId)
+func (h *OrdersHandler) ApplyPromo(ctx context.Context, req *orders_pb.PromoRequest) (*orders_pb.Order, error) {
+	order, err := h.uc.ApplyPromo(ctx, req.OrderId, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) AddItem(ctx context.Context, req *orders_pb.AddItemRequest) (*orders_pb.Order, error) {
+	order, err := h.uc.AddItem(ctx, req.OrderId, toItemInput(req.GetItem()))
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) UpdateItem(ctx context.Context, req *orders_pb.UpdateItemRequest) (*orders_pb.Order, error) {
+	order, err := h.uc.UpdateItem(ctx, usecase.UpdateItemInput{
+		OrderID:         req.OrderId,
+		LineID:          req.LineId,
+		Quantity:        int(req.Quantity),
+		ReplaceToppings: req.ReplaceToppings,
+		Toppings:        req.Toppings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) RemoveItem(ctx context.Context, req *orders_pb.RemoveItemRequest) (*orders_pb.Order, error) {
+	order, err := h.uc.RemoveItem(ctx, req.OrderId, req.LineId)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoOrder(order), nil
+}
+
+func (h *OrdersHandler) SetTip(ctx context.Context, req *orders_pb.SetTipRequest) (*orders_pb.Order, error) {
+	order, err := h.uc.SetTip(ctx, req.OrderId, common.NewMoney(req.Tip))
 	if err != nil {
 		return nil, err
 	}
+	return toProtoOrder(order), nil
+}
 
-	return &orders_pb.OrderResponse{OrderId: req.OrderId, Status: "paid"}, nil
-}
\ No newline at end of file
+func toProtoPromo(p *orders.Promo) *orders_pb.Promo {
+	res := &orders_pb.Promo{
+		Code:             p.Code,
+		Type:             string(p.Type),
+		Amount:           p.Amount.InexactFloat64(),
+		Percent:          p.Percent.InexactFloat64(),
+		MaxDiscount:      p.MaxDiscount.InexactFloat64(),
+		BuyQty:           int32(p.BuyQty),  // #nosec G115
+		FreeQty:          int32(p.FreeQty), // #nosec G115
+		ValidFrom:        timestamppb.New(p.ValidFrom),
+		Active:           p.Active,
+		MinOrderValue:    p.MinOrderValue.InexactFloat64(),
+		ProductIds:       p.ProductIDs,
+		UsageLimit:       int32(p.UsageLimit),       // #nosec G115
+		PerCustomerLimit: int32(p.PerCustomerLimit), // #nosec G115
+	}
+	if !p.ValidTo.IsZero() {
+		res.ValidTo = timestamppb.New(p.ValidTo)
+	}
+	for _, c := range p.CategoryIDs {
+		res.CategoryIds = append(res.CategoryIds, int32(c)) // #nosec G115
+	}
+	return res
+}
+
+func toProtoLines(o *orders.Order) []*orders_pb.OrderLine {
+	items := make([]*orders_pb.OrderLine, 0, len(o.Items()))
+	for _, item := range o.Items() {
+		toppings := make([]*orders_pb.Topping, 0, len(item.Toppings()))
+		for _, t := range item.Toppings() {
+			toppings = append(toppings, &orders_pb.Topping{
+				Name:  t.Name,
+				Price: t.Price.InexactFloat64(),
+			})
+		}
+
+		halves := make([]*orders_pb.PizzaHalf, 0, len(item.Halves()))
+		for _, h := range item.Halves() {
+			halves = append(halves, &orders_pb.PizzaHalf{
+				ProductId:   h.ProductID,
+				ProductName: h.ProductName,
+				BasePrice:   h.BasePrice.InexactFloat64(),
+			})
+		}
+
+		size, crust := item.Size(), item.Crust()
+		items = append(items, &orders_pb.OrderLine{
+			LineId:         item.ID(),
+			ProductId:      item.ProductID(),
+			ProductName:    item.ProductName(),
+			Quantity:       int32(item.Quantity()), // #nosec G115
+			BasePrice:      item.BasePrice().InexactFloat64(),
+			SizeMultiplier: size.Multiplier,
+			Toppings:       toppings,
+			TotalPrice:     item.CalculateTotal().InexactFloat64(),
+			Size:           &orders_pb.ItemOption{Code: size.Code, Name: size.Name, Multiplier: size.Multiplier},
+			Crust:          &orders_pb.ItemOption{Code: crust.Code, Name: crust.Name, Multiplier: crust.Multiplier},
+			Halves:         halves,
+			StoreId:        item.StoreID(),
+		})
+	}
+	return items
+}
+
+func toProtoBreakdown(o *orders.Order) []*orders_pb.PriceLine {
+	lines := o.PriceBreakdown()
+	res := make([]*orders_pb.PriceLine, 0, len(lines))
+	for _, l := range lines {
+		res = append(res, &orders_pb.PriceLine{Kind: string(l.Kind), Amount: l.Amount.InexactFloat64()})
+	}
+	return res
+}
+
+func toItemInput(item *orders_pb.OrderItem) usecase.OrderItemInput {
+	return usecase.OrderItemInput{
+		ProductID:     item.GetProductId(),
+		Quantity:      int(item.GetQuantity()),
+		Toppings:      item.GetToppings(),
+		Size:          item.GetSize(),
+		Crust:         item.GetCrust(),
+		HalfProductID: item.GetHalfProductId(),
+	}
+}
+
+func toProtoOrder(o *orders.Order) *orders_pb.Order {
+	res := &orders_pb.Order{
+		OrderId:       o.ID(),
+		OrderNumber:   o.OrderNumber(),
+		CustomerId:    o.CustomerID(),
+		Status:        o.Status().String(),
+		Address:       toProtoAddress(o.Address()),
+		Items:         toProtoLines(o),
+		StoreIds:      o.StoreIDs(),
+		DeliveryPrice: o.DeliveryPrice().InexactFloat64(),
+		Discount:      o.Discount().InexactFloat64(),
+		PromoCode:     o.PromoCode(),
+		FinalPrice:    o.FinalPrice().InexactFloat64(),
+		CreatedAt:     timestamppb.New(o.CreatedAt()),
+
+		PaymentMethod:  string(o.PaymentMethod()),
+		PriceBreakdown: toProtoBreakdown(o),
+	}
+
+	if o.IsPreOrder() {
+		res.ScheduledFor = timestamppb.New(o.ScheduledFor())
+	}
+	if c := o.Cancellation(); c != nil {
+		res.Cancellation = &orders_pb.Cancellation{
+			Reason:                   string(c.Reason),
+			Comment:                  c.Comment,
+			ActorId:                  c.Actor.ID,
+			ActorRole:                string(c.Actor.Role),
+			CanceledAt:               timestamppb.New(c.CanceledAt),
+			PreviousStatus:           c.PreviousStatus.String(),
+			RefundRequired:           c.RequiresRefund(),
+			TicketWithdrawalRequired: c.RequiresTicketWithdrawal(),
+		}
+	}
+	return res
+}
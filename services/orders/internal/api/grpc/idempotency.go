@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/versoit/diploma/services/orders/usecase"
+	"google.golang.org/protobuf/proto"
+)
+
+// idempotentRequest - запрос с ключом идемпотентности.
+type idempotentRequest interface {
+	proto.Message
+	GetIdempotencyKey() string
+}
+
+// idempotent - выполняет обработчик один раз на ключ и отдает повторам сохраненный ответ.
+func idempotent[Req idempotentRequest, Resp proto.Message](
+	ctx context.Context, uc *usecase.IdempotencyUseCase, operation string, req Req,
+	newResp func() Resp, handle func() (Resp, error),
+) (Resp, error) {
+	var zero Resp
+
+	hash, err := requestHash(req)
+	if err != nil {
+		return zero, err
+	}
+
+	raw, err := uc.Do(ctx, operation, req.GetIdempotencyKey(), hash, func() ([]byte, error) {
+		resp, err := handle()
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	resp := newResp()
+	if err := proto.Unmarshal(raw, resp); err != nil {
+		return zero, fmt.Errorf("failed to decode stored response: %w", err)
+	}
+	return resp, nil
+}
+
+// requestHash - отпечаток запроса без самого ключа.
+func requestHash(req idempotentRequest) (string, error) {
+	body := proto.Clone(req)
+	fields := body.ProtoReflect()
+	fields.Clear(fields.Descriptor().Fields().ByName("idempotency_key"))
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
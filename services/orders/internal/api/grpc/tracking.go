@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"github.com/versoit/diploma/services/orders"
+	orders_pb "github.com/versoit/diploma/services/orders/api/proto/pb"
+	"github.com/versoit/diploma/services/orders/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *OrdersHandler) WatchOrder(req *orders_pb.WatchOrderRequest, stream grpc.ServerStreamingServer[orders_pb.OrderTrackingEvent]) error {
+	updates, err := h.tracking.WatchOrder(stream.Context(), usecase.WatchOrderInput{
+		OrderID:    req.OrderId,
+		CustomerID: req.CustomerId,
+	})
+	if err != nil {
+		return err
+	}
+
+	for u := range updates {
+		if err := stream.Send(toTrackingEvent(u)); err != nil {
+			return err
+		}
+	}
+	return stream.Context().Err()
+}
+
+func toTrackingEvent(u orders.TrackingUpdate) *orders_pb.OrderTrackingEvent {
+	res := &orders_pb.OrderTrackingEvent{
+		OrderId: u.OrderID,
+		Kind:    string(u.Kind),
+		At:      timestamppb.New(u.At),
+	}
+	switch u.Kind {
+	case orders.TrackingStatus:
+		res.Status = u.Status.String()
+	case orders.TrackingKitchen:
+		res.StoreId = u.StoreID
+		res.TicketStatus = string(u.Ticket)
+	case orders.TrackingCourier:
+		res.DeliveryStatus = string(u.Delivery)
+		if u.Courier != nil {
+			res.CourierLocation = &orders_pb.GeoPoint{Lat: u.Courier.Lat, Lng: u.Courier.Lng}
+		}
+	}
+	return res
+}
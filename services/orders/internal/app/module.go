@@ -1,16 +1,295 @@
 package app
 
 import (
+	"context"
+	"errors"
+
+	catalog_pb "github.com/versoit/diploma/services/catalog/api/proto/pb"
+	kitchen_pb "github.com/versoit/diploma/services/kitchen/api/proto/pb"
+	logistics_pb "github.com/versoit/diploma/services/logistics/api/proto/pb"
+	"github.com/versoit/diploma/services/orders"
 	"github.com/versoit/diploma/services/orders/internal/api/grpc"
+	"github.com/versoit/diploma/services/orders/internal/broker"
+	"github.com/versoit/diploma/services/orders/internal/clients"
+	"github.com/versoit/diploma/services/orders/internal/config"
 	"github.com/versoit/diploma/services/orders/internal/repository"
+	"github.com/versoit/diploma/services/orders/internal/scheduler"
 	"github.com/versoit/diploma/services/orders/usecase"
+	treasury_pb "github.com/versoit/diploma/services/treasury/api/proto/pb"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
 var Module = fx.Options(
 	fx.Provide(
-		repository.NewInMemoryOrderRepository,
+		config.Load,
+		NewRepositories,
+		NewProductPricer,
+		NewDeliveryPricer,
+		NewStoreSchedule,
+		NewHalfPricing,
+		NewStoreNetwork,
+		NewStoreAssigner,
+		NewFeePolicy,
+		NewOrderPolicy,
 		usecase.NewOrderUseCase,
+		NewIdempotencyUseCase,
+		broker.NewTrackingHub,
+		NewTrackingFeed,
+		usecase.NewTrackingUseCase,
 		grpc.NewOrdersHandler,
+		NewEventPublisher,
+		NewOutboxRelay,
+		NewOutboxScheduler,
+		NewSagaGateways,
+		NewOrderSagaUseCase,
+		NewSagaScheduler,
+		NewIdempotencyScheduler,
 	),
+	fx.Invoke(func(*scheduler.OutboxScheduler, *scheduler.SagaScheduler, *scheduler.IdempotencyScheduler) {}),
+	fx.Invoke(SubscribeServiceEvents),
 )
+
+// Repositories - хранилища сервиса, все на одном бэкенде.
+type Repositories struct {
+	fx.Out
+
+	Orders      orders.OrderRepository
+	Promos      orders.PromoRepository
+	Idempotency orders.IdempotencyStore
+	Outbox      orders.Outbox
+	Sagas       orders.SagaRepository
+	Slots       orders.SlotBookings
+	Addresses   orders.AddressBook
+	StoreLoad   orders.StoreLoad
+}
+
+// NewRepositories - выбирает реализацию хранилища по конфигурации.
+func NewRepositories(lc fx.Lifecycle, cfg config.Config) (Repositories, error) {
+	if cfg.Storage != config.StoragePostgres {
+		orderRepo, outbox := repository.NewInMemoryOrderStore()
+		return Repositories{
+			Orders:      orderRepo,
+			Promos:      repository.NewInMemoryPromoRepository(),
+			Idempotency: repository.NewInMemoryIdempotencyStore(),
+			Outbox:      outbox,
+			Sagas:       repository.NewInMemorySagaRepository(),
+			Slots:       repository.NewInMemorySlotBookings(),
+			Addresses:   repository.NewInMemoryAddressBook(),
+			StoreLoad:   repository.NewOrderStoreLoad(orderRepo),
+		}, nil
+	}
+
+	db, err := repository.OpenPostgres(context.Background(), cfg.DatabaseDSN)
+	if err != nil {
+		return Repositories{}, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return db.Close()
+		},
+	})
+
+	return Repositories{
+		Orders:      repository.NewPostgresOrderRepository(db),
+		Promos:      repository.NewPostgresPromoRepository(db),
+		Idempotency: repository.NewPostgresIdempotencyStore(db),
+		Outbox:      repository.NewPostgresOutbox(db),
+		Sagas:       repository.NewPostgresSagaRepository(db),
+		Slots:       repository.NewPostgresSlotBookings(db),
+		Addresses:   repository.NewPostgresAddressBook(db),
+		StoreLoad:   repository.NewPostgresStoreLoad(db),
+	}, nil
+}
+
+func NewProductPricer(lc fx.Lifecycle, cfg config.Config) (orders.ProductPricer, error) {
+	conn, err := clients.DialCatalog(cfg.CatalogAddr)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return conn.Close()
+		},
+	})
+
+	return clients.NewCatalogPricer(catalog_pb.NewProductServiceClient(conn)), nil
+}
+
+func NewDeliveryPricer(cfg config.Config) (orders.DeliveryPricer, error) {
+	return config.LoadDeliveryTariff(cfg.DeliveryTariffPath)
+}
+
+func NewIdempotencyUseCase(store orders.IdempotencyStore, cfg config.Config) *usecase.IdempotencyUseCase {
+	return usecase.NewIdempotencyUseCase(store, cfg.IdempotencyTTL, cfg.IdempotencyLockTimeout)
+}
+
+func NewIdempotencyScheduler(lc fx.Lifecycle, cfg config.Config, uc *usecase.IdempotencyUseCase, logger *zap.Logger) *scheduler.IdempotencyScheduler {
+	s := scheduler.NewIdempotencyScheduler(uc, cfg.IdempotencyPurgeInterval, logger)
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			s.Start()
+			return nil
+		},
+		OnStop: s.Stop,
+	})
+	return s
+}
+
+func NewStoreSchedule(cfg config.Config) (*orders.StoreSchedule, error) {
+	return cfg.Schedule.StoreSchedule()
+}
+
+func NewHalfPricing(cfg config.Config) orders.HalfPricing {
+	return cfg.HalfPricing
+}
+
+func NewStoreNetwork(cfg config.Config) (*orders.StoreNetwork, error) {
+	return config.LoadStoreNetwork(cfg.StoresPath)
+}
+
+func NewFeePolicy(cfg config.Config) (*orders.FeePolicy, error) {
+	return config.LoadFeePolicy(cfg.FeesPath)
+}
+
+func NewOrderPolicy(cfg config.Config) (*orders.OrderPolicy, error) {
+	return config.LoadOrderPolicy(cfg.PolicyPath)
+}
+
+func NewStoreAssigner(network *orders.StoreNetwork, load orders.StoreLoad) orders.StoreAssigner {
+	return orders.NewStoreRouter(network, load)
+}
+
+// NewTrackingFeed - отслеживание заказов клиентами внутри процесса.
+func NewTrackingFeed(hub *broker.TrackingHub) orders.TrackingFeed {
+	return hub
+}
+
+// SubscribeServiceEvents - события кухни и логистики из NATS попадают в TrackingHub.
+// Без брокера отслеживание получает их состояние только из опроса саги.
+func SubscribeServiceEvents(lc fx.Lifecycle, cfg config.Config, hub *broker.TrackingHub) error {
+	if cfg.Outbox.Broker != config.BrokerNATS {
+		return nil
+	}
+
+	subscriber, err := broker.NewNATSTrackingSubscriber(cfg.Outbox.NATSURL, cfg.Outbox.NATSSubjectPrefix, hub)
+	if err != nil {
+		return err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return subscriber.Close()
+		},
+	})
+	return nil
+}
+
+// NewEventPublisher - брокер для событий из outbox. События заказа также
+// попадают в TrackingHub, откуда их получают клиенты, следящие за заказом.
+func NewEventPublisher(lc fx.Lifecycle, cfg config.Config, hub *broker.TrackingHub) (orders.EventPublisher, error) {
+	if cfg.Outbox.Broker != config.BrokerNATS {
+		return broker.NewFanout(hub, broker.NewInMemoryBroker()), nil
+	}
+
+	publisher, err := broker.NewNATSPublisher(cfg.Outbox.NATSURL, cfg.Outbox.NATSSubjectPrefix)
+	if err != nil {
+		return nil, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			return publisher.Close()
+		},
+	})
+	return broker.NewFanout(hub, publisher), nil
+}
+
+func NewOutboxRelay(outbox orders.Outbox, publisher orders.EventPublisher, cfg config.Config) *usecase.OutboxRelay {
+	return usecase.NewOutboxRelay(outbox, publisher, cfg.Outbox.BatchSize)
+}
+
+func NewOutboxScheduler(lc fx.Lifecycle, cfg config.Config, relay *usecase.OutboxRelay, logger *zap.Logger) *scheduler.OutboxScheduler {
+	s := scheduler.NewOutboxScheduler(relay, cfg.Outbox.RelayInterval, logger)
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			s.Start()
+			return nil
+		},
+		OnStop: s.Stop,
+	})
+	return s
+}
+
+// SagaGateways - клиенты сервисов, участвующих в саге заказа.
+type SagaGateways struct {
+	fx.Out
+
+	Payments orders.PaymentGateway
+	Kitchen  orders.KitchenGateway
+	Delivery orders.DeliveryGateway
+}
+
+func NewSagaGateways(lc fx.Lifecycle, cfg config.Config) (SagaGateways, error) {
+	var closers []func() error
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			var errs []error
+			for _, closeConn := range closers {
+				errs = append(errs, closeConn())
+			}
+			return errors.Join(errs...)
+		},
+	})
+
+	treasuryConn, err := clients.Dial("treasury", cfg.Saga.TreasuryAddr)
+	if err != nil {
+		return SagaGateways{}, err
+	}
+	closers = append(closers, treasuryConn.Close)
+
+	kitchenConn, err := clients.Dial("kitchen", cfg.Saga.KitchenAddr)
+	if err != nil {
+		return SagaGateways{}, err
+	}
+	closers = append(closers, kitchenConn.Close)
+
+	logisticsConn, err := clients.Dial("logistics", cfg.Saga.LogisticsAddr)
+	if err != nil {
+		return SagaGateways{}, err
+	}
+	closers = append(closers, logisticsConn.Close)
+
+	return SagaGateways{
+		Payments: clients.NewTreasuryPayments(treasury_pb.NewPaymentServiceClient(treasuryConn)),
+		Kitchen:  clients.NewKitchenTickets(kitchen_pb.NewTicketServiceClient(kitchenConn)),
+		Delivery: clients.NewLogisticsDeliveries(logistics_pb.NewDeliveryServiceClient(logisticsConn)),
+	}, nil
+}
+
+func NewOrderSagaUseCase(
+	orderUC *usecase.OrderUseCase,
+	sagas orders.SagaRepository,
+	slots orders.SlotBookings,
+	payments orders.PaymentGateway,
+	kitchen orders.KitchenGateway,
+	delivery orders.DeliveryGateway,
+	tracking orders.TrackingFeed,
+	cfg config.Config,
+) *usecase.OrderSagaUseCase {
+	return usecase.NewOrderSagaUseCase(orderUC, sagas, slots, payments, kitchen, delivery, tracking, usecase.SagaTimeouts{
+		Payment:  cfg.Saga.PaymentTimeout,
+		Kitchen:  cfg.Saga.KitchenTimeout,
+		Delivery: cfg.Saga.DeliveryTimeout,
+	})
+}
+
+func NewSagaScheduler(lc fx.Lifecycle, cfg config.Config, uc *usecase.OrderSagaUseCase, logger *zap.Logger) *scheduler.SagaScheduler {
+	s := scheduler.NewSagaScheduler(uc, cfg.Saga.Interval, logger)
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			s.Start()
+			return nil
+		},
+		OnStop: s.Stop,
+	})
+	return s
+}
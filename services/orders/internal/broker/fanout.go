@@ -0,0 +1,26 @@
+package broker
+
+import (
+	"context"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// Fanout - публикация одного сообщения в несколько брокеров по порядку.
+// При ошибке relay повторит сообщение целиком, получатели отбрасывают дубликаты.
+type Fanout struct {
+	publishers []orders.EventPublisher
+}
+
+func NewFanout(publishers ...orders.EventPublisher) *Fanout {
+	return &Fanout{publishers: publishers}
+}
+
+func (f *Fanout) Publish(ctx context.Context, msg orders.OutboxMessage) error {
+	for _, p := range f.publishers {
+		if err := p.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
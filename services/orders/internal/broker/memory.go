@@ -0,0 +1,45 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// Handler - получатель события. Ошибка получателя считается ошибкой публикации,
+// и relay повторит отправку.
+type Handler func(ctx context.Context, msg orders.OutboxMessage) error
+
+// InMemoryBroker - брокер внутри процесса: для тестов и локального запуска без NATS.
+type InMemoryBroker struct {
+	mu   sync.RWMutex
+	subs map[orders.EventType][]Handler
+}
+
+func NewInMemoryBroker() *InMemoryBroker {
+	return &InMemoryBroker{subs: make(map[orders.EventType][]Handler)}
+}
+
+// Subscribe - подписка на тему, пустая тема - все события.
+func (b *InMemoryBroker) Subscribe(topic orders.EventType, h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[topic] = append(b.subs[topic], h)
+}
+
+func (b *InMemoryBroker) Publish(ctx context.Context, msg orders.OutboxMessage) error {
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.subs[msg.Topic])+len(b.subs[""]))
+	handlers = append(handlers, b.subs[msg.Topic]...)
+	handlers = append(handlers, b.subs[""]...)
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		if err := h(ctx, msg); err != nil {
+			return fmt.Errorf("subscriber of %s failed: %w", msg.Topic, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/versoit/diploma/services/orders"
+)
+
+// NATSPublisher - публикация событий в NATS. Тема сообщения - префикс и тип события,
+// ID события передается в заголовке Nats-Msg-Id для дедупликации в JetStream.
+type NATSPublisher struct {
+	conn   *nats.Conn
+	prefix string
+}
+
+func NewNATSPublisher(url, subjectPrefix string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("orders-outbox"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats %s: %w", url, err)
+	}
+	return &NATSPublisher{conn: conn, prefix: subjectPrefix}, nil
+}
+
+func (p *NATSPublisher) Publish(ctx context.Context, msg orders.OutboxMessage) error {
+	m := nats.NewMsg(p.prefix + string(msg.Topic))
+	m.Data = msg.Payload
+	m.Header.Set(nats.MsgIdHdr, msg.ID)
+	m.Header.Set("Order-Id", msg.Key)
+
+	if err := p.conn.PublishMsg(m); err != nil {
+		return fmt.Errorf("failed to publish %s: %w", msg.ID, err)
+	}
+	// Без подтверждения сервера сообщение может остаться в буфере клиента
+	// и пропасть при падении процесса, а outbox уже отметит его отправленным
+	if err := p.conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to flush %s: %w", msg.ID, err)
+	}
+	return nil
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
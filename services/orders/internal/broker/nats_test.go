@@ -0,0 +1,135 @@
+package broker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// natsStandIn - минимальный NATS-сервер: понимает CONNECT, PING и HPUB
+// и запоминает опубликованные сообщения.
+type natsStandIn struct {
+	ln net.Listener
+
+	mu       sync.Mutex
+	received []natsMessage
+}
+
+type natsMessage struct {
+	subject string
+	header  string
+	data    string
+}
+
+func startNATSStandIn(t *testing.T) *natsStandIn {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := &natsStandIn{ln: ln}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *natsStandIn) url() string { return "nats://" + s.ln.Addr().String() }
+
+func (s *natsStandIn) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	port := s.ln.Addr().(*net.TCPAddr).Port
+	_, _ = fmt.Fprintf(conn, `INFO {"server_id":"stand-in","version":"2.10.0","proto":1,"host":"127.0.0.1","port":%d,"headers":true,"max_payload":1048576}`+"\r\n", port)
+
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "PING":
+			_, _ = io.WriteString(conn, "PONG\r\n")
+		case "HPUB":
+			// HPUB <subject> [reply-to] <header bytes> <total bytes>
+			hdrLen, _ := strconv.Atoi(fields[len(fields)-2])
+			total, _ := strconv.Atoi(fields[len(fields)-1])
+			body := make([]byte, total+2)
+			if _, err := io.ReadFull(r, body); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.received = append(s.received, natsMessage{
+				subject: fields[1],
+				header:  string(body[:hdrLen]),
+				data:    string(body[hdrLen:total]),
+			})
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *natsStandIn) messages() []natsMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]natsMessage{}, s.received...)
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	server := startNATSStandIn(t)
+
+	publisher, err := NewNATSPublisher(server.url(), "pizza.")
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer func() { _ = publisher.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	msg := orders.OutboxMessage{
+		ID:      "0192f0c4-0000-7000-8000-000000000001",
+		Topic:   orders.EventOrderPaid,
+		Key:     "order-1",
+		Payload: []byte(`{"order_id":"order-1"}`),
+	}
+	if err := publisher.Publish(ctx, msg); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	// Publish дожидается подтверждения сервера, сообщение уже должно быть принято
+	got := server.messages()
+	if len(got) != 1 {
+		t.Fatalf("expected 1 message on server, got %d", len(got))
+	}
+	if got[0].subject != "pizza.order.paid" || got[0].data != string(msg.Payload) {
+		t.Errorf("unexpected message: %+v", got[0])
+	}
+	if !strings.Contains(got[0].header, "Nats-Msg-Id: "+msg.ID) || !strings.Contains(got[0].header, "Order-Id: order-1") {
+		t.Errorf("expected event and order IDs in headers, got %q", got[0].header)
+	}
+}
@@ -0,0 +1,98 @@
+package broker
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/versoit/diploma/services/orders"
+)
+
+// Темы событий кухни и логистики, из которых собирается отслеживание заказа.
+const (
+	topicTicketUpdated   = "kitchen.ticket.updated"
+	topicDeliveryUpdated = "logistics.delivery.updated"
+)
+
+// ticketEvent - событие kitchen.TicketEvent в той части, которая нужна отслеживанию.
+type ticketEvent struct {
+	OrderID    string    `json:"order_id"`
+	StoreID    string    `json:"store_id"`
+	Status     string    `json:"status"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// deliveryEvent - событие logistics.DeliveryEvent в той части, которая нужна отслеживанию.
+type deliveryEvent struct {
+	OrderID    string    `json:"order_id"`
+	Status     string    `json:"status"`
+	Lat        float64   `json:"lat"`
+	Lng        float64   `json:"lng"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NATSTrackingSubscriber - подписка на события кухни и логистики: они становятся
+// обновлениями отслеживания заказа. Подписка без подтверждений, пропущенное событие
+// восполнит опрос саги.
+type NATSTrackingSubscriber struct {
+	conn *nats.Conn
+	feed orders.TrackingPublisher
+}
+
+func NewNATSTrackingSubscriber(url, subjectPrefix string, feed orders.TrackingPublisher) (*NATSTrackingSubscriber, error) {
+	conn, err := nats.Connect(url, nats.Name("orders-tracking"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats %s: %w", url, err)
+	}
+	s := &NATSTrackingSubscriber{conn: conn, feed: feed}
+
+	handlers := map[string]func([]byte){
+		topicTicketUpdated:   s.handleTicket,
+		topicDeliveryUpdated: s.handleDelivery,
+	}
+	for topic, handle := range handlers {
+		if _, err := conn.Subscribe(subjectPrefix+topic, func(m *nats.Msg) { handle(m.Data) }); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to subscribe to %s: %w", subjectPrefix+topic, err)
+		}
+	}
+	return s, nil
+}
+
+// handleTicket - непонятное событие пропускается, как и в TrackingHub.Publish.
+func (s *NATSTrackingSubscriber) handleTicket(data []byte) {
+	var e ticketEvent
+	if err := json.Unmarshal(data, &e); err != nil || e.OrderID == "" {
+		return
+	}
+	s.feed.PublishUpdate(orders.TrackingUpdate{
+		OrderID: e.OrderID,
+		Kind:    orders.TrackingKitchen,
+		At:      e.OccurredAt,
+		StoreID: e.StoreID,
+		Ticket:  orders.TicketState(e.Status),
+	})
+}
+
+func (s *NATSTrackingSubscriber) handleDelivery(data []byte) {
+	var e deliveryEvent
+	if err := json.Unmarshal(data, &e); err != nil || e.OrderID == "" {
+		return
+	}
+	u := orders.TrackingUpdate{
+		OrderID:  e.OrderID,
+		Kind:     orders.TrackingCourier,
+		At:       e.OccurredAt,
+		Delivery: orders.DeliveryState(e.Status),
+	}
+	// Logistics не различает нулевые координаты и их отсутствие
+	if e.Lat != 0 || e.Lng != 0 {
+		u.Courier = &orders.GeoPoint{Lat: e.Lat, Lng: e.Lng}
+	}
+	s.feed.PublishUpdate(u)
+}
+
+func (s *NATSTrackingSubscriber) Close() error {
+	return s.conn.Drain()
+}
@@ -0,0 +1,214 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+const (
+	// trackingBuffer - сколько обновлений ждет медленного подписчика, дальше новые пропускаются.
+	trackingBuffer = 32
+	// finishedMemory - сколько последних завершенных заказов помнится, чтобы опоздавшие
+	// обновления по ним не заводили состояние заново.
+	finishedMemory = 4096
+	// trackedIdle - незавершенный заказ без обновлений дольше этого срока забывается:
+	// брошенные корзины и неизвестные ID не копятся. Новый подписчик такого заказа
+	// получит статус из базы, кухню и курьера - со следующим обновлением.
+	trackedIdle = 6 * time.Hour
+	// sweepInterval - как часто PublishUpdate ищет забытые заказы.
+	sweepInterval = time.Minute
+)
+
+// TrackingHub - pub/sub обновлений заказов внутри процесса. Помнит последнее состояние
+// каждого незавершенного заказа, чтобы новый подписчик сразу видел кухню и курьера.
+// Статусы заказа приходят из outbox, кухня и курьер - из событий этих сервисов
+// (NATSTrackingSubscriber) и из опроса саги, который подстраховывает пропущенные события.
+type TrackingHub struct {
+	mu     sync.Mutex
+	orders map[string]*trackedOrder
+	subs   map[string]map[int]chan orders.TrackingUpdate
+	nextID int
+
+	finished      map[string]struct{}
+	finishedOrder []string // кольцо в порядке завершения
+	finishedNext  int
+
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+type trackedOrder struct {
+	// seen - время последнего обновления, по нему заказ забывается.
+	seen    time.Time
+	status  *orders.TrackingUpdate
+	kitchen []orders.TrackingUpdate // по одному на точку
+	courier *orders.TrackingUpdate
+}
+
+func NewTrackingHub() *TrackingHub {
+	return &TrackingHub{
+		orders: make(map[string]*trackedOrder),
+		subs:   make(map[string]map[int]chan orders.TrackingUpdate),
+
+		finished:      make(map[string]struct{}),
+		finishedOrder: make([]string, 0, finishedMemory),
+
+		now: time.Now,
+	}
+}
+
+// PublishUpdate - рассылает обновление подписчикам заказа. Повторы и устаревшие статусы
+// (outbox, события сервисов и опрос саги присылают одно и то же несколько раз)
+// отбрасываются, как и обновления уже завершенных заказов.
+func (h *TrackingHub) PublishUpdate(u orders.TrackingUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	h.sweep(now)
+	if _, done := h.finished[u.OrderID]; done {
+		return
+	}
+	tracked, ok := h.orders[u.OrderID]
+	if !ok {
+		tracked = &trackedOrder{}
+		h.orders[u.OrderID] = tracked
+	}
+	if !tracked.apply(u) {
+		return
+	}
+	tracked.seen = now
+	if u.Final() {
+		delete(h.orders, u.OrderID)
+		h.markFinished(u.OrderID)
+	}
+
+	for _, ch := range h.subs[u.OrderID] {
+		select {
+		case ch <- u:
+		default:
+		}
+	}
+}
+
+// sweep - забывает заказы без обновлений дольше trackedIdle, если за ними никто не следит.
+func (h *TrackingHub) sweep(now time.Time) {
+	if now.Sub(h.lastSweep) < sweepInterval {
+		return
+	}
+	h.lastSweep = now
+	for id, tracked := range h.orders {
+		if now.Sub(tracked.seen) >= trackedIdle && len(h.subs[id]) == 0 {
+			delete(h.orders, id)
+		}
+	}
+}
+
+// markFinished - запоминает завершенный заказ, вытесняя самый старый из запомненных.
+func (h *TrackingHub) markFinished(orderID string) {
+	if len(h.finishedOrder) < finishedMemory {
+		h.finishedOrder = append(h.finishedOrder, orderID)
+	} else {
+		delete(h.finished, h.finishedOrder[h.finishedNext])
+		h.finishedOrder[h.finishedNext] = orderID
+		h.finishedNext = (h.finishedNext + 1) % finishedMemory
+	}
+	h.finished[orderID] = struct{}{}
+}
+
+// apply - запоминает обновление, false - оно ничего не меняет.
+func (t *trackedOrder) apply(u orders.TrackingUpdate) bool {
+	switch u.Kind {
+	case orders.TrackingStatus:
+		// Статусы заказа только растут, отмена - последний
+		if t.status != nil && u.Status <= t.status.Status {
+			return false
+		}
+		t.status = &u
+	case orders.TrackingKitchen:
+		for i, k := range t.kitchen {
+			if k.StoreID == u.StoreID {
+				if k.Ticket == u.Ticket {
+					return false
+				}
+				t.kitchen[i] = u
+				return true
+			}
+		}
+		t.kitchen = append(t.kitchen, u)
+	case orders.TrackingCourier:
+		if t.courier != nil && t.courier.Delivery == u.Delivery && samePoint(t.courier.Courier, u.Courier) {
+			return false
+		}
+		t.courier = &u
+	default:
+		return false
+	}
+	return true
+}
+
+func samePoint(a, b *orders.GeoPoint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func (h *TrackingHub) Subscribe(orderID string) ([]orders.TrackingUpdate, <-chan orders.TrackingUpdate, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var latest []orders.TrackingUpdate
+	if tracked, ok := h.orders[orderID]; ok {
+		if tracked.status != nil {
+			latest = append(latest, *tracked.status)
+		}
+		latest = append(latest, tracked.kitchen...)
+		if tracked.courier != nil {
+			latest = append(latest, *tracked.courier)
+		}
+	}
+
+	ch := make(chan orders.TrackingUpdate, trackingBuffer)
+	id := h.nextID
+	h.nextID++
+	if h.subs[orderID] == nil {
+		h.subs[orderID] = make(map[int]chan orders.TrackingUpdate)
+	}
+	h.subs[orderID][id] = ch
+
+	cancel := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subs[orderID], id)
+		if len(h.subs[orderID]) == 0 {
+			delete(h.subs, orderID)
+		}
+	}
+	return latest, ch, cancel
+}
+
+// Publish - EventPublisher для outbox: смена статуса заказа становится обновлением.
+// Непонятное событие пропускается, чтобы не останавливать публикацию в брокер.
+func (h *TrackingHub) Publish(ctx context.Context, msg orders.OutboxMessage) error {
+	var payload orders.OrderEventPayload
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return nil
+	}
+	status, err := orders.ParseOrderStatus(payload.Status)
+	if err != nil {
+		return nil
+	}
+
+	h.PublishUpdate(orders.TrackingUpdate{
+		OrderID: payload.OrderID,
+		Kind:    orders.TrackingStatus,
+		At:      payload.OccurredAt,
+		Status:  status,
+	})
+	return nil
+}
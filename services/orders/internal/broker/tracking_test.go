@@ -0,0 +1,142 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestTrackingHub_DeduplicatesAndRemembers(t *testing.T) {
+	hub := NewTrackingHub()
+	_, updates, cancel := hub.Subscribe("o1")
+	defer cancel()
+
+	kitchen := orders.TrackingUpdate{OrderID: "o1", Kind: orders.TrackingKitchen, StoreID: "north", Ticket: orders.TicketCooking}
+	hub.PublishUpdate(kitchen)
+	hub.PublishUpdate(kitchen)
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "o1", Kind: orders.TrackingStatus, Status: orders.StatusCooking})
+	// Опоздавшее событие outbox не откатывает статус
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "o1", Kind: orders.TrackingStatus, Status: orders.StatusPaid})
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "o2", Kind: orders.TrackingStatus, Status: orders.StatusPaid})
+
+	if got := len(updates); got != 2 {
+		t.Fatalf("expected 2 updates, got %d", got)
+	}
+
+	latest, _, cancelLate := hub.Subscribe("o1")
+	defer cancelLate()
+	if len(latest) != 2 || latest[0].Status != orders.StatusCooking || latest[1].StoreID != "north" {
+		t.Errorf("unexpected latest state: %+v", latest)
+	}
+
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "o1", Kind: orders.TrackingStatus, Status: orders.StatusCompleted})
+	if latest, _, cancelDone := hub.Subscribe("o1"); len(latest) != 0 {
+		t.Errorf("completed order must be forgotten, got %+v", latest)
+	} else {
+		cancelDone()
+	}
+}
+
+func TestTrackingHub_PublishOutboxMessage(t *testing.T) {
+	hub := NewTrackingHub()
+	o := orders.NewOrder("cust1", orders.DeliveryAddress{City: "Moscow", Street: "Red Square"})
+	msg, err := orders.NewOutboxMessage(o, orders.DomainEvent{ID: "e1", Type: orders.EventOrderCreated, OrderID: o.ID(), OccurredAt: time.Now()})
+	if err != nil {
+		t.Fatalf("failed to build message: %v", err)
+	}
+	_, updates, cancel := hub.Subscribe(o.ID())
+	defer cancel()
+
+	if err := NewFanout(hub, NewInMemoryBroker()).Publish(context.Background(), msg); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	select {
+	case u := <-updates:
+		if u.Kind != orders.TrackingStatus || u.Status != orders.StatusCreated {
+			t.Errorf("unexpected update: %+v", u)
+		}
+	default:
+		t.Fatal("expected status update from outbox message")
+	}
+
+	if err := hub.Publish(context.Background(), orders.OutboxMessage{Payload: []byte("garbage")}); err != nil {
+		t.Errorf("malformed message must not stop the relay: %v", err)
+	}
+}
+
+func TestTrackingHub_IgnoresUpdatesAfterFinish(t *testing.T) {
+	hub := NewTrackingHub()
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "o1", Kind: orders.TrackingStatus, Status: orders.StatusCanceled})
+
+	_, updates, cancel := hub.Subscribe("o1")
+	defer cancel()
+	// Опрос саги и outbox присылают состояние после отмены
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "o1", Kind: orders.TrackingKitchen, StoreID: "north", Ticket: orders.TicketCanceled})
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "o1", Kind: orders.TrackingStatus, Status: orders.StatusPaid})
+
+	if got := len(updates); got != 0 {
+		t.Errorf("expected no updates after finish, got %d", got)
+	}
+	if len(hub.orders) != 0 {
+		t.Errorf("finished order must not be tracked again, got %d", len(hub.orders))
+	}
+
+	for i := 0; i < finishedMemory+10; i++ {
+		hub.PublishUpdate(orders.TrackingUpdate{OrderID: fmt.Sprintf("f%d", i), Kind: orders.TrackingStatus, Status: orders.StatusCompleted})
+	}
+	if len(hub.finished) != finishedMemory || len(hub.finishedOrder) != finishedMemory {
+		t.Errorf("finished set must stay bounded, got %d", len(hub.finished))
+	}
+	if _, ok := hub.finished["o1"]; ok {
+		t.Error("oldest finished order must be evicted")
+	}
+}
+
+func TestTrackingHub_ForgetsIdleOrders(t *testing.T) {
+	hub := NewTrackingHub()
+	now := time.Now()
+	hub.now = func() time.Time { return now }
+
+	// Брошенная корзина и заказ, за которым следит клиент
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "cart", Kind: orders.TrackingStatus, Status: orders.StatusCreated})
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "watched", Kind: orders.TrackingStatus, Status: orders.StatusCreated})
+	_, _, cancel := hub.Subscribe("watched")
+	defer cancel()
+
+	now = now.Add(trackedIdle)
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: "fresh", Kind: orders.TrackingStatus, Status: orders.StatusPaid})
+
+	if _, ok := hub.orders["cart"]; ok {
+		t.Error("idle order must be forgotten")
+	}
+	if _, ok := hub.orders["watched"]; !ok {
+		t.Error("order with subscribers must be kept")
+	}
+	if _, ok := hub.orders["fresh"]; !ok {
+		t.Error("fresh order must be tracked")
+	}
+}
+
+func TestNATSTrackingSubscriber_HandlesServiceEvents(t *testing.T) {
+	hub := NewTrackingHub()
+	s := &NATSTrackingSubscriber{feed: hub}
+	_, updates, cancel := hub.Subscribe("o1")
+	defer cancel()
+
+	s.handleTicket([]byte(`{"event_id":"e1","ticket_id":"t1","order_id":"o1","store_id":"north","status":"cooking"}`))
+	s.handleDelivery([]byte(`{"event_id":"e2","order_id":"o1","status":"on_way","lat":55.75,"lng":37.61}`))
+	s.handleTicket([]byte(`not json`))
+
+	if got := len(updates); got != 2 {
+		t.Fatalf("expected 2 updates, got %d", got)
+	}
+	if u := <-updates; u.Kind != orders.TrackingKitchen || u.StoreID != "north" || u.Ticket != orders.TicketCooking {
+		t.Errorf("unexpected kitchen update: %+v", u)
+	}
+	if u := <-updates; u.Kind != orders.TrackingCourier || u.Delivery != orders.DeliveryOnWay || u.Courier == nil || u.Courier.Lat != 55.75 {
+		t.Errorf("unexpected courier update: %+v", u)
+	}
+}
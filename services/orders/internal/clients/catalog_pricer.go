@@ -0,0 +1,96 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/versoit/diploma/pkg/common"
+	catalog_pb "github.com/versoit/diploma/services/catalog/api/proto/pb"
+	"github.com/versoit/diploma/services/orders"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// CatalogPricer - адаптер ProductPricer поверх gRPC API сервиса каталога.
+type CatalogPricer struct {
+	client catalog_pb.ProductServiceClient
+}
+
+func NewCatalogPricer(client catalog_pb.ProductServiceClient) *CatalogPricer {
+	return &CatalogPricer{client: client}
+}
+
+// DialCatalog - соединение с сервисом каталога внутри кластера.
+func DialCatalog(addr string) (*grpc.ClientConn, error) {
+	return Dial("catalog", addr)
+}
+
+// Dial - соединение с соседним сервисом внутри кластера.
+func Dial(service, addr string) (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s client for %s: %w", service, addr, err)
+	}
+	return conn, nil
+}
+
+func (p *CatalogPricer) PriceProducts(ctx context.Context, productIDs []string) (map[string]orders.PricedProduct, error) {
+	result := make(map[string]orders.PricedProduct, len(productIDs))
+	for _, id := range productIDs {
+		if _, done := result[id]; done {
+			continue
+		}
+
+		resp, err := p.client.GetProduct(ctx, &catalog_pb.GetProductRequest{Id: id})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("catalog GetProduct %s: %w", id, err)
+		}
+
+		product := orders.PricedProduct{
+			ProductID:     resp.Id,
+			Name:          resp.Name,
+			BasePrice:     common.NewMoney(resp.Price),
+			IsAvailable:   resp.IsAvailable,
+			CategoryID:    int(resp.CategoryId),
+			HalvesAllowed: resp.HalvesAllowed,
+		}
+		for _, s := range resp.Sizes {
+			product.Sizes = append(product.Sizes, orders.SizeOption{Code: s.Code, Name: s.Name, Multiplier: s.Multiplier})
+		}
+		for _, c := range resp.Crusts {
+			product.Crusts = append(product.Crusts, orders.CrustOption{Code: c.Code, Name: c.Name, Multiplier: c.Multiplier})
+		}
+
+		// Топпинги, ингредиентов которых нет на складе, каталог не возвращает
+		toppings, err := p.client.ListProductToppings(ctx, &catalog_pb.ListProductToppingsRequest{ProductId: id})
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.NotFound:
+			continue
+		case codes.Unimplemented:
+			// Каталог без справочника топпингов: товар продается без них
+			result[id] = product
+			continue
+		default:
+			return nil, fmt.Errorf("catalog ListProductToppings %s: %w", id, err)
+		}
+		product.MaxToppings = int(toppings.MaxToppings)
+		for _, t := range toppings.Toppings {
+			allowed := orders.AllowedTopping{Name: t.Name, Price: common.NewMoney(t.Price), MaxCount: int(t.MaxCount)}
+			for _, sp := range t.SizePrices {
+				if allowed.SizePrices == nil {
+					allowed.SizePrices = make(map[string]common.Money, len(t.SizePrices))
+				}
+				allowed.SizePrices[sp.SizeCode] = common.NewMoney(sp.Price)
+			}
+			product.AllowedToppings = append(product.AllowedToppings, allowed)
+		}
+		result[id] = product
+	}
+	return result, nil
+}
@@ -0,0 +1,142 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+	catalog_pb "github.com/versoit/diploma/services/catalog/api/proto/pb"
+	"github.com/versoit/diploma/services/orders"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeCatalogClient struct {
+	catalog_pb.ProductServiceClient
+	products map[string]*catalog_pb.ProductResponse
+	toppings map[string]*catalog_pb.ListProductToppingsResponse
+	calls    int
+}
+
+func (f *fakeCatalogClient) GetProduct(ctx context.Context, req *catalog_pb.GetProductRequest, _ ...grpc.CallOption) (*catalog_pb.ProductResponse, error) {
+	f.calls++
+	if p, ok := f.products[req.Id]; ok {
+		return p, nil
+	}
+	return nil, status.Error(codes.NotFound, "product not found")
+}
+
+func (f *fakeCatalogClient) ListProductToppings(ctx context.Context, req *catalog_pb.ListProductToppingsRequest, _ ...grpc.CallOption) (*catalog_pb.ListProductToppingsResponse, error) {
+	if _, ok := f.products[req.ProductId]; !ok {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if t, ok := f.toppings[req.ProductId]; ok {
+		return t, nil
+	}
+	return &catalog_pb.ListProductToppingsResponse{}, nil
+}
+
+func TestCatalogPricer_PriceProducts(t *testing.T) {
+	client := &fakeCatalogClient{products: map[string]*catalog_pb.ProductResponse{
+		"p1": {Id: "p1", Name: "Margherita", Price: 450, IsAvailable: true, HalvesAllowed: true,
+			Sizes:  []*catalog_pb.ProductOption{{Code: "25", Name: "25 см", Multiplier: 1}, {Code: "35", Name: "35 см", Multiplier: 1.5}},
+			Crusts: []*catalog_pb.ProductOption{{Code: "thin", Name: "Тонкое", Multiplier: 1.1}}},
+		"p2": {Id: "p2", Name: "Calzone", Price: 600, IsAvailable: false},
+	}, toppings: map[string]*catalog_pb.ListProductToppingsResponse{
+		"p1": {MaxToppings: 3, Toppings: []*catalog_pb.ProductTopping{
+			{ToppingId: "t1", Name: "Cheese", Price: 50, MaxCount: 2,
+				SizePrices: []*catalog_pb.ToppingSizePrice{{SizeCode: "35", Price: 80}}},
+		}},
+	}}
+	pricer := NewCatalogPricer(client)
+
+	prices, err := pricer.PriceProducts(context.Background(), []string{"p1", "p2", "p1", "missing"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(prices) != 2 {
+		t.Fatalf("expected 2 priced products, got %d", len(prices))
+	}
+	if p := prices["p1"]; p.Name != "Margherita" || p.BasePrice.InexactFloat64() != 450 || !p.IsAvailable {
+		t.Errorf("unexpected p1: %+v", p)
+	}
+	if p := prices["p1"]; len(p.Sizes) != 2 || p.Sizes[1].Multiplier != 1.5 || p.Crusts[0].Code != "thin" || !p.HalvesAllowed {
+		t.Errorf("options of p1 not mapped: %+v %+v", p.Sizes, p.Crusts)
+	}
+	if p := prices["p1"]; p.MaxToppings != 3 || len(p.AllowedToppings) != 1 || p.AllowedToppings[0].MaxCount != 2 ||
+		!p.AllowedToppings[0].PriceFor("35").Equal(common.NewMoney(80)) || !p.AllowedToppings[0].PriceFor("25").Equal(common.NewMoney(50)) {
+		t.Errorf("toppings of p1 not mapped: %+v", p.AllowedToppings)
+	}
+	if prices["p2"].IsAvailable {
+		t.Error("p2 must be unavailable")
+	}
+	if client.calls != 3 {
+		t.Errorf("expected duplicate IDs to be fetched once, got %d calls", client.calls)
+	}
+}
+
+func TestCatalogPricer_ResolvesCatalogToppings(t *testing.T) {
+	client := &fakeCatalogClient{products: map[string]*catalog_pb.ProductResponse{
+		"p1": {Id: "p1", Name: "Margherita", Price: 450, IsAvailable: true},
+		"p2": {Id: "p2", Name: "Cola", Price: 100, IsAvailable: true},
+	}, toppings: map[string]*catalog_pb.ListProductToppingsResponse{
+		"p1": {Toppings: []*catalog_pb.ProductTopping{{ToppingId: "t1", Name: "Cheese", Price: 50}}},
+	}}
+
+	prices, err := NewCatalogPricer(client).PriceProducts(context.Background(), []string{"p1", "p2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	toppings, err := prices["p1"].ResolveToppings([]string{"Cheese"}, "")
+	if err != nil || len(toppings) != 1 || !toppings[0].Price.Equal(common.NewMoney(50)) {
+		t.Errorf("expected catalog topping to be accepted, got %+v, %v", toppings, err)
+	}
+	if _, err := prices["p2"].ResolveToppings([]string{"Cheese"}, ""); !errors.Is(err, orders.ErrToppingNotAllowed) {
+		t.Errorf("expected ErrToppingNotAllowed for product without toppings, got %v", err)
+	}
+}
+
+func TestCatalogPricer_CatalogWithoutToppings(t *testing.T) {
+	client := &legacyCatalogClient{product: &catalog_pb.ProductResponse{Id: "p1", Name: "Margherita", Price: 450, IsAvailable: true}}
+
+	prices, err := NewCatalogPricer(client).PriceProducts(context.Background(), []string{"p1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p, ok := prices["p1"]; !ok || !p.IsAvailable || len(p.AllowedToppings) != 0 {
+		t.Errorf("expected product priced without toppings, got %+v", p)
+	}
+}
+
+// legacyCatalogClient - каталог, который еще не отдает топпинги.
+type legacyCatalogClient struct {
+	catalog_pb.ProductServiceClient
+	product *catalog_pb.ProductResponse
+}
+
+func (f *legacyCatalogClient) GetProduct(context.Context, *catalog_pb.GetProductRequest, ...grpc.CallOption) (*catalog_pb.ProductResponse, error) {
+	return f.product, nil
+}
+
+func (f *legacyCatalogClient) ListProductToppings(context.Context, *catalog_pb.ListProductToppingsRequest, ...grpc.CallOption) (*catalog_pb.ListProductToppingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method ListProductToppings")
+}
+
+func TestCatalogPricer_PropagatesErrors(t *testing.T) {
+	client := &failingCatalogClient{}
+	if _, err := NewCatalogPricer(client).PriceProducts(context.Background(), []string{"p1"}); err == nil {
+		t.Error("expected error when catalog is unavailable")
+	}
+}
+
+type failingCatalogClient struct {
+	catalog_pb.ProductServiceClient
+}
+
+func (f *failingCatalogClient) GetProduct(context.Context, *catalog_pb.GetProductRequest, ...grpc.CallOption) (*catalog_pb.ProductResponse, error) {
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
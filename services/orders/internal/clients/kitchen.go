@@ -0,0 +1,65 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	kitchen_pb "github.com/versoit/diploma/services/kitchen/api/proto/pb"
+	"github.com/versoit/diploma/services/orders"
+)
+
+// KitchenTickets - адаптер KitchenGateway поверх gRPC API кухни.
+type KitchenTickets struct {
+	client kitchen_pb.TicketServiceClient
+}
+
+func NewKitchenTickets(client kitchen_pb.TicketServiceClient) *KitchenTickets {
+	return &KitchenTickets{client: client}
+}
+
+// AcceptOrder - тикет на позиции заказа, которые готовит точка storeID.
+func (k *KitchenTickets) AcceptOrder(ctx context.Context, o *orders.Order, storeID string) (string, error) {
+	req := &kitchen_pb.CreateTicketRequest{OrderId: o.ID(), StoreId: storeID}
+	for _, item := range o.ItemsForStore(storeID) {
+		req.Items = append(req.Items, toKitchenItem(item))
+	}
+
+	resp, err := k.client.CreateTicket(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("kitchen CreateTicket %s at store %s: %w", o.ID(), storeID, err)
+	}
+	return resp.TicketId, nil
+}
+
+func (k *KitchenTickets) TicketStatus(ctx context.Context, ticketID string) (orders.TicketState, error) {
+	resp, err := k.client.GetTicket(ctx, &kitchen_pb.TicketRequest{TicketId: ticketID})
+	if err != nil {
+		return "", fmt.Errorf("kitchen GetTicket %s: %w", ticketID, err)
+	}
+	return orders.TicketState(resp.Status), nil
+}
+
+func (k *KitchenTickets) CancelTicket(ctx context.Context, ticketID string) error {
+	if _, err := k.client.CancelTicket(ctx, &kitchen_pb.TicketRequest{TicketId: ticketID}); err != nil {
+		return fmt.Errorf("kitchen CancelTicket %s: %w", ticketID, err)
+	}
+	return nil
+}
+
+// toKitchenItem - состав позиции для кухни: размер, тесто, половины и топпинги.
+func toKitchenItem(item *orders.OrderItem) *kitchen_pb.KitchenItem {
+	res := &kitchen_pb.KitchenItem{
+		ProductId:   item.ProductID(),
+		ProductName: item.ProductName(),
+		Quantity:    int32(item.Quantity()), // #nosec G115
+		Size:        item.Size().Name,
+		Crust:       item.Crust().Name,
+	}
+	for _, h := range item.Halves() {
+		res.Halves = append(res.Halves, &kitchen_pb.KitchenHalf{ProductId: h.ProductID, ProductName: h.ProductName})
+	}
+	for _, t := range item.Toppings() {
+		res.Toppings = append(res.Toppings, t.Name)
+	}
+	return res
+}
@@ -0,0 +1,41 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	logistics_pb "github.com/versoit/diploma/services/logistics/api/proto/pb"
+	"github.com/versoit/diploma/services/orders"
+)
+
+// LogisticsDeliveries - адаптер DeliveryGateway поверх gRPC API логистики.
+type LogisticsDeliveries struct {
+	client logistics_pb.DeliveryServiceClient
+}
+
+func NewLogisticsDeliveries(client logistics_pb.DeliveryServiceClient) *LogisticsDeliveries {
+	return &LogisticsDeliveries{client: client}
+}
+
+// CreateDelivery - доставка заказа, курьер забирает его части во всех точках заказа.
+func (l *LogisticsDeliveries) CreateDelivery(ctx context.Context, o *orders.Order) error {
+	req := &logistics_pb.CreateDeliveryRequest{OrderId: o.ID(), StoreIds: o.StoreIDs(), Tip: o.Tip().InexactFloat64()}
+	if _, err := l.client.CreateDelivery(ctx, req); err != nil {
+		return fmt.Errorf("logistics CreateDelivery %s: %w", o.ID(), err)
+	}
+	return nil
+}
+
+func (l *LogisticsDeliveries) DeliveryStatus(ctx context.Context, orderID string) (orders.DeliveryProgress, error) {
+	resp, err := l.client.GetDelivery(ctx, &logistics_pb.DeliveryRequest{OrderId: orderID})
+	if err != nil {
+		return orders.DeliveryProgress{}, fmt.Errorf("logistics GetDelivery %s: %w", orderID, err)
+	}
+
+	progress := orders.DeliveryProgress{State: orders.DeliveryState(resp.Status)}
+	// Logistics не различает нулевые координаты и их отсутствие
+	if resp.Lat != 0 || resp.Lng != 0 {
+		progress.Courier = &orders.GeoPoint{Lat: resp.Lat, Lng: resp.Lng}
+	}
+	return progress, nil
+}
@@ -0,0 +1,56 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+	treasury_pb "github.com/versoit/diploma/services/treasury/api/proto/pb"
+)
+
+// methodOnline - онлайн-оплата, treasury.MethodOnline.
+const methodOnline = 0
+
+// TreasuryPayments - адаптер PaymentGateway поверх gRPC API treasury.
+type TreasuryPayments struct {
+	client treasury_pb.PaymentServiceClient
+}
+
+func NewTreasuryPayments(client treasury_pb.PaymentServiceClient) *TreasuryPayments {
+	return &TreasuryPayments{client: client}
+}
+
+func (p *TreasuryPayments) InitiatePayment(ctx context.Context, orderID string, amount common.Money) (string, error) {
+	resp, err := p.client.ProcessPayment(ctx, &treasury_pb.PaymentRequest{
+		OrderId: orderID,
+		Amount:  amount.InexactFloat64(),
+		Method:  methodOnline,
+	})
+	if err != nil {
+		return "", fmt.Errorf("treasury ProcessPayment %s: %w", orderID, err)
+	}
+	return resp.PaymentId, nil
+}
+
+func (p *TreasuryPayments) PaymentStatus(ctx context.Context, orderID string) (orders.PaymentState, error) {
+	resp, err := p.client.GetPayment(ctx, &treasury_pb.PaymentLookupRequest{OrderId: orderID})
+	if err != nil {
+		return "", fmt.Errorf("treasury GetPayment %s: %w", orderID, err)
+	}
+	return orders.PaymentState(resp.Status), nil
+}
+
+func (p *TreasuryPayments) DeclinePayment(ctx context.Context, orderID string) error {
+	if _, err := p.client.DeclinePayment(ctx, &treasury_pb.PaymentLookupRequest{OrderId: orderID}); err != nil {
+		return fmt.Errorf("treasury DeclinePayment %s: %w", orderID, err)
+	}
+	return nil
+}
+
+func (p *TreasuryPayments) RefundPayment(ctx context.Context, orderID string) error {
+	if _, err := p.client.RefundPayment(ctx, &treasury_pb.PaymentLookupRequest{OrderId: orderID}); err != nil {
+		return fmt.Errorf("treasury RefundPayment %s: %w", orderID, err)
+	}
+	return nil
+}
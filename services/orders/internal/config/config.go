@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type StorageType string
+
+const (
+	StorageMemory   StorageType = "memory"
+	StoragePostgres StorageType = "postgres"
+)
+
+// Config - настройки сервиса заказов, читаются из переменных окружения.
+type Config struct {
+	Storage     StorageType
+	DatabaseDSN string
+	CatalogAddr string
+	// DeliveryTariffPath - JSON-файл с зонами доставки, пусто - тариф по умолчанию.
+	DeliveryTariffPath string
+	// StoresPath - JSON-файл с точками приготовления, пусто - одна точка на все зоны.
+	StoresPath string
+	// FeesPath - JSON-файл с упаковкой и сервисными сборами, пусто - без сборов.
+	FeesPath string
+	// PolicyPath - JSON-файл с лимитом позиций и заблокированными клиентами, пусто - без ограничений.
+	PolicyPath string
+
+	Schedule ScheduleConfig
+	// IdempotencyTTL - сколько хранится ответ на запрос с ключом идемпотентности.
+	IdempotencyTTL time.Duration
+	// IdempotencyLockTimeout - через сколько незавершенный запрос с ключом считается брошенным.
+	// Должен быть больше дедлайна самого долгого запроса, иначе повтор выполнится дважды.
+	IdempotencyLockTimeout time.Duration
+	// IdempotencyPurgeInterval - как часто удаляются ключи с истекшим TTL.
+	IdempotencyPurgeInterval time.Duration
+	// HalfPricing - цена пиццы "пополам": max - по дорогой половине, average - средняя.
+	HalfPricing orders.HalfPricing
+
+	Outbox OutboxConfig
+	Saga   SagaConfig
+}
+
+func Load() (Config, error) {
+	cfg := Config{
+		Storage:     StorageType(getEnv("ORDERS_STORAGE", string(StorageMemory))),
+		DatabaseDSN: os.Getenv("ORDERS_DATABASE_DSN"),
+		CatalogAddr: getEnv("CATALOG_ADDR", "catalog:8080"),
+
+		DeliveryTariffPath: os.Getenv("ORDERS_DELIVERY_TARIFF"),
+		StoresPath:         os.Getenv("ORDERS_STORES"),
+		FeesPath:           os.Getenv("ORDERS_FEES"),
+		PolicyPath:         os.Getenv("ORDERS_POLICY"),
+	}
+
+	var err error
+	if cfg.Schedule, err = loadSchedule(); err != nil {
+		return Config{}, err
+	}
+	if cfg.Outbox, err = loadOutbox(); err != nil {
+		return Config{}, err
+	}
+	if cfg.Saga, err = loadSaga(); err != nil {
+		return Config{}, err
+	}
+	if cfg.IdempotencyTTL, err = time.ParseDuration(getEnv("ORDERS_IDEMPOTENCY_TTL", "24h")); err != nil || cfg.IdempotencyTTL <= 0 {
+		return Config{}, fmt.Errorf("invalid ORDERS_IDEMPOTENCY_TTL %q", os.Getenv("ORDERS_IDEMPOTENCY_TTL"))
+	}
+	lockTimeout := getEnv("ORDERS_IDEMPOTENCY_LOCK_TIMEOUT", "1m")
+	if cfg.IdempotencyLockTimeout, err = time.ParseDuration(lockTimeout); err != nil || cfg.IdempotencyLockTimeout <= 0 {
+		return Config{}, fmt.Errorf("invalid ORDERS_IDEMPOTENCY_LOCK_TIMEOUT %q", lockTimeout)
+	}
+	purgeInterval := getEnv("ORDERS_IDEMPOTENCY_PURGE_INTERVAL", "10m")
+	if cfg.IdempotencyPurgeInterval, err = time.ParseDuration(purgeInterval); err != nil || cfg.IdempotencyPurgeInterval <= 0 {
+		return Config{}, fmt.Errorf("invalid ORDERS_IDEMPOTENCY_PURGE_INTERVAL %q", purgeInterval)
+	}
+	if cfg.HalfPricing, err = orders.ParseHalfPricing(getEnv("ORDERS_HALF_PIZZA_PRICING", string(orders.HalfPricingMax))); err != nil {
+		return Config{}, fmt.Errorf("invalid ORDERS_HALF_PIZZA_PRICING: %w", err)
+	}
+
+	switch cfg.Storage {
+	case StorageMemory:
+	case StoragePostgres:
+		if cfg.DatabaseDSN == "" {
+			return Config{}, fmt.Errorf("ORDERS_DATABASE_DSN is required for %s storage", cfg.Storage)
+		}
+	default:
+		return Config{}, fmt.Errorf("unknown storage type %q", cfg.Storage)
+	}
+
+	return cfg, nil
+}
+
+func getEnv(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
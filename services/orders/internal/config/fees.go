@@ -0,0 +1,67 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+// feesFile - формат JSON-файла со сборами.
+type feesFile struct {
+	Packaging common.Money              `json:"packaging"`
+	Service   map[string]serviceFeeFile `json:"service"` // по способу оплаты
+}
+
+type serviceFeeFile struct {
+	Percent float64      `json:"percent"`
+	Fixed   common.Money `json:"fixed"`
+}
+
+// LoadFeePolicy - читает сборы из файла, без файла сборов нет.
+func LoadFeePolicy(path string) (*orders.FeePolicy, error) {
+	if path == "" {
+		return DefaultFeePolicy(), nil
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задается оператором
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fee policy: %w", err)
+	}
+	return ParseFeePolicy(data)
+}
+
+func ParseFeePolicy(data []byte) (*orders.FeePolicy, error) {
+	var f feesFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse fee policy: %w", err)
+	}
+
+	policy := &orders.FeePolicy{
+		Packaging: f.Packaging,
+		Service:   make(map[orders.PaymentMethod]orders.ServiceFee, len(f.Service)),
+	}
+	for method, fee := range f.Service {
+		policy.Service[orders.PaymentMethod(method)] = orders.ServiceFee{Percent: fee.Percent, Fixed: fee.Fixed}
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DefaultFeePolicy - без упаковки и сервисного сбора, принимаются все способы оплаты.
+func DefaultFeePolicy() *orders.FeePolicy {
+	free := orders.ServiceFee{Fixed: common.ZeroMoney()}
+	return &orders.FeePolicy{
+		Packaging: common.ZeroMoney(),
+		Service: map[orders.PaymentMethod]orders.ServiceFee{
+			orders.PaymentCard:           free,
+			orders.PaymentCash:           free,
+			orders.PaymentCardOnDelivery: free,
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestParseFeePolicy(t *testing.T) {
+	data := []byte(`{
+		"packaging": "49",
+		"service": {
+			"card": {},
+			"cash": {"fixed": "30"},
+			"card_on_delivery": {"percent": 2.5, "fixed": "10"}
+		}
+	}`)
+
+	policy, err := ParseFeePolicy(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fees, err := policy.Quote(orders.PaymentCardOnDelivery, common.NewMoney(1000))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fees.Packaging.Equal(common.NewMoney(49)) || !fees.Service.Equal(common.NewMoney(35)) {
+		t.Errorf("unexpected fees: packaging %s, service %s", fees.Packaging, fees.Service)
+	}
+
+	if _, err := policy.Quote("crypto", common.NewMoney(1000)); !errors.Is(err, orders.ErrUnknownPaymentMethod) {
+		t.Errorf("expected ErrUnknownPaymentMethod, got %v", err)
+	}
+}
+
+func TestParseFeePolicy_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no methods":        `{"packaging": "10"}`,
+		"negative packing":  `{"packaging": "-1", "service": {"card": {}}}`,
+		"percent over 100":  `{"service": {"card": {"percent": 101}}}`,
+		"negative fixed":    `{"service": {"cash": {"fixed": "-5"}}}`,
+		"malformed payload": `{"service": []}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseFeePolicy([]byte(data)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+type BrokerType string
+
+const (
+	BrokerMemory BrokerType = "memory"
+	BrokerNATS   BrokerType = "nats"
+)
+
+// OutboxConfig - публикация доменных событий из outbox.
+type OutboxConfig struct {
+	Broker            BrokerType
+	NATSURL           string
+	NATSSubjectPrefix string
+
+	RelayInterval time.Duration
+	BatchSize     int
+}
+
+func loadOutbox() (OutboxConfig, error) {
+	cfg := OutboxConfig{
+		Broker:            BrokerType(getEnv("ORDERS_BROKER", string(BrokerMemory))),
+		NATSURL:           getEnv("ORDERS_NATS_URL", "nats://nats:4222"),
+		NATSSubjectPrefix: getEnv("ORDERS_NATS_SUBJECT_PREFIX", "pizza."),
+	}
+
+	var err error
+	if cfg.RelayInterval, err = time.ParseDuration(getEnv("ORDERS_OUTBOX_INTERVAL", "1s")); err != nil || cfg.RelayInterval <= 0 {
+		return OutboxConfig{}, fmt.Errorf("invalid ORDERS_OUTBOX_INTERVAL %q", os.Getenv("ORDERS_OUTBOX_INTERVAL"))
+	}
+	if cfg.BatchSize, err = strconv.Atoi(getEnv("ORDERS_OUTBOX_BATCH_SIZE", "100")); err != nil || cfg.BatchSize <= 0 {
+		return OutboxConfig{}, fmt.Errorf("invalid ORDERS_OUTBOX_BATCH_SIZE %q", os.Getenv("ORDERS_OUTBOX_BATCH_SIZE"))
+	}
+
+	switch cfg.Broker {
+	case BrokerMemory, BrokerNATS:
+	default:
+		return OutboxConfig{}, fmt.Errorf("unknown broker type %q", cfg.Broker)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// policyFile - формат JSON-файла с ограничениями на оформление заказа.
+type policyFile struct {
+	MaxItems         int      `json:"max_items"`
+	BlockedCustomers []string `json:"blocked_customers"`
+}
+
+// LoadOrderPolicy - читает ограничения из файла, без файла ограничений нет.
+func LoadOrderPolicy(path string) (*orders.OrderPolicy, error) {
+	if path == "" {
+		return &orders.OrderPolicy{}, nil
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задается оператором
+	if err != nil {
+		return nil, fmt.Errorf("failed to read order policy: %w", err)
+	}
+	return ParseOrderPolicy(data)
+}
+
+func ParseOrderPolicy(data []byte) (*orders.OrderPolicy, error) {
+	var f policyFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse order policy: %w", err)
+	}
+	if f.MaxItems < 0 {
+		return nil, fmt.Errorf("invalid order policy: max_items must not be negative")
+	}
+
+	policy := &orders.OrderPolicy{
+		MaxItems:         f.MaxItems,
+		BlockedCustomers: make(map[string]bool, len(f.BlockedCustomers)),
+	}
+	for _, id := range f.BlockedCustomers {
+		policy.BlockedCustomers[id] = true
+	}
+	return policy, nil
+}
@@ -0,0 +1,17 @@
+package config
+
+import "testing"
+
+func TestParseOrderPolicy(t *testing.T) {
+	policy, err := ParseOrderPolicy([]byte(`{"max_items": 30, "blocked_customers": ["cust-9"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.MaxItems != 30 || policy.CheckCustomer("cust-9") == nil || policy.CheckCustomer("cust-1") != nil {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+
+	if _, err := ParseOrderPolicy([]byte(`{"max_items": -1}`)); err == nil {
+		t.Error("expected error for negative limit")
+	}
+}
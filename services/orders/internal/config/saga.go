@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// SagaConfig - оркестрация заказа через treasury, kitchen и logistics.
+type SagaConfig struct {
+	TreasuryAddr  string
+	KitchenAddr   string
+	LogisticsAddr string
+
+	// Сроки шагов, после которых запускается компенсация.
+	PaymentTimeout  time.Duration
+	KitchenTimeout  time.Duration
+	DeliveryTimeout time.Duration
+	// Interval - период опроса внешних сервисов по активным сагам.
+	Interval time.Duration
+}
+
+func loadSaga() (SagaConfig, error) {
+	cfg := SagaConfig{
+		TreasuryAddr:  getEnv("TREASURY_ADDR", "treasury:8080"),
+		KitchenAddr:   getEnv("KITCHEN_ADDR", "kitchen:8080"),
+		LogisticsAddr: getEnv("LOGISTICS_ADDR", "logistics:8080"),
+	}
+
+	durations := []struct {
+		env, fallback string
+		dst           *time.Duration
+	}{
+		{"ORDERS_SAGA_PAYMENT_TIMEOUT", "15m", &cfg.PaymentTimeout},
+		{"ORDERS_SAGA_KITCHEN_TIMEOUT", "1h", &cfg.KitchenTimeout},
+		{"ORDERS_SAGA_DELIVERY_TIMEOUT", "2h", &cfg.DeliveryTimeout},
+		{"ORDERS_SAGA_INTERVAL", "5s", &cfg.Interval},
+	}
+	for _, d := range durations {
+		v, err := time.ParseDuration(getEnv(d.env, d.fallback))
+		if err != nil || v <= 0 {
+			return SagaConfig{}, fmt.Errorf("invalid %s %q", d.env, os.Getenv(d.env))
+		}
+		*d.dst = v
+	}
+	return cfg, nil
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// ScheduleConfig - часы работы и параметры предзаказов.
+type ScheduleConfig struct {
+	// WorkingHours - "10:00-23:00", одинаково для всех дней недели.
+	WorkingHours string
+	Timezone     string
+	// Holidays - особые дни через запятую: "2026-12-31=10:00-18:00,2027-01-01=closed".
+	Holidays string
+
+	SlotLength      time.Duration
+	SlotCapacity    int
+	MinAdvance      time.Duration
+	MaxAdvance      time.Duration
+	KitchenLeadTime time.Duration
+}
+
+func loadSchedule() (ScheduleConfig, error) {
+	cfg := ScheduleConfig{
+		WorkingHours: getEnv("ORDERS_WORKING_HOURS", "10:00-23:00"),
+		Timezone:     getEnv("ORDERS_TIMEZONE", "Europe/Moscow"),
+		Holidays:     os.Getenv("ORDERS_HOLIDAYS"),
+	}
+
+	durations := []struct {
+		key      string
+		fallback string
+		dst      *time.Duration
+	}{
+		{"ORDERS_SLOT_LENGTH", "30m", &cfg.SlotLength},
+		{"ORDERS_PREORDER_MIN_ADVANCE", "1h", &cfg.MinAdvance},
+		{"ORDERS_PREORDER_MAX_ADVANCE", "168h", &cfg.MaxAdvance},
+		{"ORDERS_KITCHEN_LEAD_TIME", "45m", &cfg.KitchenLeadTime},
+	}
+	for _, d := range durations {
+		v, err := time.ParseDuration(getEnv(d.key, d.fallback))
+		if err != nil {
+			return ScheduleConfig{}, fmt.Errorf("invalid %s: %w", d.key, err)
+		}
+		*d.dst = v
+	}
+
+	capacity, err := strconv.Atoi(getEnv("ORDERS_SLOT_CAPACITY", "20"))
+	if err != nil || capacity < 0 {
+		return ScheduleConfig{}, fmt.Errorf("invalid ORDERS_SLOT_CAPACITY %q", os.Getenv("ORDERS_SLOT_CAPACITY"))
+	}
+	cfg.SlotCapacity = capacity
+	return cfg, nil
+}
+
+// StoreSchedule - расписание заведения для доменной логики.
+func (c ScheduleConfig) StoreSchedule() (*orders.StoreSchedule, error) {
+	hours, err := parseWorkingHours(c.WorkingHours)
+	if err != nil {
+		return nil, err
+	}
+	special, err := parseHolidays(c.Holidays)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q: %w", c.Timezone, err)
+	}
+
+	schedule := &orders.StoreSchedule{
+		Hours:           make(map[time.Weekday]orders.WorkingHours, 7),
+		Location:        loc,
+		SpecialDays:     special,
+		SlotLength:      c.SlotLength,
+		SlotCapacity:    c.SlotCapacity,
+		MinAdvance:      c.MinAdvance,
+		MaxAdvance:      c.MaxAdvance,
+		KitchenLeadTime: c.KitchenLeadTime,
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		schedule.Hours[d] = hours
+	}
+	return schedule, nil
+}
+
+// parseWorkingHours - "HH:MM-HH:MM".
+func parseWorkingHours(v string) (orders.WorkingHours, error) {
+	opens, closes, ok := strings.Cut(v, "-")
+	if !ok {
+		return orders.WorkingHours{}, fmt.Errorf("invalid working hours %q, expected HH:MM-HH:MM", v)
+	}
+	hours := orders.WorkingHours{}
+	var err error
+	if hours.Opens, err = orders.ParseTimeOfDay(strings.TrimSpace(opens)); err != nil {
+		return orders.WorkingHours{}, err
+	}
+	if hours.Closes, err = orders.ParseTimeOfDay(strings.TrimSpace(closes)); err != nil {
+		return orders.WorkingHours{}, err
+	}
+	return hours, nil
+}
+
+func parseHolidays(v string) (map[string]orders.SpecialDay, error) {
+	days := make(map[string]orders.SpecialDay)
+	for _, entry := range strings.Split(v, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		date, hours, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid holiday %q, expected DATE=HH:MM-HH:MM or DATE=closed", entry)
+		}
+		date = strings.TrimSpace(date)
+		if _, err := time.Parse(orders.DateLayout, date); err != nil {
+			return nil, fmt.Errorf("invalid holiday date %q: %w", date, err)
+		}
+
+		if strings.TrimSpace(hours) == "closed" {
+			days[date] = orders.SpecialDay{Closed: true}
+			continue
+		}
+		wh, err := parseWorkingHours(hours)
+		if err != nil {
+			return nil, fmt.Errorf("invalid holiday %s: %w", date, err)
+		}
+		days[date] = orders.SpecialDay{Hours: wh}
+	}
+	return days, nil
+}
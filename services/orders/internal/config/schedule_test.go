@@ -0,0 +1,35 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleConfig_Holidays(t *testing.T) {
+	cfg := ScheduleConfig{
+		WorkingHours: "10:00-23:00",
+		Timezone:     "UTC",
+		Holidays:     "2026-12-31=10:00-18:00, 2027-01-01=closed",
+	}
+	schedule, err := cfg.StoreSchedule()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if schedule.IsOpen(time.Date(2026, 12, 31, 19, 0, 0, 0, time.UTC)) {
+		t.Error("expected early closing on new year's eve")
+	}
+	if schedule.IsOpen(time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Error("expected day off on new year")
+	}
+	if !schedule.IsOpen(time.Date(2027, 1, 2, 12, 0, 0, 0, time.UTC)) {
+		t.Error("expected regular hours after holidays")
+	}
+
+	for _, holidays := range []string{"2027-01-01", "01.01.2027=closed", "2027-01-01=noon"} {
+		cfg.Holidays = holidays
+		if _, err := cfg.StoreSchedule(); err == nil {
+			t.Errorf("expected error for %q", holidays)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// storesFile - формат JSON-файла с точками приготовления.
+type storesFile struct {
+	Stores []storeFile `json:"stores"`
+}
+
+type storeFile struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Zones    []string `json:"zones"`             // ID зон доставки из тарифа
+	Excluded []string `json:"excluded_products"` // товары, которые точка не готовит
+	Capacity int      `json:"capacity"`
+}
+
+// LoadStoreNetwork - читает точки из файла, без файла используется одна точка на все зоны.
+func LoadStoreNetwork(path string) (*orders.StoreNetwork, error) {
+	if path == "" {
+		return DefaultStoreNetwork(), nil
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задается оператором
+	if err != nil {
+		return nil, fmt.Errorf("failed to read store network: %w", err)
+	}
+	return ParseStoreNetwork(data)
+}
+
+func ParseStoreNetwork(data []byte) (*orders.StoreNetwork, error) {
+	var f storesFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse store network: %w", err)
+	}
+
+	network := &orders.StoreNetwork{}
+	for _, s := range f.Stores {
+		network.Stores = append(network.Stores, orders.Store{
+			ID:       s.ID,
+			Name:     s.Name,
+			Zones:    s.Zones,
+			Excluded: s.Excluded,
+			Capacity: s.Capacity,
+		})
+	}
+
+	if err := network.Validate(); err != nil {
+		return nil, err
+	}
+	return network, nil
+}
+
+// DefaultStoreNetwork - единственная точка, которая обслуживает все зоны и готовит все меню.
+func DefaultStoreNetwork() *orders.StoreNetwork {
+	return &orders.StoreNetwork{
+		Stores: []orders.Store{{ID: "main", Name: "Main"}},
+	}
+}
@@ -0,0 +1,39 @@
+package config
+
+import "testing"
+
+func TestParseStoreNetwork(t *testing.T) {
+	data := []byte(`{
+		"stores": [
+			{"id": "north", "name": "North", "zones": ["center", "north"], "capacity": 10},
+			{"id": "south", "name": "South", "excluded_products": ["p-calzone"]}
+		]
+	}`)
+
+	network, err := ParseStoreNetwork(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(network.Stores) != 2 || network.Stores[0].Capacity != 10 || len(network.Stores[1].Excluded) != 1 {
+		t.Fatalf("unexpected network: %+v", network)
+	}
+	if !network.Stores[1].Covers("center") || network.Stores[0].Covers("south") {
+		t.Error("unexpected store coverage")
+	}
+}
+
+func TestParseStoreNetwork_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no stores":      `{"stores": []}`,
+		"no id":          `{"stores": [{"name": "North"}]}`,
+		"duplicate":      `{"stores": [{"id": "a"}, {"id": "a"}]}`,
+		"negative limit": `{"stores": [{"id": "a", "capacity": -1}]}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseStoreNetwork([]byte(data)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
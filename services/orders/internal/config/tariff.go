@@ -0,0 +1,125 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	_ "time/tzdata" // образ сервиса не содержит базы часовых поясов
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+// tariffFile - формат JSON-файла с зонами доставки.
+type tariffFile struct {
+	Timezone string          `json:"timezone"`
+	Zones    []zoneFile      `json:"zones"`
+	Surges   []surgeRuleFile `json:"surges"`
+}
+
+type zoneFile struct {
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	City             string       `json:"city"`
+	Districts        []string     `json:"districts"`
+	Polygon          [][2]float64 `json:"polygon"` // пары [lat, lng]
+	BasePrice        common.Money `json:"base_price"`
+	FreeDeliveryFrom common.Money `json:"free_delivery_from"`
+	MinOrderValue    common.Money `json:"min_order_value"`
+}
+
+type surgeRuleFile struct {
+	Weekdays  []string     `json:"weekdays"` // "mon".."sun"
+	From      string       `json:"from"`     // "18:00"
+	To        string       `json:"to"`
+	Surcharge common.Money `json:"surcharge"`
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// LoadDeliveryTariff - читает тариф из файла, без файла используется тариф по умолчанию.
+func LoadDeliveryTariff(path string) (*orders.DeliveryTariff, error) {
+	if path == "" {
+		return DefaultDeliveryTariff(), nil
+	}
+
+	data, err := os.ReadFile(path) // #nosec G304 -- путь задается оператором
+	if err != nil {
+		return nil, fmt.Errorf("failed to read delivery tariff: %w", err)
+	}
+	return ParseDeliveryTariff(data)
+}
+
+func ParseDeliveryTariff(data []byte) (*orders.DeliveryTariff, error) {
+	var f tariffFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse delivery tariff: %w", err)
+	}
+
+	tariff := &orders.DeliveryTariff{}
+	if f.Timezone != "" {
+		loc, err := time.LoadLocation(f.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("unknown tariff timezone %q: %w", f.Timezone, err)
+		}
+		tariff.Location = loc
+	}
+
+	for _, z := range f.Zones {
+		zone := orders.DeliveryZone{
+			ID:               z.ID,
+			Name:             z.Name,
+			City:             z.City,
+			Districts:        z.Districts,
+			BasePrice:        z.BasePrice,
+			FreeDeliveryFrom: z.FreeDeliveryFrom,
+			MinOrderValue:    z.MinOrderValue,
+		}
+		for _, p := range z.Polygon {
+			zone.Polygon = append(zone.Polygon, orders.GeoPoint{Lat: p[0], Lng: p[1]})
+		}
+		tariff.Zones = append(tariff.Zones, zone)
+	}
+
+	for _, s := range f.Surges {
+		rule := orders.SurgeRule{Surcharge: s.Surcharge}
+		var err error
+		if rule.From, err = orders.ParseTimeOfDay(s.From); err != nil {
+			return nil, err
+		}
+		if rule.To, err = orders.ParseTimeOfDay(s.To); err != nil {
+			return nil, err
+		}
+		for _, d := range s.Weekdays {
+			wd, ok := weekdays[strings.ToLower(d)]
+			if !ok {
+				return nil, fmt.Errorf("unknown weekday %q in delivery tariff", d)
+			}
+			rule.Weekdays = append(rule.Weekdays, wd)
+		}
+		tariff.Surges = append(tariff.Surges, rule)
+	}
+
+	if err := tariff.Validate(); err != nil {
+		return nil, err
+	}
+	return tariff, nil
+}
+
+// DefaultDeliveryTariff - единая зона на любой город с бесплатной доставкой от 1500.
+func DefaultDeliveryTariff() *orders.DeliveryTariff {
+	return &orders.DeliveryTariff{
+		Zones: []orders.DeliveryZone{{
+			ID:               "default",
+			Name:             "Default",
+			BasePrice:        common.NewMoney(199),
+			FreeDeliveryFrom: common.NewMoney(1500),
+			MinOrderValue:    common.ZeroMoney(),
+		}},
+	}
+}
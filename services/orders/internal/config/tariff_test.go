@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestParseDeliveryTariff(t *testing.T) {
+	data := []byte(`{
+		"timezone": "Europe/Moscow",
+		"zones": [
+			{"id": "center", "name": "Center", "polygon": [[55.7, 37.5], [55.7, 37.7], [55.8, 37.7], [55.8, 37.5]],
+			 "base_price": 99, "free_delivery_from": "1000"},
+			{"id": "moscow", "name": "Moscow", "city": "Moscow", "base_price": 199, "min_order_value": 700}
+		],
+		"surges": [{"weekdays": ["fri", "sat"], "from": "18:00", "to": "21:00", "surcharge": 50}]
+	}`)
+
+	tariff, err := ParseDeliveryTariff(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tariff.Zones) != 2 || len(tariff.Zones[0].Polygon) != 4 || tariff.Location.String() != "Europe/Moscow" {
+		t.Fatalf("unexpected tariff: %+v", tariff)
+	}
+
+	// 16:00 UTC в пятницу - 19:00 по Москве, действует надбавка
+	at := time.Date(2026, 10, 16, 16, 0, 0, 0, time.UTC)
+	q, err := tariff.QuoteDelivery(orders.DeliveryAddress{City: "Moscow"}, common.NewMoney(800), at)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !q.Price.Equal(common.NewMoney(249)) {
+		t.Errorf("expected 249, got %v", q.Price)
+	}
+}
+
+func TestParseDeliveryTariff_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no zones":    `{"zones": []}`,
+		"bad time":    `{"zones": [{"id": "a"}], "surges": [{"from": "6pm", "to": "21:00", "surcharge": 50}]}`,
+		"bad weekday": `{"zones": [{"id": "a"}], "surges": [{"weekdays": ["friday"], "from": "18:00", "to": "21:00", "surcharge": 50}]}`,
+		"bad polygon": `{"zones": [{"id": "a", "polygon": [[55.7, 37.5]]}]}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseDeliveryTariff([]byte(data)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type InMemoryAddressBook struct {
+	mu        sync.RWMutex
+	addresses map[string]orders.SavedAddress
+}
+
+func NewInMemoryAddressBook() orders.AddressBook {
+	return &InMemoryAddressBook{addresses: make(map[string]orders.SavedAddress)}
+}
+
+func (r *InMemoryAddressBook) Save(ctx context.Context, a *orders.SavedAddress) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.addresses[a.ID] = *a
+	return nil
+}
+
+func (r *InMemoryAddressBook) FindByID(ctx context.Context, id string) (*orders.SavedAddress, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, ok := r.addresses[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", orders.ErrAddressNotFound, id)
+	}
+	return &a, nil
+}
+
+func (r *InMemoryAddressBook) ListByCustomer(ctx context.Context, customerID string) ([]*orders.SavedAddress, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]*orders.SavedAddress, 0)
+	for _, a := range r.addresses {
+		if a.CustomerID == customerID {
+			a := a
+			res = append(res, &a)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].CreatedAt.Equal(res[j].CreatedAt) {
+			return res[i].CreatedAt.Before(res[j].CreatedAt)
+		}
+		return res[i].ID < res[j].ID
+	})
+	return res, nil
+}
+
+func (r *InMemoryAddressBook) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.addresses, id)
+	return nil
+}
+
+func (r *InMemoryAddressBook) SetDefault(ctx context.Context, customerID, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if a, ok := r.addresses[id]; !ok || a.CustomerID != customerID {
+		return fmt.Errorf("%w: %s", orders.ErrAddressNotFound, id)
+	}
+	for key, a := range r.addresses {
+		if a.CustomerID == customerID {
+			a.IsDefault = key == id
+			r.addresses[key] = a
+		}
+	}
+	return nil
+}
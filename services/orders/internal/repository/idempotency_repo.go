@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type idempotencyKey struct {
+	operation string
+	key       string
+}
+
+type InMemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[idempotencyKey]orders.IdempotencyRecord
+}
+
+func NewInMemoryIdempotencyStore() orders.IdempotencyStore {
+	return &InMemoryIdempotencyStore{records: make(map[idempotencyKey]orders.IdempotencyRecord)}
+}
+
+func (s *InMemoryIdempotencyStore) Reserve(ctx context.Context, rec orders.IdempotencyRecord) (orders.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purge(rec.CreatedAt)
+	k := idempotencyKey{rec.Operation, rec.Key}
+	if existing, ok := s.records[k]; ok && !existing.Stale(rec.CreatedAt) {
+		return existing, false, nil
+	}
+	rec.Response = nil
+	s.records[k] = rec
+	return rec, true, nil
+}
+
+func (s *InMemoryIdempotencyStore) Complete(ctx context.Context, operation, key, token string, response []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := idempotencyKey{operation, key}
+	rec, ok := s.records[k]
+	if !ok || rec.Token != token {
+		return nil
+	}
+	rec.Response = append([]byte{}, response...)
+	s.records[k] = rec
+	return nil
+}
+
+func (s *InMemoryIdempotencyStore) Release(ctx context.Context, operation, key, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := idempotencyKey{operation, key}
+	if rec, ok := s.records[k]; ok && rec.Token == token && !rec.Completed() {
+		delete(s.records, k)
+	}
+	return nil
+}
+
+func (s *InMemoryIdempotencyStore) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.purge(now), nil
+}
+
+// purge - удаляет просроченные записи, чтобы карта не росла бесконечно.
+func (s *InMemoryIdempotencyStore) purge(now time.Time) int {
+	purged := 0
+	for k, rec := range s.records {
+		if rec.Expired(now) {
+			delete(s.records, k)
+			purged++
+		}
+	}
+	return purged
+}
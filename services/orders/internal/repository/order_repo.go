@@ -2,35 +2,116 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/versoit/diploma/services/orders"
 )
 
+// InMemoryOrderRepository хранит снимки, а не сами агрегаты: изменения заказа,
+// не дошедшие до Save, не должны быть видны другим запросам.
 type InMemoryOrderRepository struct {
-	mu    sync.RWMutex
-	store map[string]*orders.Order
+	mu      sync.RWMutex
+	store   map[string]orders.OrderSnapshot
+	history map[string][]orders.StatusChange
+	// recorded - ID уже сохраненных записей истории
+	recorded map[string]struct{}
+	outbox   *InMemoryOutbox
 }
 
 func NewInMemoryOrderRepository() orders.OrderRepository {
+	repo, _ := NewInMemoryOrderStore()
+	return repo
+}
+
+// NewInMemoryOrderStore - хранилище заказов и outbox, в который Save пишет события заказа.
+func NewInMemoryOrderStore() (orders.OrderRepository, orders.Outbox) {
+	outbox := NewInMemoryOutbox()
 	return &InMemoryOrderRepository{
-		store: make(map[string]*orders.Order),
-	}
+		store:    make(map[string]orders.OrderSnapshot),
+		history:  make(map[string][]orders.StatusChange),
+		recorded: make(map[string]struct{}),
+		outbox:   outbox,
+	}, outbox
 }
 
 func (r *InMemoryOrderRepository) Save(ctx context.Context, o *orders.Order) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.store[o.ID()] = o
+
+	stored, exists := r.store[o.ID()]
+	if (exists && stored.Version != o.Version()) || (!exists && o.Version() != 0) {
+		return fmt.Errorf("%w: order %s", orders.ErrConcurrentModification, o.ID())
+	}
+
+	// Сообщения собираются до записи заказа, чтобы ошибка не оставила заказ без событий
+	messages := make([]orders.OutboxMessage, 0, len(o.PendingEvents()))
+	for _, e := range o.PendingEvents() {
+		msg, err := orders.NewOutboxMessage(o, e)
+		if err != nil {
+			return err
+		}
+		messages = append(messages, msg)
+	}
+
+	snapshot := o.Snapshot()
+	snapshot.Version++
+	r.store[o.ID()] = snapshot
+	r.outbox.append(messages)
+
+	for _, change := range o.PendingStatusChanges() {
+		if _, ok := r.recorded[change.ID]; ok {
+			continue
+		}
+		r.recorded[change.ID] = struct{}{}
+		r.history[o.ID()] = append(r.history[o.ID()], change)
+	}
+	o.MarkSaved()
 	return nil
 }
 
+func (r *InMemoryOrderRepository) History(ctx context.Context, orderID string) ([]orders.StatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	res := make([]orders.StatusChange, len(r.history[orderID]))
+	copy(res, r.history[orderID])
+	return res, nil
+}
+
 func (r *InMemoryOrderRepository) FindByID(ctx context.Context, id string) (*orders.Order, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	o, ok := r.store[id]
+	s, ok := r.store[id]
 	if !ok {
 		return nil, orders.ErrOrderNotFound
 	}
-	return o, nil
+	return orders.RestoreOrder(s), nil
+}
+
+func (r *InMemoryOrderRepository) List(ctx context.Context, filter orders.OrderFilter) ([]*orders.Order, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*orders.Order, 0)
+	for _, s := range r.store {
+		if o := orders.RestoreOrder(s); filter.Match(o) {
+			list = append(list, o)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].CreatedAt().Equal(list[j].CreatedAt()) {
+			return list[i].ID() > list[j].ID()
+		}
+		return list[i].CreatedAt().After(list[j].CreatedAt())
+	})
+
+	if filter.Offset >= len(list) {
+		return []*orders.Order{}, nil
+	}
+	list = list[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(list) {
+		list = list[:filter.Limit]
+	}
+	return list, nil
 }
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// InMemoryOutbox - outbox для in-memory хранилища, заполняется из InMemoryOrderRepository.Save.
+type InMemoryOutbox struct {
+	mu      sync.Mutex
+	pending []orders.OutboxMessage
+	// written - ID всех когда-либо записанных сообщений, чтобы повторный Save не дублировал события
+	written map[string]struct{}
+}
+
+func NewInMemoryOutbox() *InMemoryOutbox {
+	return &InMemoryOutbox{written: make(map[string]struct{})}
+}
+
+func (b *InMemoryOutbox) append(messages []orders.OutboxMessage) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, msg := range messages {
+		if _, ok := b.written[msg.ID]; ok {
+			continue
+		}
+		b.written[msg.ID] = struct{}{}
+		b.pending = append(b.pending, msg)
+	}
+}
+
+func (b *InMemoryOutbox) FetchPending(ctx context.Context, limit int) ([]orders.OutboxMessage, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := len(b.pending)
+	if limit > 0 && limit < n {
+		n = limit
+	}
+	res := make([]orders.OutboxMessage, n)
+	copy(res, b.pending)
+	return res, nil
+}
+
+func (b *InMemoryOutbox) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, msg := range b.pending {
+		if msg.ID == id {
+			b.pending = append(b.pending[:i], b.pending[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// MarkFailed - в памяти попытки не учитываются, сообщение и так остается в очереди.
+func (b *InMemoryOutbox) MarkFailed(ctx context.Context, id string, cause error) error {
+	return nil
+}
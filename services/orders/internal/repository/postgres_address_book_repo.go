@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type PostgresAddressBook struct {
+	db *sql.DB
+}
+
+func NewPostgresAddressBook(db *sql.DB) orders.AddressBook {
+	return &PostgresAddressBook{db: db}
+}
+
+const addressColumns = `id, customer_id, label, city, district, street, house, apartment, floor, comment,
+	lat, lng, is_default, created_at`
+
+func (r *PostgresAddressBook) Save(ctx context.Context, a *orders.SavedAddress) error {
+	addr := a.Address
+	var lat, lng sql.NullFloat64
+	if addr.Location != nil {
+		lat = sql.NullFloat64{Float64: addr.Location.Lat, Valid: true}
+		lng = sql.NullFloat64{Float64: addr.Location.Lng, Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO customer_addresses (`+addressColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (id) DO UPDATE SET
+			label = EXCLUDED.label,
+			city = EXCLUDED.city,
+			district = EXCLUDED.district,
+			street = EXCLUDED.street,
+			house = EXCLUDED.house,
+			apartment = EXCLUDED.apartment,
+			floor = EXCLUDED.floor,
+			comment = EXCLUDED.comment,
+			lat = EXCLUDED.lat,
+			lng = EXCLUDED.lng,
+			is_default = EXCLUDED.is_default`,
+		a.ID, a.CustomerID, a.Label, addr.City, addr.District, addr.Street,
+		addr.House, addr.Apartment, addr.Floor, addr.Comment, lat, lng, a.IsDefault, a.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save address %s: %w", a.ID, err)
+	}
+	return nil
+}
+
+func (r *PostgresAddressBook) FindByID(ctx context.Context, id string) (*orders.SavedAddress, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+addressColumns+` FROM customer_addresses WHERE id = $1`, id)
+	a, err := scanAddress(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", orders.ErrAddressNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load address %s: %w", id, err)
+	}
+	return a, nil
+}
+
+func (r *PostgresAddressBook) ListByCustomer(ctx context.Context, customerID string) ([]*orders.SavedAddress, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+addressColumns+` FROM customer_addresses
+		WHERE customer_id = $1
+		ORDER BY created_at, id`, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addresses of customer %s: %w", customerID, err)
+	}
+	defer rows.Close()
+
+	res := make([]*orders.SavedAddress, 0)
+	for rows.Next() {
+		a, err := scanAddress(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan address: %w", err)
+		}
+		res = append(res, a)
+	}
+	return res, rows.Err()
+}
+
+func (r *PostgresAddressBook) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM customer_addresses WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete address %s: %w", id, err)
+	}
+	return nil
+}
+
+func (r *PostgresAddressBook) SetDefault(ctx context.Context, customerID, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Сначала снимаем старую отметку, иначе сработает уникальный индекс основного адреса
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE customer_addresses SET is_default = FALSE
+		WHERE customer_id = $1 AND is_default AND id <> $2`, customerID, id); err != nil {
+		return fmt.Errorf("failed to reset default address: %w", err)
+	}
+	res, err := tx.ExecContext(ctx, `
+		UPDATE customer_addresses SET is_default = TRUE
+		WHERE customer_id = $1 AND id = $2`, customerID, id)
+	if err != nil {
+		return fmt.Errorf("failed to set default address %s: %w", id, err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("%w: %s", orders.ErrAddressNotFound, id)
+	}
+
+	return tx.Commit()
+}
+
+func scanAddress(row rowScanner) (*orders.SavedAddress, error) {
+	var (
+		a                                 orders.SavedAddress
+		district, house, apartment, floor sql.NullString
+		comment                           sql.NullString
+		lat, lng                          sql.NullFloat64
+	)
+	err := row.Scan(&a.ID, &a.CustomerID, &a.Label, &a.Address.City, &district, &a.Address.Street,
+		&house, &apartment, &floor, &comment, &lat, &lng, &a.IsDefault, &a.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Address.District = district.String
+	a.Address.House = house.String
+	a.Address.Apartment = apartment.String
+	a.Address.Floor = floor.String
+	a.Address.Comment = comment.String
+	if lat.Valid && lng.Valid {
+		a.Address.Location = &orders.GeoPoint{Lat: lat.Float64, Lng: lng.Float64}
+	}
+	return &a, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestPostgresAddressBook_SetDefault(t *testing.T) {
+	book := NewPostgresAddressBook(openTestDB(t))
+	ctx := context.Background()
+	customerID := uuid.NewString()
+
+	home := orders.NewSavedAddress(customerID, "Home", orders.DeliveryAddress{
+		City:     "Moscow",
+		Street:   "Arbat",
+		House:    "10",
+		Floor:    "2",
+		Location: &orders.GeoPoint{Lat: 55.75, Lng: 37.59},
+	})
+	home.IsDefault = true
+	work := orders.NewSavedAddress(customerID, "Work", orders.DeliveryAddress{City: "Moscow", Street: "Tverskaya"})
+	for _, a := range []*orders.SavedAddress{home, work} {
+		if err := book.Save(ctx, a); err != nil {
+			t.Fatalf("failed to save address: %v", err)
+		}
+	}
+
+	if err := book.SetDefault(ctx, customerID, work.ID); err != nil {
+		t.Fatalf("failed to set default: %v", err)
+	}
+	if err := book.SetDefault(ctx, uuid.NewString(), home.ID); !errors.Is(err, orders.ErrAddressNotFound) {
+		t.Errorf("expected ErrAddressNotFound for foreign customer, got %v", err)
+	}
+
+	list, err := book.ListByCustomer(ctx, customerID)
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != home.ID || list[0].IsDefault || !list[1].IsDefault {
+		t.Fatalf("expected work to be the only default, got %+v", list)
+	}
+	if loc := list[0].Address.Location; loc == nil || loc.Lat != 55.75 || list[0].Address.House != "10" {
+		t.Errorf("address fields were not stored: %+v", list[0].Address)
+	}
+
+	if err := book.Delete(ctx, home.ID); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if _, err := book.FindByID(ctx, home.ID); !errors.Is(err, orders.ErrAddressNotFound) {
+		t.Errorf("expected ErrAddressNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type PostgresIdempotencyStore struct {
+	db *sql.DB
+}
+
+func NewPostgresIdempotencyStore(db *sql.DB) orders.IdempotencyStore {
+	return &PostgresIdempotencyStore{db: db}
+}
+
+func (s *PostgresIdempotencyStore) Reserve(ctx context.Context, rec orders.IdempotencyRecord) (orders.IdempotencyRecord, bool, error) {
+	// Просроченная или брошенная запись перезаписывается тем же запросом, живая остается как есть
+	res, err := s.db.ExecContext(ctx, `
+		INSERT INTO idempotency_keys (operation, key, token, request_hash, response, created_at, expires_at, locked_until)
+		VALUES ($1, $2, $7, $3, NULL, $4, $5, $6)
+		ON CONFLICT (operation, key) DO UPDATE SET
+			token = EXCLUDED.token,
+			request_hash = EXCLUDED.request_hash,
+			response = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at,
+			locked_until = EXCLUDED.locked_until
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+			OR (idempotency_keys.response IS NULL AND idempotency_keys.locked_until <= EXCLUDED.created_at)`,
+		rec.Operation, rec.Key, rec.RequestHash, rec.CreatedAt, rec.ExpiresAt, rec.LockedUntil, rec.Token,
+	)
+	if err != nil {
+		return orders.IdempotencyRecord{}, false, fmt.Errorf("failed to reserve key %s: %w", rec.Key, err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return orders.IdempotencyRecord{}, false, err
+	} else if n > 0 {
+		rec.Response = nil
+		return rec, true, nil
+	}
+
+	existing := orders.IdempotencyRecord{Operation: rec.Operation, Key: rec.Key}
+	err = s.db.QueryRowContext(ctx, `
+		SELECT token, request_hash, response, created_at, expires_at, locked_until
+		FROM idempotency_keys WHERE operation = $1 AND key = $2`,
+		rec.Operation, rec.Key,
+	).Scan(&existing.Token, &existing.RequestHash, &existing.Response, &existing.CreatedAt, &existing.ExpiresAt, &existing.LockedUntil)
+	if err != nil {
+		return orders.IdempotencyRecord{}, false, fmt.Errorf("failed to load key %s: %w", rec.Key, err)
+	}
+	return existing, false, nil
+}
+
+func (s *PostgresIdempotencyStore) Complete(ctx context.Context, operation, key, token string, response []byte) error {
+	if response == nil {
+		response = []byte{}
+	}
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE idempotency_keys SET response = $3 WHERE operation = $1 AND key = $2 AND token = $4`,
+		operation, key, response, token,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to complete key %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *PostgresIdempotencyStore) Release(ctx context.Context, operation, key, token string) error {
+	_, err := s.db.ExecContext(ctx, `
+		DELETE FROM idempotency_keys WHERE operation = $1 AND key = $2 AND token = $3 AND response IS NULL`,
+		operation, key, token,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to release key %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *PostgresIdempotencyStore) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired keys: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(n), nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestPostgresIdempotencyStore_Reserve(t *testing.T) {
+	store := NewPostgresIdempotencyStore(openTestDB(t))
+	ctx := context.Background()
+
+	now := time.Now()
+	rec := orders.IdempotencyRecord{
+		Operation:   "CreateOrder",
+		Key:         "key-1",
+		Token:       "token-a",
+		RequestHash: "hash-a",
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+		LockedUntil: now.Add(time.Minute),
+	}
+	if _, reserved, err := store.Reserve(ctx, rec); err != nil || !reserved {
+		t.Fatalf("expected key to be reserved, got %v (%v)", reserved, err)
+	}
+
+	existing, reserved, err := store.Reserve(ctx, rec)
+	if err != nil || reserved || existing.Completed() {
+		t.Fatalf("expected in-flight record, got reserved=%v completed=%v (%v)", reserved, existing.Completed(), err)
+	}
+	if !existing.LockedUntil.Equal(rec.LockedUntil.Truncate(time.Microsecond)) {
+		t.Errorf("expected lock deadline %v, got %v", rec.LockedUntil, existing.LockedUntil)
+	}
+
+	if err := store.Complete(ctx, rec.Operation, rec.Key, rec.Token, []byte("response")); err != nil {
+		t.Fatalf("failed to complete: %v", err)
+	}
+	// Завершенная запись не снимается
+	if err := store.Release(ctx, rec.Operation, rec.Key, rec.Token); err != nil {
+		t.Fatalf("failed to release: %v", err)
+	}
+	existing, _, err = store.Reserve(ctx, rec)
+	if err != nil || string(existing.Response) != "response" || existing.RequestHash != "hash-a" {
+		t.Fatalf("expected stored response, got %q (%v)", existing.Response, err)
+	}
+
+	later := rec
+	later.RequestHash = "hash-b"
+	later.CreatedAt = now.Add(2 * time.Hour)
+	later.ExpiresAt = now.Add(3 * time.Hour)
+	if _, reserved, err := store.Reserve(ctx, later); err != nil || !reserved {
+		t.Errorf("expected expired key to be reserved again, got %v (%v)", reserved, err)
+	}
+}
+
+func TestPostgresIdempotencyStore_TakesOverStaleReservation(t *testing.T) {
+	store := NewPostgresIdempotencyStore(openTestDB(t))
+	ctx := context.Background()
+
+	now := time.Now()
+	rec := orders.IdempotencyRecord{
+		Operation:   "CreateOrder",
+		Key:         "key-1",
+		Token:       "token-a",
+		RequestHash: "hash-a",
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(24 * time.Hour),
+		LockedUntil: now.Add(time.Minute),
+	}
+	if _, reserved, err := store.Reserve(ctx, rec); err != nil || !reserved {
+		t.Fatalf("expected key to be reserved, got %v (%v)", reserved, err)
+	}
+
+	retry := rec
+	retry.Token = "token-b"
+	retry.CreatedAt = now.Add(2 * time.Minute)
+	retry.LockedUntil = retry.CreatedAt.Add(time.Minute)
+	if _, reserved, err := store.Reserve(ctx, retry); err != nil || !reserved {
+		t.Fatalf("expected abandoned reservation to be taken over, got %v (%v)", reserved, err)
+	}
+
+	// Поздние вызовы первого запроса не трогают резерв повтора
+	if err := store.Release(ctx, rec.Operation, rec.Key, rec.Token); err != nil {
+		t.Fatalf("failed to release: %v", err)
+	}
+	if err := store.Complete(ctx, rec.Operation, rec.Key, rec.Token, []byte("stale")); err != nil {
+		t.Fatalf("failed to complete: %v", err)
+	}
+	existing, reserved, err := store.Reserve(ctx, retry)
+	if err != nil || reserved || existing.Completed() || existing.Token != retry.Token {
+		t.Fatalf("expected retry reservation to stay in flight, got reserved=%v %q (%v)", reserved, existing.Response, err)
+	}
+
+	// Завершенный ответ не перехватывается до истечения TTL
+	if err := store.Complete(ctx, retry.Operation, retry.Key, retry.Token, []byte("response")); err != nil {
+		t.Fatalf("failed to complete: %v", err)
+	}
+	late := rec
+	late.CreatedAt = now.Add(time.Hour)
+	late.LockedUntil = late.CreatedAt.Add(time.Minute)
+	existing, reserved, err = store.Reserve(ctx, late)
+	if err != nil || reserved || string(existing.Response) != "response" {
+		t.Errorf("expected completed record to be kept, got reserved=%v %q (%v)", reserved, existing.Response, err)
+	}
+}
+
+func TestPostgresIdempotencyStore_PurgeExpired(t *testing.T) {
+	store := NewPostgresIdempotencyStore(openTestDB(t))
+	ctx := context.Background()
+
+	now := time.Now()
+	for i, ttl := range []time.Duration{time.Minute, time.Hour} {
+		rec := orders.IdempotencyRecord{
+			Operation:   "CreateOrder",
+			Key:         []string{"short", "long"}[i],
+			Token:       "token",
+			RequestHash: "hash-a",
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(ttl),
+			LockedUntil: now.Add(time.Minute),
+		}
+		if _, reserved, err := store.Reserve(ctx, rec); err != nil || !reserved {
+			t.Fatalf("expected key to be reserved, got %v (%v)", reserved, err)
+		}
+	}
+
+	purged, err := store.PurgeExpired(ctx, now.Add(2*time.Minute))
+	if err != nil || purged != 1 {
+		t.Errorf("expected one expired key to be purged, got %d (%v)", purged, err)
+	}
+}
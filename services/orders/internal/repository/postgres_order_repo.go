@@ -0,0 +1,489 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/versoit/diploma/services/orders"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+type PostgresOrderRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresOrderRepository(db *sql.DB) orders.OrderRepository {
+	return &PostgresOrderRepository{db: db}
+}
+
+// OpenPostgres - открывает пул соединений и проверяет доступность базы.
+func OpenPostgres(ctx context.Context, dsn string) (*sql.DB, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	return db, nil
+}
+
+func (r *PostgresOrderRepository) Save(ctx context.Context, o *orders.Order) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	addr := o.Address()
+	var lat, lng sql.NullFloat64
+	if addr.Location != nil {
+		lat = sql.NullFloat64{Float64: addr.Location.Lat, Valid: true}
+		lng = sql.NullFloat64{Float64: addr.Location.Lng, Valid: true}
+	}
+	cancel := cancellationColumns(o.Cancellation())
+	// Обновление проходит, только если в базе та версия, которую прочитали
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO orders (
+			id, order_number, customer_id, status, created_at,
+			delivery_city, delivery_street, delivery_house, delivery_apartment, delivery_floor, delivery_comment,
+			delivery_price, discount, promo_code, final_price,
+			canceled_at, cancel_reason, cancel_comment, canceled_by, canceled_by_role, status_before_cancel,
+			delivery_district, delivery_lat, delivery_lng, scheduled_for, version,
+			payment_method, packaging_fee, service_fee, tip, checkout_started
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26,
+			$27, $28, $29, $30, $31)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status,
+			delivery_city = EXCLUDED.delivery_city,
+			delivery_district = EXCLUDED.delivery_district,
+			delivery_lat = EXCLUDED.delivery_lat,
+			delivery_lng = EXCLUDED.delivery_lng,
+			delivery_street = EXCLUDED.delivery_street,
+			delivery_house = EXCLUDED.delivery_house,
+			delivery_apartment = EXCLUDED.delivery_apartment,
+			delivery_floor = EXCLUDED.delivery_floor,
+			delivery_comment = EXCLUDED.delivery_comment,
+			delivery_price = EXCLUDED.delivery_price,
+			discount = EXCLUDED.discount,
+			promo_code = EXCLUDED.promo_code,
+			final_price = EXCLUDED.final_price,
+			canceled_at = EXCLUDED.canceled_at,
+			cancel_reason = EXCLUDED.cancel_reason,
+			cancel_comment = EXCLUDED.cancel_comment,
+			canceled_by = EXCLUDED.canceled_by,
+			canceled_by_role = EXCLUDED.canceled_by_role,
+			status_before_cancel = EXCLUDED.status_before_cancel,
+			scheduled_for = EXCLUDED.scheduled_for,
+			payment_method = EXCLUDED.payment_method,
+			packaging_fee = EXCLUDED.packaging_fee,
+			service_fee = EXCLUDED.service_fee,
+			tip = EXCLUDED.tip,
+			checkout_started = EXCLUDED.checkout_started,
+			version = EXCLUDED.version
+		WHERE orders.version = EXCLUDED.version - 1`,
+		o.ID(), o.OrderNumber(), o.CustomerID(), int(o.Status()), o.CreatedAt(),
+		addr.City, addr.Street, addr.House, addr.Apartment, addr.Floor, addr.Comment,
+		o.DeliveryPrice(), o.Discount(), o.PromoCode(), o.FinalPrice(),
+		cancel.at, cancel.reason, cancel.comment, cancel.actorID, cancel.actorRole, cancel.prevStatus,
+		addr.District, lat, lng, sql.NullTime{Time: o.ScheduledFor(), Valid: o.IsPreOrder()}, o.Version()+1,
+		string(o.PaymentMethod()), o.PackagingFee(), o.ServiceFee(), o.Tip(), o.CheckoutStarted(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert order %s: %w", o.ID(), err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to upsert order %s: %w", o.ID(), err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("%w: order %s", orders.ErrConcurrentModification, o.ID())
+	}
+
+	// История только дописывается: записи, сохраненные ранее, пропускаются
+	for _, change := range o.PendingStatusChanges() {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO order_status_history (id, order_id, status, changed_at, actor_id, actor_role, note)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (id) DO NOTHING`,
+			change.ID, o.ID(), int(change.Status), change.ChangedAt,
+			change.Actor.ID, string(change.Actor.Role), sql.NullString{String: change.Note, Valid: change.Note != ""},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record status history of order %s: %w", o.ID(), err)
+		}
+	}
+
+	if err := writeOutbox(ctx, tx, o); err != nil {
+		return err
+	}
+
+	// Позиции заказа перезаписываются целиком, топпинги удаляются каскадно
+	if _, err := tx.ExecContext(ctx, `DELETE FROM order_items WHERE order_id = $1`, o.ID()); err != nil {
+		return fmt.Errorf("failed to clear items of order %s: %w", o.ID(), err)
+	}
+
+	for _, item := range o.Items() {
+		// ID строк - UUIDv7, поэтому порядок позиций восстанавливается сортировкой по id
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO order_items (
+				id, order_id, product_id, product_name, quantity, base_price,
+				size_code, size_name, size_multiplier, crust_code, crust_name, crust_multiplier, store_id
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+			item.ID(), o.ID(), item.ProductID(), item.ProductName(), item.Quantity(), item.BasePrice(),
+			item.Size().Code, item.Size().Name, item.Size().Multiplier,
+			item.Crust().Code, item.Crust().Name, item.Crust().Multiplier, item.StoreID(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert item %s: %w", item.ProductID(), err)
+		}
+
+		for i, half := range item.Halves() {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO order_item_halves (order_item_id, position, product_id, product_name, base_price)
+				VALUES ($1, $2, $3, $4, $5)`,
+				item.ID(), i, half.ProductID, half.ProductName, half.BasePrice,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert half %s: %w", half.ProductID, err)
+			}
+		}
+
+		for _, t := range item.Toppings() {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO order_item_toppings (order_item_id, name, price)
+				VALUES ($1, $2, $3)`,
+				item.ID(), t.Name, t.Price,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to insert topping %s: %w", t.Name, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order %s: %w", o.ID(), err)
+	}
+	o.MarkSaved()
+	return nil
+}
+
+const orderColumns = `id, order_number, customer_id, status, created_at,
+	delivery_city, delivery_street, delivery_house, delivery_apartment, delivery_floor, delivery_comment,
+	delivery_price, discount, promo_code,
+	canceled_at, cancel_reason, cancel_comment, canceled_by, canceled_by_role, status_before_cancel,
+	delivery_district, delivery_lat, delivery_lng, scheduled_for, version,
+	payment_method, packaging_fee, service_fee, tip, checkout_started`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanOrder(row rowScanner) (orders.OrderSnapshot, error) {
+	var (
+		s                                     orders.OrderSnapshot
+		status                                int
+		city, street, house, apartment, floor sql.NullString
+		comment, promoCode, district          sql.NullString
+		paymentMethod                         string
+		lat, lng                              sql.NullFloat64
+		scheduledFor                          sql.NullTime
+		cancel                                nullCancellation
+	)
+	if err := row.Scan(
+		&s.ID, &s.OrderNumber, &s.CustomerID, &status, &s.CreatedAt,
+		&city, &street, &house, &apartment, &floor, &comment,
+		&s.DeliveryPrice, &s.Discount, &promoCode,
+		&cancel.at, &cancel.reason, &cancel.comment, &cancel.actorID, &cancel.actorRole, &cancel.prevStatus,
+		&district, &lat, &lng, &scheduledFor, &s.Version,
+		&paymentMethod, &s.PackagingFee, &s.ServiceFee, &s.Tip, &s.CheckoutStarted,
+	); err != nil {
+		return orders.OrderSnapshot{}, err
+	}
+
+	s.Status = orders.OrderStatus(status)
+	s.PromoCode = promoCode.String
+	s.PaymentMethod = orders.PaymentMethod(paymentMethod)
+	s.Address = orders.DeliveryAddress{
+		City:      city.String,
+		District:  district.String,
+		Street:    street.String,
+		House:     house.String,
+		Apartment: apartment.String,
+		Floor:     floor.String,
+		Comment:   comment.String,
+	}
+	if lat.Valid && lng.Valid {
+		s.Address.Location = &orders.GeoPoint{Lat: lat.Float64, Lng: lng.Float64}
+	}
+	s.ScheduledFor = scheduledFor.Time
+	s.Cancellation = cancel.toDomain()
+	return s, nil
+}
+
+// nullCancellation - колонки отмены, заполненные только у отмененных заказов.
+type nullCancellation struct {
+	at         sql.NullTime
+	reason     sql.NullString
+	comment    sql.NullString
+	actorID    sql.NullString
+	actorRole  sql.NullString
+	prevStatus sql.NullInt16
+}
+
+func cancellationColumns(c *orders.Cancellation) nullCancellation {
+	if c == nil {
+		return nullCancellation{}
+	}
+	return nullCancellation{
+		at:         sql.NullTime{Time: c.CanceledAt, Valid: true},
+		reason:     sql.NullString{String: string(c.Reason), Valid: true},
+		comment:    sql.NullString{String: c.Comment, Valid: c.Comment != ""},
+		actorID:    sql.NullString{String: c.Actor.ID, Valid: true},
+		actorRole:  sql.NullString{String: string(c.Actor.Role), Valid: true},
+		prevStatus: sql.NullInt16{Int16: int16(c.PreviousStatus), Valid: true}, // #nosec G115
+	}
+}
+
+func (n nullCancellation) toDomain() *orders.Cancellation {
+	if !n.at.Valid {
+		return nil
+	}
+	return &orders.Cancellation{
+		Reason:         orders.CancelReason(n.reason.String),
+		Comment:        n.comment.String,
+		Actor:          orders.Actor{ID: n.actorID.String, Role: orders.ActorRole(n.actorRole.String)},
+		CanceledAt:     n.at.Time,
+		PreviousStatus: orders.OrderStatus(n.prevStatus.Int16),
+	}
+}
+
+func (r *PostgresOrderRepository) FindByID(ctx context.Context, id string) (*orders.Order, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, orders.ErrOrderNotFound
+	}
+
+	s, err := scanOrder(r.db.QueryRowContext(ctx, `SELECT `+orderColumns+` FROM orders WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, orders.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load order %s: %w", id, err)
+	}
+
+	if s.Items, err = r.loadItems(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return orders.RestoreOrder(s), nil
+}
+
+func (r *PostgresOrderRepository) List(ctx context.Context, filter orders.OrderFilter) ([]*orders.Order, error) {
+	conds := make([]string, 0, 4)
+	args := make([]any, 0, 6)
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.CustomerID != "" {
+		if _, err := uuid.Parse(filter.CustomerID); err != nil {
+			return []*orders.Order{}, nil
+		}
+		addCond("customer_id = $%d", filter.CustomerID)
+	}
+	if filter.Status != nil {
+		addCond("status = $%d", int(*filter.Status))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		addCond("created_at >= $%d", filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		addCond("created_at < $%d", filter.CreatedTo)
+	}
+	if !filter.ScheduledFrom.IsZero() || !filter.ScheduledTo.IsZero() {
+		conds = append(conds, "scheduled_for IS NOT NULL")
+	}
+	if !filter.ScheduledFrom.IsZero() {
+		addCond("scheduled_for >= $%d", filter.ScheduledFrom)
+	}
+	if !filter.ScheduledTo.IsZero() {
+		addCond("scheduled_for < $%d", filter.ScheduledTo)
+	}
+
+	query := `SELECT ` + orderColumns + ` FROM orders`
+	if len(conds) > 0 {
+		query += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	query += ` ORDER BY created_at DESC, id DESC`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	snapshots := make([]orders.OrderSnapshot, 0)
+	for rows.Next() {
+		s, err := scanOrder(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan order: %w", err)
+		}
+		snapshots = append(snapshots, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate orders: %w", err)
+	}
+
+	result := make([]*orders.Order, 0, len(snapshots))
+	for _, s := range snapshots {
+		if s.Items, err = r.loadItems(ctx, s.ID); err != nil {
+			return nil, err
+		}
+		result = append(result, orders.RestoreOrder(s))
+	}
+	return result, nil
+}
+
+func (r *PostgresOrderRepository) History(ctx context.Context, orderID string) ([]orders.StatusChange, error) {
+	if _, err := uuid.Parse(orderID); err != nil {
+		return []orders.StatusChange{}, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, order_id, status, changed_at, actor_id, actor_role, note
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY changed_at, id`, orderID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load history of order %s: %w", orderID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	history := make([]orders.StatusChange, 0)
+	for rows.Next() {
+		var (
+			c      orders.StatusChange
+			status int
+			role   string
+			note   sql.NullString
+		)
+		if err := rows.Scan(&c.ID, &c.OrderID, &status, &c.ChangedAt, &c.Actor.ID, &role, &note); err != nil {
+			return nil, fmt.Errorf("failed to scan history of order %s: %w", orderID, err)
+		}
+		c.Status = orders.OrderStatus(status)
+		c.Actor.Role = orders.ActorRole(role)
+		c.Note = note.String
+		history = append(history, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate history of order %s: %w", orderID, err)
+	}
+
+	return history, nil
+}
+
+func (r *PostgresOrderRepository) loadItems(ctx context.Context, orderID string) ([]orders.OrderItemSnapshot, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT i.id, i.product_id, i.product_name, i.quantity, i.base_price,
+			i.size_code, i.size_name, i.size_multiplier, i.crust_code, i.crust_name, i.crust_multiplier, i.store_id,
+			t.name, t.price
+		FROM order_items i
+		LEFT JOIN order_item_toppings t ON t.order_item_id = i.id
+		WHERE i.order_id = $1
+		ORDER BY i.id, t.id`, orderID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load items of order %s: %w", orderID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := make([]orders.OrderItemSnapshot, 0)
+	lastItemID := ""
+	for rows.Next() {
+		var (
+			item         orders.OrderItemSnapshot
+			toppingName  sql.NullString
+			toppingPrice decimal.NullDecimal
+		)
+		if err := rows.Scan(
+			&item.ID, &item.ProductID, &item.ProductName, &item.Quantity, &item.BasePrice,
+			&item.Size.Code, &item.Size.Name, &item.Size.Multiplier,
+			&item.Crust.Code, &item.Crust.Name, &item.Crust.Multiplier, &item.StoreID,
+			&toppingName, &toppingPrice,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan item of order %s: %w", orderID, err)
+		}
+
+		if item.ID != lastItemID {
+			item.Toppings = make([]orders.Topping, 0)
+			items = append(items, item)
+			lastItemID = item.ID
+		}
+		if toppingName.Valid {
+			last := &items[len(items)-1]
+			last.Toppings = append(last.Toppings, orders.Topping{Name: toppingName.String, Price: toppingPrice.Decimal})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate items of order %s: %w", orderID, err)
+	}
+
+	if err := r.loadHalves(ctx, orderID, items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// loadHalves - половины пицц "пополам" для уже загруженных позиций заказа.
+func (r *PostgresOrderRepository) loadHalves(ctx context.Context, orderID string, items []orders.OrderItemSnapshot) error {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT h.order_item_id, h.product_id, h.product_name, h.base_price
+		FROM order_item_halves h
+		JOIN order_items i ON i.id = h.order_item_id
+		WHERE i.order_id = $1
+		ORDER BY h.order_item_id, h.position`, orderID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load halves of order %s: %w", orderID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	byItem := make(map[string]int, len(items))
+	for i := range items {
+		byItem[items[i].ID] = i
+	}
+	for rows.Next() {
+		var (
+			itemID string
+			half   orders.PizzaHalf
+		)
+		if err := rows.Scan(&itemID, &half.ProductID, &half.ProductName, &half.BasePrice); err != nil {
+			return fmt.Errorf("failed to scan half of order %s: %w", orderID, err)
+		}
+		if i, ok := byItem[itemID]; ok {
+			items[i].Halves = append(items[i].Halves, half)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate halves of order %s: %w", orderID, err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type PostgresOutbox struct {
+	db *sql.DB
+}
+
+func NewPostgresOutbox(db *sql.DB) orders.Outbox {
+	return &PostgresOutbox{db: db}
+}
+
+// writeOutbox - пишет события заказа в outbox внутри транзакции сохранения заказа.
+func writeOutbox(ctx context.Context, tx *sql.Tx, o *orders.Order) error {
+	for _, e := range o.PendingEvents() {
+		msg, err := orders.NewOutboxMessage(o, e)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO outbox (id, topic, key, payload, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO NOTHING`,
+			msg.ID, string(msg.Topic), msg.Key, string(msg.Payload), msg.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write event %s to outbox: %w", msg.ID, err)
+		}
+	}
+	return nil
+}
+
+func (b *PostgresOutbox) FetchPending(ctx context.Context, limit int) ([]orders.OutboxMessage, error) {
+	query := `
+		SELECT id, topic, key, payload, created_at
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY created_at, id`
+	args := []any{}
+	if limit > 0 {
+		query += ` LIMIT $1`
+		args = append(args, limit)
+	}
+
+	rows, err := b.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outbox: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	res := make([]orders.OutboxMessage, 0)
+	for rows.Next() {
+		var (
+			msg   orders.OutboxMessage
+			topic string
+		)
+		if err := rows.Scan(&msg.ID, &topic, &msg.Key, &msg.Payload, &msg.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		msg.Topic = orders.EventType(topic)
+		res = append(res, msg)
+	}
+	return res, rows.Err()
+}
+
+func (b *PostgresOutbox) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	if _, err := b.db.ExecContext(ctx, `UPDATE outbox SET published_at = $2 WHERE id = $1`, id, at); err != nil {
+		return fmt.Errorf("failed to mark outbox message %s published: %w", id, err)
+	}
+	return nil
+}
+
+func (b *PostgresOutbox) MarkFailed(ctx context.Context, id string, cause error) error {
+	_, err := b.db.ExecContext(ctx, `
+		UPDATE outbox SET attempts = attempts + 1, last_error = $2 WHERE id = $1`,
+		id, cause.Error(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record outbox failure of %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestPostgresOutbox_WrittenWithOrder(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewPostgresOrderRepository(db)
+	outbox := NewPostgresOutbox(db)
+	ctx := context.Background()
+
+	order := newTestOrder(t)
+	if err := order.MarkPaid(orders.SystemActor, ""); err != nil {
+		t.Fatalf("failed to pay: %v", err)
+	}
+	if err := repo.Save(ctx, order); err != nil {
+		t.Fatalf("failed to save: %v", err)
+	}
+	// Повторное сохранение того же агрегата события не дублирует
+	if err := repo.Save(ctx, order); err != nil {
+		t.Fatalf("failed to save again: %v", err)
+	}
+
+	pending, err := outbox.FetchPending(ctx, 10)
+	if err != nil {
+		t.Fatalf("failed to fetch: %v", err)
+	}
+	if len(pending) != 2 || pending[0].Topic != orders.EventOrderCreated || pending[1].Topic != orders.EventOrderPaid {
+		t.Fatalf("expected created and paid events, got %+v", pending)
+	}
+	if pending[0].Key != order.ID() || len(pending[0].Payload) == 0 {
+		t.Errorf("unexpected message: %+v", pending[0])
+	}
+
+	if err := outbox.MarkFailed(ctx, pending[0].ID, errors.New("broker is down")); err != nil {
+		t.Fatalf("failed to mark failed: %v", err)
+	}
+	if err := outbox.MarkPublished(ctx, pending[0].ID, time.Now()); err != nil {
+		t.Fatalf("failed to mark published: %v", err)
+	}
+	pending, err = outbox.FetchPending(ctx, 10)
+	if err != nil {
+		t.Fatalf("failed to fetch: %v", err)
+	}
+	if len(pending) != 1 || pending[0].Topic != orders.EventOrderPaid {
+		t.Errorf("expected only paid event left, got %+v", pending)
+	}
+}
@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type PostgresPromoRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresPromoRepository(db *sql.DB) orders.PromoRepository {
+	return &PostgresPromoRepository{db: db}
+}
+
+func (r *PostgresPromoRepository) Create(ctx context.Context, p *orders.Promo) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	validTo := sql.NullTime{Time: p.ValidTo, Valid: !p.ValidTo.IsZero()}
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO promos (
+			code, type, amount, percent, max_discount, buy_qty, free_qty,
+			valid_from, valid_to, is_active, min_order_value, usage_limit, per_customer_limit
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (code) DO NOTHING`,
+		p.Code, string(p.Type), p.Amount, p.Percent, p.MaxDiscount, p.BuyQty, p.FreeQty,
+		p.ValidFrom, validTo, p.Active, p.MinOrderValue, p.UsageLimit, p.PerCustomerLimit,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert promo %s: %w", p.Code, err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to check insert of promo %s: %w", p.Code, err)
+	} else if n == 0 {
+		return orders.ErrPromoExists
+	}
+
+	if err := r.saveRestrictions(ctx, tx, p); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit promo %s: %w", p.Code, err)
+	}
+	return nil
+}
+
+func (r *PostgresPromoRepository) Update(ctx context.Context, p *orders.Promo) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	validTo := sql.NullTime{Time: p.ValidTo, Valid: !p.ValidTo.IsZero()}
+	res, err := tx.ExecContext(ctx, `
+		UPDATE promos SET
+			type = $2, amount = $3, percent = $4, max_discount = $5, buy_qty = $6, free_qty = $7,
+			valid_from = $8, valid_to = $9, is_active = $10, min_order_value = $11,
+			usage_limit = $12, per_customer_limit = $13
+		WHERE code = $1`,
+		p.Code, string(p.Type), p.Amount, p.Percent, p.MaxDiscount, p.BuyQty, p.FreeQty,
+		p.ValidFrom, validTo, p.Active, p.MinOrderValue, p.UsageLimit, p.PerCustomerLimit,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update promo %s: %w", p.Code, err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to check update of promo %s: %w", p.Code, err)
+	} else if n == 0 {
+		return orders.ErrPromoNotFound
+	}
+
+	if err := r.saveRestrictions(ctx, tx, p); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit promo %s: %w", p.Code, err)
+	}
+	return nil
+}
+
+// saveRestrictions - заменяет товары и категории, к которым применим промокод.
+func (r *PostgresPromoRepository) saveRestrictions(ctx context.Context, tx *sql.Tx, p *orders.Promo) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM promo_products WHERE code = $1`, p.Code); err != nil {
+		return fmt.Errorf("failed to clear products of promo %s: %w", p.Code, err)
+	}
+	for _, id := range p.ProductIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO promo_products (code, product_id) VALUES ($1, $2)`, p.Code, id); err != nil {
+			return fmt.Errorf("failed to insert product %s of promo %s: %w", id, p.Code, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM promo_categories WHERE code = $1`, p.Code); err != nil {
+		return fmt.Errorf("failed to clear categories of promo %s: %w", p.Code, err)
+	}
+	for _, cat := range p.CategoryIDs {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO promo_categories (code, category_id) VALUES ($1, $2)`, p.Code, cat); err != nil {
+			return fmt.Errorf("failed to insert category %d of promo %s: %w", cat, p.Code, err)
+		}
+	}
+	return nil
+}
+
+func (r *PostgresPromoRepository) FindByCode(ctx context.Context, code string) (*orders.Promo, error) {
+	var (
+		p       orders.Promo
+		typ     string
+		validTo sql.NullTime
+	)
+	err := r.db.QueryRowContext(ctx, `
+		SELECT code, type, amount, percent, max_discount, buy_qty, free_qty,
+			valid_from, valid_to, is_active, min_order_value, usage_limit, per_customer_limit
+		FROM promos WHERE code = $1`, code,
+	).Scan(
+		&p.Code, &typ, &p.Amount, &p.Percent, &p.MaxDiscount, &p.BuyQty, &p.FreeQty,
+		&p.ValidFrom, &validTo, &p.Active, &p.MinOrderValue, &p.UsageLimit, &p.PerCustomerLimit,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, orders.ErrPromoNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load promo %s: %w", code, err)
+	}
+	p.Type = orders.PromoType(typ)
+	p.ValidTo = validTo.Time
+
+	if p.ProductIDs, err = queryStrings(ctx, r.db, `SELECT product_id FROM promo_products WHERE code = $1 ORDER BY product_id`, code); err != nil {
+		return nil, fmt.Errorf("failed to load products of promo %s: %w", code, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT category_id FROM promo_categories WHERE code = $1 ORDER BY category_id`, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load categories of promo %s: %w", code, err)
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var cat int
+		if err := rows.Scan(&cat); err != nil {
+			return nil, fmt.Errorf("failed to scan category of promo %s: %w", code, err)
+		}
+		p.CategoryIDs = append(p.CategoryIDs, cat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate categories of promo %s: %w", code, err)
+	}
+
+	return &p, nil
+}
+
+func (r *PostgresPromoRepository) Usage(ctx context.Context, code, customerID, excludeOrderID string) (orders.PromoUsage, error) {
+	return countRedemptions(ctx, r.db, code, customerID, excludeOrderID)
+}
+
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func countRedemptions(ctx context.Context, q queryRower, code, customerID, excludeOrderID string) (orders.PromoUsage, error) {
+	var u orders.PromoUsage
+	err := q.QueryRowContext(ctx, `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE customer_id::text = $2)
+		FROM promo_redemptions
+		WHERE code = $1 AND order_id::text <> $3`,
+		code, customerID, excludeOrderID,
+	).Scan(&u.Total, &u.ByCustomer)
+	if err != nil {
+		return orders.PromoUsage{}, fmt.Errorf("failed to count redemptions of promo %s: %w", code, err)
+	}
+	return u, nil
+}
+
+func (r *PostgresPromoRepository) Redeem(ctx context.Context, red orders.Redemption, p *orders.Promo) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Блокировка строки промокода сериализует конкурентные применения одного кода
+	if _, err := tx.ExecContext(ctx, `SELECT 1 FROM promos WHERE code = $1 FOR UPDATE`, red.Code); err != nil {
+		return fmt.Errorf("failed to lock promo %s: %w", red.Code, err)
+	}
+
+	usage, err := countRedemptions(ctx, tx, red.Code, red.CustomerID, red.OrderID)
+	if err != nil {
+		return err
+	}
+	if err := p.CheckUsage(usage); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO promo_redemptions (order_id, code, customer_id, discount, redeemed_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (order_id) DO UPDATE SET
+			code = EXCLUDED.code,
+			discount = EXCLUDED.discount,
+			redeemed_at = EXCLUDED.redeemed_at`,
+		red.OrderID, red.Code, red.CustomerID, red.Discount, red.RedeemedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record redemption of promo %s: %w", red.Code, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit redemption of promo %s: %w", red.Code, err)
+	}
+	return nil
+}
+
+func (r *PostgresPromoRepository) Release(ctx context.Context, orderID string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM promo_redemptions WHERE order_id = $1`, orderID); err != nil {
+		return fmt.Errorf("failed to release promo of order %s: %w", orderID, err)
+	}
+	return nil
+}
+
+func queryStrings(ctx context.Context, db *sql.DB, query string, args ...any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, rows.Err()
+}
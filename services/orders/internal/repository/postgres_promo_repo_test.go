@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestPostgresPromoRepository_SaveAndFind(t *testing.T) {
+	repo := NewPostgresPromoRepository(openTestDB(t))
+	ctx := context.Background()
+
+	productID := uuid.NewString()
+	promo := &orders.Promo{
+		Code:          "SPRING",
+		Type:          orders.PromoPercentage,
+		Percent:       decimal.NewFromInt(15),
+		MaxDiscount:   common.NewMoney(300),
+		ValidFrom:     time.Now().Add(-time.Hour).UTC().Truncate(time.Second),
+		Active:        true,
+		MinOrderValue: common.NewMoney(1000),
+		ProductIDs:    []string{productID},
+		CategoryIDs:   []int{1, 3},
+		UsageLimit:    100,
+	}
+	if err := repo.Create(ctx, promo); err != nil {
+		t.Fatalf("failed to save: %v", err)
+	}
+	if err := repo.Create(ctx, &orders.Promo{Code: "SPRING", Type: orders.PromoFreeDelivery}); !errors.Is(err, orders.ErrPromoExists) {
+		t.Fatalf("expected ErrPromoExists, got %v", err)
+	}
+
+	loaded, err := repo.FindByCode(ctx, "SPRING")
+	if err != nil {
+		t.Fatalf("failed to find: %v", err)
+	}
+	if loaded.Type != orders.PromoPercentage || !loaded.Percent.Equal(promo.Percent) ||
+		!loaded.ValidTo.IsZero() || loaded.UsageLimit != 100 {
+		t.Errorf("unexpected promo: %+v", loaded)
+	}
+	if len(loaded.ProductIDs) != 1 || loaded.ProductIDs[0] != productID || len(loaded.CategoryIDs) != 2 {
+		t.Errorf("restrictions are not restored: %v %v", loaded.ProductIDs, loaded.CategoryIDs)
+	}
+
+	promo.UsageLimit = 10
+	promo.CategoryIDs = nil
+	if err := repo.Update(ctx, promo); err != nil {
+		t.Fatalf("failed to update: %v", err)
+	}
+	loaded, err = repo.FindByCode(ctx, "SPRING")
+	if err != nil {
+		t.Fatalf("failed to find: %v", err)
+	}
+	if loaded.UsageLimit != 10 || len(loaded.CategoryIDs) != 0 || len(loaded.ProductIDs) != 1 {
+		t.Errorf("update is not applied: %+v", loaded)
+	}
+
+	if _, err := repo.FindByCode(ctx, "MISSING"); !errors.Is(err, orders.ErrPromoNotFound) {
+		t.Errorf("expected ErrPromoNotFound, got %v", err)
+	}
+	if err := repo.Update(ctx, &orders.Promo{Code: "MISSING"}); !errors.Is(err, orders.ErrPromoNotFound) {
+		t.Errorf("expected ErrPromoNotFound on update, got %v", err)
+	}
+}
+
+func TestPostgresPromoRepository_RedeemLimits(t *testing.T) {
+	db := openTestDB(t)
+	orderRepo := NewPostgresOrderRepository(db)
+	repo := NewPostgresPromoRepository(db)
+	ctx := context.Background()
+
+	promo := &orders.Promo{
+		Code:             "ONCE",
+		Type:             orders.PromoFreeDelivery,
+		ValidFrom:        time.Now().Add(-time.Hour),
+		Active:           true,
+		PerCustomerLimit: 1,
+	}
+	if err := repo.Create(ctx, promo); err != nil {
+		t.Fatalf("failed to save promo: %v", err)
+	}
+
+	first, second := newTestOrder(t), newTestOrder(t)
+	// Оба заказа от одного клиента
+	second = orders.RestoreOrder(orders.OrderSnapshot{
+		ID:          second.ID(),
+		OrderNumber: second.OrderNumber(),
+		CustomerID:  first.CustomerID(),
+		Status:      orders.StatusCreated,
+		CreatedAt:   second.CreatedAt(),
+		Address:     second.Address(),
+	})
+	for _, o := range []*orders.Order{first, second} {
+		if err := orderRepo.Save(ctx, o); err != nil {
+			t.Fatalf("failed to save order: %v", err)
+		}
+	}
+
+	redeem := func(o *orders.Order) error {
+		return repo.Redeem(ctx, orders.Redemption{
+			Code:       promo.Code,
+			OrderID:    o.ID(),
+			CustomerID: o.CustomerID(),
+			Discount:   common.NewMoney(150),
+			RedeemedAt: time.Now(),
+		}, promo)
+	}
+
+	if err := redeem(first); err != nil {
+		t.Fatalf("failed to redeem: %v", err)
+	}
+	if err := redeem(first); err != nil {
+		t.Errorf("re-redeeming for the same order should succeed, got %v", err)
+	}
+	if err := redeem(second); !errors.Is(err, orders.ErrPromoCustomerLimit) {
+		t.Errorf("expected ErrPromoCustomerLimit, got %v", err)
+	}
+
+	usage, err := repo.Usage(ctx, promo.Code, first.CustomerID(), second.ID())
+	if err != nil {
+		t.Fatalf("failed to count usage: %v", err)
+	}
+	if usage.Total != 1 || usage.ByCustomer != 1 {
+		t.Errorf("unexpected usage: %+v", usage)
+	}
+
+	if err := repo.Release(ctx, first.ID()); err != nil {
+		t.Fatalf("failed to release: %v", err)
+	}
+	if err := redeem(second); err != nil {
+		t.Errorf("expected redemption after release, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type PostgresSagaRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresSagaRepository(db *sql.DB) orders.SagaRepository {
+	return &PostgresSagaRepository{db: db}
+}
+
+const sagaColumns = `order_id, state, payment_id, deadline, cancel_reason,
+	failure_reason, ticket_canceled, payment_settled, created_at, updated_at, amount`
+
+func (r *PostgresSagaRepository) Save(ctx context.Context, s *orders.OrderSaga) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO order_sagas (`+sagaColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (order_id) DO UPDATE SET
+			state = EXCLUDED.state,
+			payment_id = EXCLUDED.payment_id,
+			deadline = EXCLUDED.deadline,
+			cancel_reason = EXCLUDED.cancel_reason,
+			failure_reason = EXCLUDED.failure_reason,
+			ticket_canceled = EXCLUDED.ticket_canceled,
+			payment_settled = EXCLUDED.payment_settled,
+			updated_at = EXCLUDED.updated_at,
+			amount = EXCLUDED.amount`,
+		s.OrderID, string(s.State), s.PaymentID,
+		sql.NullTime{Time: s.Deadline, Valid: !s.Deadline.IsZero()},
+		string(s.CancelReason), s.FailureReason, s.TicketCanceled, s.PaymentSettled,
+		s.CreatedAt, s.UpdatedAt, s.Amount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save saga of order %s: %w", s.OrderID, err)
+	}
+
+	// Тикеты только добавляются, поэтому достаточно дописать новые
+	for _, t := range s.Tickets {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO order_saga_tickets (order_id, store_id, ticket_id)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (order_id, store_id) DO UPDATE SET ticket_id = EXCLUDED.ticket_id`,
+			s.OrderID, t.StoreID, t.TicketID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save ticket %s of order %s: %w", t.TicketID, s.OrderID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit saga of order %s: %w", s.OrderID, err)
+	}
+	return nil
+}
+
+func (r *PostgresSagaRepository) FindByOrderID(ctx context.Context, orderID string) (*orders.OrderSaga, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+sagaColumns+` FROM order_sagas WHERE order_id = $1`, orderID)
+	s, err := scanSaga(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", orders.ErrSagaNotFound, orderID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load saga of order %s: %w", orderID, err)
+	}
+	if err := r.loadTickets(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (r *PostgresSagaRepository) ListActive(ctx context.Context) ([]*orders.OrderSaga, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+sagaColumns+` FROM order_sagas
+		WHERE state NOT IN ($1, $2, $3)
+		ORDER BY created_at`,
+		string(orders.SagaCompleted), string(orders.SagaCompensated), string(orders.SagaFailed),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active sagas: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*orders.OrderSaga
+	for rows.Next() {
+		s, err := scanSaga(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saga: %w", err)
+		}
+		res = append(res, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate active sagas: %w", err)
+	}
+	_ = rows.Close()
+
+	for _, s := range res {
+		if err := r.loadTickets(ctx, s); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (r *PostgresSagaRepository) loadTickets(ctx context.Context, s *orders.OrderSaga) error {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT store_id, ticket_id FROM order_saga_tickets
+		WHERE order_id = $1
+		ORDER BY store_id`, s.OrderID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load tickets of order %s: %w", s.OrderID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var t orders.SagaTicket
+		if err := rows.Scan(&t.StoreID, &t.TicketID); err != nil {
+			return fmt.Errorf("failed to scan ticket of order %s: %w", s.OrderID, err)
+		}
+		s.Tickets = append(s.Tickets, t)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to iterate tickets of order %s: %w", s.OrderID, err)
+	}
+	return nil
+}
+
+func scanSaga(row rowScanner) (*orders.OrderSaga, error) {
+	var (
+		s                   orders.OrderSaga
+		state, cancelReason string
+		deadline            sql.NullTime
+	)
+	err := row.Scan(&s.OrderID, &state, &s.PaymentID, &deadline, &cancelReason,
+		&s.FailureReason, &s.TicketCanceled, &s.PaymentSettled, &s.CreatedAt, &s.UpdatedAt, &s.Amount)
+	if err != nil {
+		return nil, err
+	}
+	s.State = orders.SagaState(state)
+	s.CancelReason = orders.CancelReason(cancelReason)
+	s.Deadline = deadline.Time
+	return &s, nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestPostgresSagaRepository_SaveAndListActive(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewPostgresOrderRepository(db)
+	sagas := NewPostgresSagaRepository(db)
+	ctx := context.Background()
+
+	if _, err := sagas.FindByOrderID(ctx, "0190a5c0-0000-7000-8000-000000000000"); !errors.Is(err, orders.ErrSagaNotFound) {
+		t.Fatalf("expected ErrSagaNotFound, got %v", err)
+	}
+
+	order := newTestOrder(t)
+	if err := repo.Save(ctx, order); err != nil {
+		t.Fatalf("failed to save order: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	saga := orders.NewOrderSaga(order.ID(), "payment-1", now, 15*time.Minute)
+	saga.Amount = common.NewMoney(1250.5)
+	if err := sagas.Save(ctx, saga); err != nil {
+		t.Fatalf("failed to save saga: %v", err)
+	}
+
+	active, err := sagas.ListActive(ctx)
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(active) != 1 || active[0].PaymentID != "payment-1" || !active[0].Deadline.Equal(saga.Deadline) ||
+		!active[0].Amount.Equal(saga.Amount) {
+		t.Fatalf("expected saved saga to be active, got %+v", active)
+	}
+
+	saga.AddTicket("store-1", "ticket-1")
+	saga.AddTicket("store-2", "ticket-2")
+	saga.Compensate(orders.CancelReasonKitchenFailure, "kitchen timed out", now)
+	saga.MoveTo(orders.SagaCompensated, now, 0)
+	if err := sagas.Save(ctx, saga); err != nil {
+		t.Fatalf("failed to update saga: %v", err)
+	}
+
+	got, err := sagas.FindByOrderID(ctx, order.ID())
+	if err != nil {
+		t.Fatalf("failed to find: %v", err)
+	}
+	if got.State != orders.SagaCompensated || got.CancelReason != orders.CancelReasonKitchenFailure ||
+		len(got.Tickets) != 2 || got.Tickets[1].TicketID != "ticket-2" || !got.Deadline.IsZero() {
+		t.Errorf("unexpected saga: %+v", got)
+	}
+	if active, _ := sagas.ListActive(ctx); len(active) != 0 {
+		t.Errorf("expected no active sagas, got %d", len(active))
+	}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type PostgresSlotBookings struct {
+	db *sql.DB
+}
+
+func NewPostgresSlotBookings(db *sql.DB) orders.SlotBookings {
+	return &PostgresSlotBookings{db: db}
+}
+
+// Book - проверка вместимости и вставка идут под advisory-блокировкой слота,
+// поэтому параллельные оформления не занимают больше мест, чем есть.
+func (r *PostgresSlotBookings) Book(ctx context.Context, b orders.SlotBooking, capacity int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	slot := b.From.UTC().Format(time.RFC3339)
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('slot:' || $1))`, slot); err != nil {
+		return fmt.Errorf("failed to lock slot %s: %w", slot, err)
+	}
+
+	var booked bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM slot_bookings WHERE order_id = $1)`, b.OrderID).Scan(&booked)
+	if err != nil {
+		return fmt.Errorf("failed to check booking of order %s: %w", b.OrderID, err)
+	}
+	if booked {
+		return nil
+	}
+
+	if capacity > 0 {
+		var taken int
+		err = tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM slot_bookings WHERE deliver_at >= $1 AND deliver_at < $2`,
+			b.From, b.To,
+		).Scan(&taken)
+		if err != nil {
+			return fmt.Errorf("failed to count bookings of slot %s: %w", slot, err)
+		}
+		if taken >= capacity {
+			return orders.ErrSlotFull
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO slot_bookings (order_id, deliver_at) VALUES ($1, $2)`,
+		b.OrderID, b.DeliverAt,
+	); err != nil {
+		return fmt.Errorf("failed to book slot %s for order %s: %w", slot, b.OrderID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit booking of order %s: %w", b.OrderID, err)
+	}
+	return nil
+}
+
+func (r *PostgresSlotBookings) Release(ctx context.Context, orderID string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM slot_bookings WHERE order_id = $1`, orderID); err != nil {
+		return fmt.Errorf("failed to release slot of order %s: %w", orderID, err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestPostgresSlotBookings_ConcurrentBook(t *testing.T) {
+	db := openTestDB(t)
+	orderRepo := NewPostgresOrderRepository(db)
+	slots := NewPostgresSlotBookings(db)
+	ctx := context.Background()
+
+	from := time.Now().Add(3 * time.Hour).UTC().Truncate(30 * time.Minute)
+	bookings := make([]orders.SlotBooking, 5)
+	for i := range bookings {
+		order := newTestOrder(t)
+		if err := orderRepo.Save(ctx, order); err != nil {
+			t.Fatalf("failed to save order: %v", err)
+		}
+		bookings[i] = orders.SlotBooking{OrderID: order.ID(), DeliverAt: from.Add(10 * time.Minute), From: from, To: from.Add(30 * time.Minute)}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		full int
+	)
+	for _, b := range bookings {
+		wg.Add(1)
+		go func(b orders.SlotBooking) {
+			defer wg.Done()
+			err := slots.Book(ctx, b, 2)
+			if err != nil && !errors.Is(err, orders.ErrSlotFull) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err != nil {
+				mu.Lock()
+				full++
+				mu.Unlock()
+			}
+		}(b)
+	}
+	wg.Wait()
+	if full != len(bookings)-2 {
+		t.Fatalf("expected exactly 2 bookings, %d rejected", full)
+	}
+
+	// Освобожденное место можно занять снова, повторная бронь не занимает второе
+	var booked, free orders.SlotBooking
+	for _, b := range bookings {
+		if err := slots.Book(ctx, b, 2); err == nil && booked.OrderID == "" {
+			booked = b
+		} else if err != nil {
+			free = b
+		}
+	}
+	if err := slots.Release(ctx, booked.OrderID); err != nil {
+		t.Fatalf("failed to release: %v", err)
+	}
+	if err := slots.Book(ctx, free, 2); err != nil {
+		t.Errorf("expected released place to be available, got %v", err)
+	}
+}
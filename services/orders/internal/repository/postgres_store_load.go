@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type PostgresStoreLoad struct {
+	db *sql.DB
+}
+
+func NewPostgresStoreLoad(db *sql.DB) orders.StoreLoad {
+	return &PostgresStoreLoad{db: db}
+}
+
+func (l *PostgresStoreLoad) ActiveOrdersByStore(ctx context.Context) (map[string]int, error) {
+	rows, err := l.db.QueryContext(ctx, `
+		SELECT i.store_id, COUNT(DISTINCT i.order_id)
+		FROM order_items i
+		JOIN orders o ON o.id = i.order_id
+		WHERE o.status IN ($1, $2) AND i.store_id <> ''
+		GROUP BY i.store_id`,
+		int(orders.StatusPaid), int(orders.StatusCooking),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load store utilization: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	load := make(map[string]int)
+	for rows.Next() {
+		var (
+			storeID string
+			active  int
+		)
+		if err := rows.Scan(&storeID, &active); err != nil {
+			return nil, fmt.Errorf("failed to scan store utilization: %w", err)
+		}
+		load[storeID] = active
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate store utilization: %w", err)
+	}
+	return load, nil
+}
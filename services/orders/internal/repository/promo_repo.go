@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type InMemoryPromoRepository struct {
+	mu          sync.Mutex
+	promos      map[string]*orders.Promo
+	redemptions map[string]orders.Redemption // по ID заказа
+}
+
+func NewInMemoryPromoRepository() orders.PromoRepository {
+	return &InMemoryPromoRepository{
+		promos:      make(map[string]*orders.Promo),
+		redemptions: make(map[string]orders.Redemption),
+	}
+}
+
+func (r *InMemoryPromoRepository) Create(ctx context.Context, p *orders.Promo) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.promos[p.Code]; ok {
+		return orders.ErrPromoExists
+	}
+	cp := *p
+	r.promos[p.Code] = &cp
+	return nil
+}
+
+func (r *InMemoryPromoRepository) Update(ctx context.Context, p *orders.Promo) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.promos[p.Code]; !ok {
+		return orders.ErrPromoNotFound
+	}
+	cp := *p
+	r.promos[p.Code] = &cp
+	return nil
+}
+
+func (r *InMemoryPromoRepository) FindByCode(ctx context.Context, code string) (*orders.Promo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.promos[code]
+	if !ok {
+		return nil, orders.ErrPromoNotFound
+	}
+	cp := *p
+	return &cp, nil
+}
+
+func (r *InMemoryPromoRepository) Usage(ctx context.Context, code, customerID, excludeOrderID string) (orders.PromoUsage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.usage(code, customerID, excludeOrderID), nil
+}
+
+func (r *InMemoryPromoRepository) Redeem(ctx context.Context, red orders.Redemption, p *orders.Promo) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := p.CheckUsage(r.usage(red.Code, red.CustomerID, red.OrderID)); err != nil {
+		return err
+	}
+
+	r.redemptions[red.OrderID] = red
+	return nil
+}
+
+func (r *InMemoryPromoRepository) usage(code, customerID, excludeOrderID string) orders.PromoUsage {
+	var u orders.PromoUsage
+	for orderID, existing := range r.redemptions {
+		if existing.Code != code || orderID == excludeOrderID {
+			continue
+		}
+		u.Total++
+		if existing.CustomerID == customerID {
+			u.ByCustomer++
+		}
+	}
+	return u
+}
+
+func (r *InMemoryPromoRepository) Release(ctx context.Context, orderID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.redemptions, orderID)
+	return nil
+}
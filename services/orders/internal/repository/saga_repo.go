@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type InMemorySagaRepository struct {
+	mu    sync.RWMutex
+	sagas map[string]orders.OrderSaga
+}
+
+func NewInMemorySagaRepository() orders.SagaRepository {
+	return &InMemorySagaRepository{sagas: make(map[string]orders.OrderSaga)}
+}
+
+func (r *InMemorySagaRepository) Save(ctx context.Context, s *orders.OrderSaga) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := *s
+	saved.Tickets = append([]orders.SagaTicket(nil), s.Tickets...)
+	r.sagas[s.OrderID] = saved
+	return nil
+}
+
+func (r *InMemorySagaRepository) FindByOrderID(ctx context.Context, orderID string) (*orders.OrderSaga, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.sagas[orderID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", orders.ErrSagaNotFound, orderID)
+	}
+	s.Tickets = append([]orders.SagaTicket(nil), s.Tickets...)
+	return &s, nil
+}
+
+func (r *InMemorySagaRepository) ListActive(ctx context.Context) ([]*orders.OrderSaga, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var res []*orders.OrderSaga
+	for _, s := range r.sagas {
+		if s.Active() {
+			s := s
+			s.Tickets = append([]orders.SagaTicket(nil), s.Tickets...)
+			res = append(res, &s)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].CreatedAt.Before(res[j].CreatedAt) })
+	return res, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type InMemorySlotBookings struct {
+	mu       sync.Mutex
+	bookings map[string]time.Time // время доставки по ID заказа
+}
+
+func NewInMemorySlotBookings() orders.SlotBookings {
+	return &InMemorySlotBookings{bookings: make(map[string]time.Time)}
+}
+
+func (r *InMemorySlotBookings) Book(ctx context.Context, b orders.SlotBooking, capacity int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.bookings[b.OrderID]; ok {
+		return nil
+	}
+	if capacity > 0 {
+		taken := 0
+		for _, at := range r.bookings {
+			if !at.Before(b.From) && at.Before(b.To) {
+				taken++
+			}
+		}
+		if taken >= capacity {
+			return orders.ErrSlotFull
+		}
+	}
+	r.bookings[b.OrderID] = b.DeliverAt
+	return nil
+}
+
+func (r *InMemorySlotBookings) Release(ctx context.Context, orderID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.bookings, orderID)
+	return nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// OrderStoreLoad - загрузка точек по заказам из OrderRepository, для хранилища в памяти.
+type OrderStoreLoad struct {
+	repo orders.OrderRepository
+}
+
+func NewOrderStoreLoad(repo orders.OrderRepository) orders.StoreLoad {
+	return &OrderStoreLoad{repo: repo}
+}
+
+func (l *OrderStoreLoad) ActiveOrdersByStore(ctx context.Context) (map[string]int, error) {
+	load := make(map[string]int)
+	for _, status := range []orders.OrderStatus{orders.StatusPaid, orders.StatusCooking} {
+		list, err := l.repo.List(ctx, orders.OrderFilter{Status: &status})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s orders: %w", status, err)
+		}
+		// Разделенный заказ загружает каждую из своих точек
+		for _, o := range list {
+			for _, id := range o.StoreIDs() {
+				load[id]++
+			}
+		}
+	}
+	return load, nil
+}
@@ -0,0 +1,39 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// IdempotencyPurger - часть IdempotencyUseCase, нужная планировщику.
+type IdempotencyPurger interface {
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// IdempotencyScheduler - периодически удаляет ключи идемпотентности с истекшим TTL.
+type IdempotencyScheduler struct {
+	runner
+	purger IdempotencyPurger
+	logger *zap.Logger
+}
+
+func NewIdempotencyScheduler(purger IdempotencyPurger, interval time.Duration, logger *zap.Logger) *IdempotencyScheduler {
+	return &IdempotencyScheduler{runner: runner{interval: interval}, purger: purger, logger: logger}
+}
+
+func (s *IdempotencyScheduler) Start() {
+	s.start(s.Tick)
+}
+
+// Tick - один проход очистки.
+func (s *IdempotencyScheduler) Tick(ctx context.Context, now time.Time) {
+	purged, err := s.purger.PurgeExpired(ctx, now)
+	if purged > 0 {
+		s.logger.Debug("Purged expired idempotency keys", zap.Int("count", purged))
+	}
+	if err != nil && ctx.Err() == nil {
+		s.logger.Warn("Failed to purge idempotency keys", zap.Error(err))
+	}
+}
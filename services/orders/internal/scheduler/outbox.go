@@ -0,0 +1,39 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// EventRelayer - часть OutboxRelay, нужная планировщику.
+type EventRelayer interface {
+	RelayPending(ctx context.Context, now time.Time) (int, error)
+}
+
+// OutboxScheduler - периодически публикует события из outbox.
+type OutboxScheduler struct {
+	runner
+	relayer EventRelayer
+	logger  *zap.Logger
+}
+
+func NewOutboxScheduler(relayer EventRelayer, interval time.Duration, logger *zap.Logger) *OutboxScheduler {
+	return &OutboxScheduler{runner: runner{interval: interval}, relayer: relayer, logger: logger}
+}
+
+func (s *OutboxScheduler) Start() {
+	s.start(s.Tick)
+}
+
+// Tick - один проход relay.
+func (s *OutboxScheduler) Tick(ctx context.Context, now time.Time) {
+	published, err := s.relayer.RelayPending(ctx, now)
+	if published > 0 {
+		s.logger.Debug("Published outbox events", zap.Int("count", published))
+	}
+	if err != nil && ctx.Err() == nil {
+		s.logger.Warn("Failed to relay outbox events", zap.Error(err))
+	}
+}
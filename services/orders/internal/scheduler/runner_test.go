@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type countingAdvancer struct {
+	calls atomic.Int32
+}
+
+func (a *countingAdvancer) Advance(ctx context.Context, now time.Time) (int, error) {
+	a.calls.Add(1)
+	return 0, nil
+}
+
+func TestSagaScheduler_StartStop(t *testing.T) {
+	advancer := &countingAdvancer{}
+	s := NewSagaScheduler(advancer, time.Millisecond, zap.NewNop())
+
+	s.Start()
+	deadline := time.Now().Add(time.Second)
+	for advancer.calls.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.Stop(ctx); err != nil {
+		t.Fatalf("failed to stop: %v", err)
+	}
+	if advancer.calls.Load() < 2 {
+		t.Errorf("expected periodic passes, got %d", advancer.calls.Load())
+	}
+
+	calls := advancer.calls.Load()
+	time.Sleep(10 * time.Millisecond)
+	if advancer.calls.Load() != calls {
+		t.Error("scheduler kept running after Stop")
+	}
+}
@@ -0,0 +1,39 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SagaAdvancer - часть OrderSagaUseCase, нужная планировщику.
+type SagaAdvancer interface {
+	Advance(ctx context.Context, now time.Time) (int, error)
+}
+
+// SagaScheduler - периодически продвигает саги заказов.
+type SagaScheduler struct {
+	runner
+	advancer SagaAdvancer
+	logger   *zap.Logger
+}
+
+func NewSagaScheduler(advancer SagaAdvancer, interval time.Duration, logger *zap.Logger) *SagaScheduler {
+	return &SagaScheduler{runner: runner{interval: interval}, advancer: advancer, logger: logger}
+}
+
+func (s *SagaScheduler) Start() {
+	s.start(s.Tick)
+}
+
+// Tick - один проход по активным сагам.
+func (s *SagaScheduler) Tick(ctx context.Context, now time.Time) {
+	advanced, err := s.advancer.Advance(ctx, now)
+	if advanced > 0 {
+		s.logger.Info("Advanced order sagas", zap.Int("count", advanced))
+	}
+	if err != nil && ctx.Err() == nil {
+		s.logger.Warn("Failed to advance order sagas", zap.Error(err))
+	}
+}
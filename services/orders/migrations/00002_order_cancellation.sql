@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS canceled_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS cancel_reason VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS cancel_comment TEXT,
+    ADD COLUMN IF NOT EXISTS canceled_by VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS canceled_by_role VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS status_before_cancel SMALLINT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS status_before_cancel,
+    DROP COLUMN IF EXISTS canceled_by_role,
+    DROP COLUMN IF EXISTS canceled_by,
+    DROP COLUMN IF EXISTS cancel_comment,
+    DROP COLUMN IF EXISTS cancel_reason,
+    DROP COLUMN IF EXISTS canceled_at;
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS promos (
+    code VARCHAR(50) PRIMARY KEY,
+    type VARCHAR(20) NOT NULL,
+    amount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    percent DECIMAL(5,2) NOT NULL DEFAULT 0,
+    max_discount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    buy_qty INT NOT NULL DEFAULT 0,
+    free_qty INT NOT NULL DEFAULT 0,
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_to TIMESTAMPTZ,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    min_order_value DECIMAL(12,2) NOT NULL DEFAULT 0,
+    usage_limit INT NOT NULL DEFAULT 0,
+    per_customer_limit INT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS promo_products (
+    code VARCHAR(50) REFERENCES promos(code) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    PRIMARY KEY (code, product_id)
+);
+
+CREATE TABLE IF NOT EXISTS promo_categories (
+    code VARCHAR(50) REFERENCES promos(code) ON DELETE CASCADE,
+    category_id SMALLINT NOT NULL,
+    PRIMARY KEY (code, category_id)
+);
+
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    order_id UUID PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL REFERENCES promos(code),
+    customer_id UUID NOT NULL,
+    discount DECIMAL(12,2) NOT NULL,
+    redeemed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_promo_redemptions_code_customer ON promo_redemptions(code, customer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_categories;
+DROP TABLE IF EXISTS promo_products;
+DROP TABLE IF EXISTS promos;
+-- +goose StatementEnd
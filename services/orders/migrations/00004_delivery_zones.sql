@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS delivery_district VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS delivery_lat DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS delivery_lng DOUBLE PRECISION;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS delivery_lng,
+    DROP COLUMN IF EXISTS delivery_lat,
+    DROP COLUMN IF EXISTS delivery_district;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    status SMALLINT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    actor_id VARCHAR(100) NOT NULL,
+    actor_role VARCHAR(20) NOT NULL,
+    note TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history(order_id, changed_at);
+
+-- Для уже существующих заказов известен только момент создания
+INSERT INTO order_status_history (id, order_id, status, changed_at, actor_id, actor_role)
+SELECT gen_random_uuid(), id, 0, created_at, customer_id::text, 'customer'
+FROM orders;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_status_history;
+-- +goose StatementEnd
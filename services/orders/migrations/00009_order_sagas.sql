@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_sagas (
+    order_id UUID PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    state VARCHAR(20) NOT NULL,
+    payment_id VARCHAR(255) NOT NULL DEFAULT '',
+    ticket_id VARCHAR(255) NOT NULL DEFAULT '',
+    deadline TIMESTAMPTZ,
+    cancel_reason VARCHAR(50) NOT NULL DEFAULT '',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    ticket_canceled BOOLEAN NOT NULL DEFAULT FALSE,
+    payment_settled BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_sagas_active ON order_sagas(created_at)
+    WHERE state NOT IN ('completed', 'compensated', 'failed');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_sagas;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS customer_addresses (
+    id UUID PRIMARY KEY,
+    customer_id UUID NOT NULL,
+    label VARCHAR(100) NOT NULL DEFAULT '',
+
+    city VARCHAR(100) NOT NULL,
+    district VARCHAR(100),
+    street VARCHAR(255) NOT NULL,
+    house VARCHAR(20),
+    apartment VARCHAR(20),
+    floor VARCHAR(10),
+    comment TEXT,
+    lat DOUBLE PRECISION,
+    lng DOUBLE PRECISION,
+
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_addresses_customer ON customer_addresses(customer_id, created_at);
+-- У клиента не больше одного основного адреса
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customer_addresses_default ON customer_addresses(customer_id) WHERE is_default;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS customer_addresses;
+-- +goose StatementEnd
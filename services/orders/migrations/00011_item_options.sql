@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Размер и тесто хранятся целиком: множители каталога могут измениться после заказа
+ALTER TABLE order_items
+    ADD COLUMN IF NOT EXISTS size_code VARCHAR(50) NOT NULL DEFAULT 'standard',
+    ADD COLUMN IF NOT EXISTS size_name VARCHAR(100) NOT NULL DEFAULT 'Стандартный',
+    ADD COLUMN IF NOT EXISTS crust_code VARCHAR(50) NOT NULL DEFAULT 'standard',
+    ADD COLUMN IF NOT EXISTS crust_name VARCHAR(100) NOT NULL DEFAULT 'Стандартное',
+    ADD COLUMN IF NOT EXISTS crust_multiplier DOUBLE PRECISION NOT NULL DEFAULT 1.0;
+
+-- Половины пиццы "пополам", position - порядок половин
+CREATE TABLE IF NOT EXISTS order_item_halves (
+    order_item_id UUID REFERENCES order_items(id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    product_id UUID NOT NULL,
+    product_name VARCHAR(255) NOT NULL,
+    base_price DECIMAL(12,2) NOT NULL,
+    PRIMARY KEY (order_item_id, position)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_item_halves;
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS size_code,
+    DROP COLUMN IF EXISTS size_name,
+    DROP COLUMN IF EXISTS crust_code,
+    DROP COLUMN IF EXISTS crust_name,
+    DROP COLUMN IF EXISTS crust_multiplier;
+-- +goose StatementEnd
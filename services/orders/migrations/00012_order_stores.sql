@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Точка, которая готовит позицию; позиции одного заказа могут готовить разные точки
+ALTER TABLE order_items ADD COLUMN IF NOT EXISTS store_id VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_order_items_store ON order_items(store_id);
+
+-- Тикеты кухни по точкам, у разделенного заказа их несколько
+CREATE TABLE IF NOT EXISTS order_saga_tickets (
+    order_id UUID REFERENCES order_sagas(order_id) ON DELETE CASCADE,
+    store_id VARCHAR(50) NOT NULL,
+    ticket_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (order_id, store_id)
+);
+
+INSERT INTO order_saga_tickets (order_id, store_id, ticket_id)
+SELECT order_id, '', ticket_id FROM order_sagas WHERE ticket_id <> ''
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE order_sagas DROP COLUMN IF EXISTS ticket_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_sagas ADD COLUMN IF NOT EXISTS ticket_id VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE order_sagas s SET ticket_id = t.ticket_id
+FROM (SELECT DISTINCT ON (order_id) order_id, ticket_id FROM order_saga_tickets ORDER BY order_id, store_id) t
+WHERE t.order_id = s.order_id;
+
+DROP TABLE IF EXISTS order_saga_tickets;
+DROP INDEX IF EXISTS idx_order_items_store;
+ALTER TABLE order_items DROP COLUMN IF EXISTS store_id;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Версия для оптимистичной блокировки, существующие заказы уже сохранены хотя бы раз
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
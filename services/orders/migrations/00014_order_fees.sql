@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Сборы и чаевые сверх стоимости позиций и доставки, старые заказы оплачены картой без сборов
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS payment_method TEXT NOT NULL DEFAULT 'card',
+    ADD COLUMN IF NOT EXISTS packaging_fee DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS service_fee DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tip DECIMAL(12,2) NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS tip,
+    DROP COLUMN IF EXISTS service_fee,
+    DROP COLUMN IF EXISTS packaging_fee,
+    DROP COLUMN IF EXISTS payment_method;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Заказ с запущенной оплатой закрыт для правок, сага хранит сумму платежа для сверки
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS checkout_started BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE orders SET checkout_started = TRUE
+WHERE id IN (SELECT order_id FROM order_sagas);
+
+ALTER TABLE order_sagas
+    ADD COLUMN IF NOT EXISTS amount DECIMAL(12,2) NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_sagas
+    DROP COLUMN IF EXISTS amount;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS checkout_started;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Срок резерва незавершенного запроса, после него ключ можно занять повтором.
+-- Незавершенные ключи, оставшиеся до миграции, сразу считаются брошенными.
+ALTER TABLE idempotency_keys
+    ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ NOT NULL DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys
+    DROP COLUMN IF EXISTS locked_until;
+-- +goose StatementEnd
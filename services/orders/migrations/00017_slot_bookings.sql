@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Места в слотах доставки занимают только оформленные предзаказы, 6 - отмененный заказ
+CREATE TABLE IF NOT EXISTS slot_bookings (
+    order_id UUID PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    deliver_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_slot_bookings_deliver_at ON slot_bookings(deliver_at);
+
+INSERT INTO slot_bookings (order_id, deliver_at)
+SELECT id, scheduled_for FROM orders
+WHERE scheduled_for IS NOT NULL AND (checkout_started OR status > 0) AND status <> 6
+ON CONFLICT (order_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS slot_bookings;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Метка резерва: Complete и Release применяются только к резерву, который сделал тот же запрос.
+ALTER TABLE idempotency_keys
+    ADD COLUMN IF NOT EXISTS token VARCHAR(64) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_idempotency_keys_expires_at;
+ALTER TABLE idempotency_keys
+    DROP COLUMN IF EXISTS token;
+-- +goose StatementEnd
@@ -0,0 +1,176 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var (
+	ErrUnknownSize      = errors.New("size is not offered for product")
+	ErrUnknownCrust     = errors.New("crust is not offered for product")
+	ErrHalvesNotAllowed = errors.New("product cannot be ordered as a half")
+	ErrUnknownHalfPrice = errors.New("unknown half-and-half pricing")
+)
+
+// SizeOption - размер позиции, множитель берется из каталога на момент заказа.
+type SizeOption struct {
+	Code       string
+	Name       string
+	Multiplier float64
+}
+
+// CrustOption - тип теста, тоже множитель к базовой цене.
+type CrustOption struct {
+	Code       string
+	Name       string
+	Multiplier float64
+}
+
+// DefaultSize и DefaultCrust - для товаров, у которых в каталоге нет вариантов.
+var (
+	DefaultSize  = SizeOption{Code: "standard", Name: "Стандартный", Multiplier: 1}
+	DefaultCrust = CrustOption{Code: "standard", Name: "Стандартное", Multiplier: 1}
+)
+
+// PizzaHalf - половина пиццы "пополам" с ценой целой пиццы этого вида.
+type PizzaHalf struct {
+	ProductID   string
+	ProductName string
+	BasePrice   common.Money
+}
+
+// HalfPricing - как считается базовая цена пиццы из двух половин.
+type HalfPricing string
+
+const (
+	// HalfPricingMax - по более дорогой половине.
+	HalfPricingMax     HalfPricing = "max"
+	HalfPricingAverage HalfPricing = "average"
+)
+
+func ParseHalfPricing(s string) (HalfPricing, error) {
+	switch p := HalfPricing(s); p {
+	case HalfPricingMax, HalfPricingAverage:
+		return p, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownHalfPrice, s)
+}
+
+// Combine - базовая цена пиццы из половин по ценам целых пицц.
+func (p HalfPricing) Combine(a, b common.Money) common.Money {
+	if p == HalfPricingAverage {
+		return a.Add(b).Div(common.NewMoney(2)).Round(2)
+	}
+	if a.GreaterThan(b) {
+		return a
+	}
+	return b
+}
+
+// ItemOptions - размер, тесто и состав позиции. Нулевые значения - варианты по умолчанию.
+type ItemOptions struct {
+	Size  SizeOption
+	Crust CrustOption
+	// Halves - две половины пиццы "пополам", пусто для обычной позиции.
+	Halves []PizzaHalf
+}
+
+func (o ItemOptions) withDefaults() ItemOptions {
+	if o.Size.Code == "" {
+		o.Size = DefaultSize
+	}
+	if o.Crust.Code == "" {
+		o.Crust = DefaultCrust
+	}
+	o.Halves = append([]PizzaHalf(nil), o.Halves...)
+	return o
+}
+
+// ResolveSize - размер из каталога по коду. Пустой код и код DefaultSize - первый размер товара.
+func (p PricedProduct) ResolveSize(code string) (SizeOption, error) {
+	for _, s := range p.Sizes {
+		if s.Code == code {
+			return s, nil
+		}
+	}
+	if code == "" || code == DefaultSize.Code {
+		if len(p.Sizes) == 0 {
+			return DefaultSize, nil
+		}
+		return p.Sizes[0], nil
+	}
+	return SizeOption{}, fmt.Errorf("%w: %s for %s", ErrUnknownSize, code, p.Name)
+}
+
+// ResolveCrust - тесто из каталога по коду. Пустой код и код DefaultCrust - первое тесто товара.
+func (p PricedProduct) ResolveCrust(code string) (CrustOption, error) {
+	for _, c := range p.Crusts {
+		if c.Code == code {
+			return c, nil
+		}
+	}
+	if code == "" || code == DefaultCrust.Code {
+		if len(p.Crusts) == 0 {
+			return DefaultCrust, nil
+		}
+		return p.Crusts[0], nil
+	}
+	return CrustOption{}, fmt.Errorf("%w: %s for %s", ErrUnknownCrust, code, p.Name)
+}
+
+// CombineHalves - товар "пополам" из двух половин. Доступны только размеры и тесто,
+// которые есть у обеих половин (множители берутся у первой), топпинги - любой из половин.
+func CombineHalves(a, b PricedProduct, pricing HalfPricing) (PricedProduct, []PizzaHalf, error) {
+	for _, p := range []PricedProduct{a, b} {
+		if !p.HalvesAllowed {
+			return PricedProduct{}, nil, fmt.Errorf("%w: %s", ErrHalvesNotAllowed, p.Name)
+		}
+	}
+
+	combined := PricedProduct{
+		ProductID:   a.ProductID,
+		Name:        a.Name + " / " + b.Name,
+		BasePrice:   pricing.Combine(a.BasePrice, b.BasePrice),
+		IsAvailable: a.IsAvailable && b.IsAvailable,
+		CategoryID:  a.CategoryID,
+	}
+	for _, s := range a.Sizes {
+		for _, other := range b.Sizes {
+			if s.Code == other.Code {
+				combined.Sizes = append(combined.Sizes, s)
+			}
+		}
+	}
+	for _, c := range a.Crusts {
+		for _, other := range b.Crusts {
+			if c.Code == other.Code {
+				combined.Crusts = append(combined.Crusts, c)
+			}
+		}
+	}
+	if len(combined.Sizes) == 0 && (len(a.Sizes) > 0 || len(b.Sizes) > 0) {
+		return PricedProduct{}, nil, fmt.Errorf("%w: no common size for %s", ErrUnknownSize, combined.Name)
+	}
+	if len(combined.Crusts) == 0 && (len(a.Crusts) > 0 || len(b.Crusts) > 0) {
+		return PricedProduct{}, nil, fmt.Errorf("%w: no common crust for %s", ErrUnknownCrust, combined.Name)
+	}
+	// Лимит порций - более строгий из лимитов половин
+	combined.MaxToppings = a.MaxToppings
+	if b.MaxToppings > 0 && (combined.MaxToppings == 0 || b.MaxToppings < combined.MaxToppings) {
+		combined.MaxToppings = b.MaxToppings
+	}
+	combined.AllowedToppings = append([]AllowedTopping(nil), a.AllowedToppings...)
+	for _, t := range b.AllowedToppings {
+		if _, ok := a.findTopping(t.Name); !ok {
+			combined.AllowedToppings = append(combined.AllowedToppings, t)
+		}
+	}
+
+	halves := []PizzaHalf{
+		{ProductID: a.ProductID, ProductName: a.Name, BasePrice: a.BasePrice},
+		{ProductID: b.ProductID, ProductName: b.Name, BasePrice: b.BasePrice},
+	}
+	return combined, halves, nil
+}
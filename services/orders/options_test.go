@@ -0,0 +1,108 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func pizza(id, name string, price float64) PricedProduct {
+	return PricedProduct{
+		ProductID:     id,
+		Name:          name,
+		BasePrice:     common.NewMoney(price),
+		IsAvailable:   true,
+		HalvesAllowed: true,
+		Sizes: []SizeOption{
+			{Code: "25", Name: "25 см", Multiplier: 1},
+			{Code: "35", Name: "35 см", Multiplier: 1.5},
+		},
+		Crusts: []CrustOption{{Code: "classic", Name: "Традиционное", Multiplier: 1}},
+	}
+}
+
+func TestPricedProduct_ResolveSize(t *testing.T) {
+	p := pizza("p1", "Pepperoni", 500)
+
+	if s, err := p.ResolveSize(""); err != nil || s.Code != "25" {
+		t.Errorf("expected first size by default, got %+v %v", s, err)
+	}
+	if s, err := p.ResolveSize("35"); err != nil || s.Multiplier != 1.5 {
+		t.Errorf("expected 35 size, got %+v %v", s, err)
+	}
+	if _, err := p.ResolveSize("40"); !errors.Is(err, ErrUnknownSize) {
+		t.Errorf("expected ErrUnknownSize, got %v", err)
+	}
+
+	cola := PricedProduct{ProductID: "p2", Name: "Cola"}
+	if s, err := cola.ResolveSize(""); err != nil || s != DefaultSize {
+		t.Errorf("expected default size for product without sizes, got %+v %v", s, err)
+	}
+	if c, err := cola.ResolveCrust(DefaultCrust.Code); err != nil || c != DefaultCrust {
+		t.Errorf("expected default crust, got %+v %v", c, err)
+	}
+}
+
+func TestOrderItem_SizeAndCrustPrice(t *testing.T) {
+	order := NewOrder("c1", DeliveryAddress{})
+	opts := ItemOptions{
+		Size:  SizeOption{Code: "35", Name: "35 см", Multiplier: 1.5},
+		Crust: CrustOption{Code: "thin", Name: "Тонкое", Multiplier: 1.1},
+	}
+	if err := order.AddItem("p1", "Pepperoni", 1, common.NewMoney(500), opts, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 500 * 1.5 * 1.1
+	if total := order.Items()[0].CalculateTotal(); !total.Equal(common.NewMoney(825)) {
+		t.Errorf("expected 825, got %v", total)
+	}
+}
+
+func TestCombineHalves(t *testing.T) {
+	a := pizza("p1", "Pepperoni", 600)
+	b := pizza("p2", "Margherita", 450)
+	b.Sizes = b.Sizes[:1]
+	a.AllowedToppings = []AllowedTopping{{Name: "Cheese", Price: common.NewMoney(50)}}
+	b.AllowedToppings = []AllowedTopping{{Name: "Cheese", Price: common.NewMoney(70)}, {Name: "Basil", Price: common.NewMoney(20)}}
+	a.MaxToppings, b.MaxToppings = 0, 4
+
+	combined, halves, err := CombineHalves(a, b, HalfPricingMax)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if combined.Name != "Pepperoni / Margherita" || !combined.BasePrice.Equal(common.NewMoney(600)) {
+		t.Errorf("unexpected combined product: %s %v", combined.Name, combined.BasePrice)
+	}
+	if len(combined.Sizes) != 1 || combined.Sizes[0].Code != "25" {
+		t.Errorf("expected only common sizes, got %+v", combined.Sizes)
+	}
+	if len(combined.AllowedToppings) != 2 || !combined.AllowedToppings[0].Price.Equal(common.NewMoney(50)) {
+		t.Errorf("expected toppings of both halves, got %+v", combined.AllowedToppings)
+	}
+	if combined.MaxToppings != 4 {
+		t.Errorf("expected the stricter topping limit, got %d", combined.MaxToppings)
+	}
+	if len(halves) != 2 || halves[1].ProductID != "p2" {
+		t.Errorf("unexpected halves: %+v", halves)
+	}
+
+	if combined, _, _ := CombineHalves(a, b, HalfPricingAverage); !combined.BasePrice.Equal(common.NewMoney(525)) {
+		t.Errorf("expected average price 525, got %v", combined.BasePrice)
+	}
+
+	cola := PricedProduct{ProductID: "p3", Name: "Cola", IsAvailable: true}
+	if _, _, err := CombineHalves(a, cola, HalfPricingMax); !errors.Is(err, ErrHalvesNotAllowed) {
+		t.Errorf("expected ErrHalvesNotAllowed, got %v", err)
+	}
+}
+
+func TestParseHalfPricing(t *testing.T) {
+	if p, err := ParseHalfPricing("average"); err != nil || p != HalfPricingAverage {
+		t.Errorf("expected average, got %s %v", p, err)
+	}
+	if _, err := ParseHalfPricing("min"); !errors.Is(err, ErrUnknownHalfPrice) {
+		t.Errorf("expected ErrUnknownHalfPrice, got %v", err)
+	}
+}
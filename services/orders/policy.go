@@ -0,0 +1,42 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTooManyItems    = errors.New("order has too many items")
+	ErrCustomerBlocked = errors.New("customer is not allowed to place orders")
+)
+
+// OrderPolicy - ограничения на оформление заказа. Минимальная сумма заказа задается
+// зоной доставки, часы работы - расписанием заведения.
+type OrderPolicy struct {
+	// MaxItems - максимум единиц товара в заказе, 0 - без ограничения.
+	MaxItems int
+	// BlockedCustomers - клиенты, которым запрещено оформлять заказы.
+	BlockedCustomers map[string]bool
+}
+
+func (p *OrderPolicy) CheckCustomer(customerID string) error {
+	if p.BlockedCustomers[customerID] {
+		return fmt.Errorf("%w: %s", ErrCustomerBlocked, customerID)
+	}
+	return nil
+}
+
+// CheckItems - количество считается по единицам, а не по строкам заказа.
+func (p *OrderPolicy) CheckItems(o *Order) error {
+	if p.MaxItems <= 0 {
+		return nil
+	}
+	count := 0
+	for _, item := range o.Items() {
+		count += item.Quantity()
+	}
+	if count > p.MaxItems {
+		return fmt.Errorf("%w: %d of %d allowed", ErrTooManyItems, count, p.MaxItems)
+	}
+	return nil
+}
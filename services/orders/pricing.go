@@ -0,0 +1,90 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var (
+	ErrUnknownProduct     = errors.New("product not found in catalog")
+	ErrProductUnavailable = errors.New("product is not available")
+	ErrToppingNotAllowed  = errors.New("topping is not allowed for product")
+	ErrTooManyToppings    = errors.New("too many toppings for product")
+)
+
+// PricedProduct - актуальные цена и доступность товара из каталога.
+type PricedProduct struct {
+	ProductID   string
+	Name        string
+	BasePrice   common.Money
+	IsAvailable bool
+	CategoryID  int
+	// AllowedToppings - топпинги, которые можно добавить к товару, с их ценами.
+	AllowedToppings []AllowedTopping
+	// MaxToppings - лимит порций топпингов на позицию, 0 - без ограничения.
+	MaxToppings int
+	// Sizes и Crusts - варианты из каталога, первый используется по умолчанию.
+	Sizes  []SizeOption
+	Crusts []CrustOption
+	// HalvesAllowed - товар можно заказать половинкой пиццы "пополам".
+	HalvesAllowed bool
+}
+
+// AllowedTopping - топпинг из каталога, разрешенный для товара.
+type AllowedTopping struct {
+	Name  string
+	Price common.Money
+	// SizePrices - цена порции по коду размера, для остальных размеров действует Price.
+	SizePrices map[string]common.Money
+	// MaxCount - сколько порций можно добавить в позицию, 0 - без ограничения.
+	MaxCount int
+}
+
+// PriceFor - цена порции для размера позиции.
+func (t AllowedTopping) PriceFor(size string) common.Money {
+	if price, ok := t.SizePrices[size]; ok {
+		return price
+	}
+	return t.Price
+}
+
+// ResolveToppings - подставляет цены каталога для размера позиции вместо запрошенных
+// клиентом названий. Повтор названия - еще одна порция топпинга.
+func (p PricedProduct) ResolveToppings(names []string, size string) ([]Topping, error) {
+	if p.MaxToppings > 0 && len(names) > p.MaxToppings {
+		return nil, fmt.Errorf("%w: %d of %d for %s", ErrTooManyToppings, len(names), p.MaxToppings, p.Name)
+	}
+
+	counts := make(map[string]int, len(names))
+	result := make([]Topping, 0, len(names))
+	for _, name := range names {
+		t, ok := p.findTopping(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s for %s", ErrToppingNotAllowed, name, p.Name)
+		}
+		counts[name]++
+		if t.MaxCount > 0 && counts[name] > t.MaxCount {
+			return nil, fmt.Errorf("%w: %s more than %d for %s", ErrTooManyToppings, name, t.MaxCount, p.Name)
+		}
+		result = append(result, Topping{Name: t.Name, Price: t.PriceFor(size)})
+	}
+	return result, nil
+}
+
+func (p PricedProduct) findTopping(name string) (AllowedTopping, bool) {
+	for _, t := range p.AllowedToppings {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return AllowedTopping{}, false
+}
+
+// ProductPricer - источник цен для серверного расчета стоимости заказа.
+type ProductPricer interface {
+	// PriceProducts возвращает товары по ID. Отсутствующие в каталоге ID в результат не попадают.
+	PriceProducts(ctx context.Context, productIDs []string) (map[string]PricedProduct, error)
+}
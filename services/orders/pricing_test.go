@@ -0,0 +1,47 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestPricedProduct_ResolveToppings(t *testing.T) {
+	p := PricedProduct{
+		Name:        "Margherita",
+		MaxToppings: 3,
+		AllowedToppings: []AllowedTopping{
+			{Name: "Cheese", Price: common.NewMoney(50), SizePrices: map[string]common.Money{"35": common.NewMoney(80)}, MaxCount: 2},
+			{Name: "Basil", Price: common.NewMoney(20)},
+		},
+	}
+
+	toppings, err := p.ResolveToppings([]string{"Cheese", "Cheese", "Basil"}, "35")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(toppings) != 3 || !toppings[0].Price.Equal(common.NewMoney(80)) || !toppings[2].Price.Equal(common.NewMoney(20)) {
+		t.Errorf("unexpected toppings for size 35: %+v", toppings)
+	}
+	if toppings, _ := p.ResolveToppings([]string{"Cheese"}, "25"); !toppings[0].Price.Equal(common.NewMoney(50)) {
+		t.Errorf("expected default price for size without override, got %s", toppings[0].Price)
+	}
+
+	tests := []struct {
+		name  string
+		names []string
+		want  error
+	}{
+		{"not allowed", []string{"Ham"}, ErrToppingNotAllowed},
+		{"over topping limit", []string{"Cheese", "Cheese", "Cheese"}, ErrTooManyToppings},
+		{"over total limit", []string{"Basil", "Basil", "Basil", "Basil"}, ErrTooManyToppings},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := p.ResolveToppings(tt.names, ""); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
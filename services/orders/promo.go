@@ -0,0 +1,269 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/versoit/diploma/pkg/common"
+)
+
+type PromoType string
+
+const (
+	PromoFixedAmount  PromoType = "fixed_amount"
+	PromoPercentage   PromoType = "percentage"
+	PromoFreeDelivery PromoType = "free_delivery"
+	PromoBuyNGetM     PromoType = "buy_n_get_m"
+	PromoFreeTopping  PromoType = "free_topping"
+)
+
+var (
+	ErrInvalidPromo       = errors.New("invalid promo definition")
+	ErrPromoNotFound      = errors.New("promo code not found")
+	ErrPromoExists        = errors.New("promo code already exists")
+	ErrPromoInactive      = errors.New("promo code is not active")
+	ErrPromoExpired       = errors.New("promo code is outside its validity window")
+	ErrPromoMinOrderValue = errors.New("order value is below promo minimum")
+	ErrPromoNotApplicable = errors.New("promo code does not apply to order items")
+	ErrPromoLimitReached  = errors.New("promo code usage limit reached")
+	ErrPromoCustomerLimit = errors.New("promo code already used by customer")
+)
+
+// IsPromoRejection - ошибка означает, что промокод не подходит к заказу, а не сбой.
+func IsPromoRejection(err error) bool {
+	for _, target := range []error{
+		ErrPromoNotFound, ErrPromoInactive, ErrPromoExpired, ErrPromoMinOrderValue,
+		ErrPromoNotApplicable, ErrPromoLimitReached, ErrPromoCustomerLimit,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Promo - определение промокода.
+type Promo struct {
+	Code string
+	Type PromoType
+
+	// Amount - скидка для fixed_amount.
+	Amount common.Money
+	// Percent - процент скидки для percentage, MaxDiscount ограничивает ее сверху (ноль - без ограничения).
+	Percent     decimal.Decimal
+	MaxDiscount common.Money
+	// BuyQty и FreeQty - "купи N, получи M" для buy_n_get_m.
+	BuyQty  int
+	FreeQty int
+
+	ValidFrom time.Time
+	ValidTo   time.Time
+	Active    bool
+
+	MinOrderValue common.Money
+	// ProductIDs и CategoryIDs ограничивают позиции, на которые действует промокод.
+	ProductIDs  []string
+	CategoryIDs []int
+
+	// UsageLimit и PerCustomerLimit - ноль означает без ограничения.
+	UsageLimit       int
+	PerCustomerLimit int
+}
+
+// NormalizePromoCode - промокоды регистронезависимы.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (p *Promo) Validate() error {
+	if p.Code == "" {
+		return fmt.Errorf("%w: code is required", ErrInvalidPromo)
+	}
+	if !p.ValidTo.IsZero() && p.ValidTo.Before(p.ValidFrom) {
+		return fmt.Errorf("%w: validity window ends before it starts", ErrInvalidPromo)
+	}
+	if p.MinOrderValue.IsNegative() || p.UsageLimit < 0 || p.PerCustomerLimit < 0 {
+		return fmt.Errorf("%w: limits cannot be negative", ErrInvalidPromo)
+	}
+
+	switch p.Type {
+	case PromoFixedAmount:
+		if !p.Amount.IsPositive() {
+			return fmt.Errorf("%w: amount must be positive", ErrInvalidPromo)
+		}
+	case PromoPercentage:
+		if !p.Percent.IsPositive() || p.Percent.GreaterThan(decimal.NewFromInt(100)) {
+			return fmt.Errorf("%w: percent must be in (0, 100]", ErrInvalidPromo)
+		}
+		if p.MaxDiscount.IsNegative() {
+			return fmt.Errorf("%w: max discount cannot be negative", ErrInvalidPromo)
+		}
+	case PromoBuyNGetM:
+		if p.BuyQty <= 0 || p.FreeQty <= 0 {
+			return fmt.Errorf("%w: buy and free quantities must be positive", ErrInvalidPromo)
+		}
+	case PromoFreeDelivery, PromoFreeTopping:
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidPromo, p.Type)
+	}
+	return nil
+}
+
+// PromoContext - данные, нужные для расчета скидки помимо самого заказа.
+type PromoContext struct {
+	Now time.Time
+	// Categories - категории товаров заказа по ID товара.
+	Categories map[string]int
+}
+
+// Evaluate - проверяет применимость промокода к заказу и считает скидку.
+// Лимиты использования здесь не проверяются, за них отвечает PromoRepository.Redeem.
+func (p *Promo) Evaluate(o *Order, pc PromoContext) (common.Money, error) {
+	if !p.Active {
+		return common.ZeroMoney(), ErrPromoInactive
+	}
+	if pc.Now.Before(p.ValidFrom) || (!p.ValidTo.IsZero() && !pc.Now.Before(p.ValidTo)) {
+		return common.ZeroMoney(), ErrPromoExpired
+	}
+	if o.ItemsTotal().LessThan(p.MinOrderValue) {
+		return common.ZeroMoney(), fmt.Errorf("%w: minimum is %s", ErrPromoMinOrderValue, p.MinOrderValue.StringFixed(2))
+	}
+
+	eligible := make([]*OrderItem, 0, len(o.items))
+	for _, item := range o.items {
+		if p.appliesTo(item, pc.Categories) {
+			eligible = append(eligible, item)
+		}
+	}
+	if len(eligible) == 0 {
+		return common.ZeroMoney(), ErrPromoNotApplicable
+	}
+
+	subtotal := common.ZeroMoney()
+	for _, item := range eligible {
+		subtotal = subtotal.Add(item.CalculateTotal())
+	}
+
+	var discount common.Money
+	switch p.Type {
+	case PromoFixedAmount:
+		discount = p.Amount
+	case PromoPercentage:
+		discount = subtotal.Mul(p.Percent).Div(decimal.NewFromInt(100)).Round(2)
+		if p.MaxDiscount.IsPositive() && discount.GreaterThan(p.MaxDiscount) {
+			discount = p.MaxDiscount
+		}
+	case PromoFreeDelivery:
+		discount = o.deliveryPrice
+	case PromoBuyNGetM:
+		discount = p.buyNGetMDiscount(eligible)
+	case PromoFreeTopping:
+		discount = mostExpensiveTopping(eligible)
+	default:
+		return common.ZeroMoney(), fmt.Errorf("%w: unknown type %q", ErrInvalidPromo, p.Type)
+	}
+
+	if discount.GreaterThan(subtotal) && p.Type != PromoFreeDelivery {
+		discount = subtotal
+	}
+	if !discount.IsPositive() {
+		return common.ZeroMoney(), ErrPromoNotApplicable
+	}
+	return discount, nil
+}
+
+func (p *Promo) appliesTo(item *OrderItem, categories map[string]int) bool {
+	if len(p.ProductIDs) == 0 && len(p.CategoryIDs) == 0 {
+		return true
+	}
+	for _, id := range p.ProductIDs {
+		if id == item.productID {
+			return true
+		}
+	}
+	if cat, ok := categories[item.productID]; ok {
+		for _, c := range p.CategoryIDs {
+			if c == cat {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// buyNGetMDiscount - из каждых N+M единиц бесплатны M самых дешевых.
+func (p *Promo) buyNGetMDiscount(items []*OrderItem) common.Money {
+	units := make([]common.Money, 0)
+	for _, item := range items {
+		unit := item.CalculateTotal().Div(decimal.NewFromInt(int64(item.quantity)))
+		for i := 0; i < item.quantity; i++ {
+			units = append(units, unit)
+		}
+	}
+	sort.Slice(units, func(i, j int) bool { return units[i].LessThan(units[j]) })
+
+	free := len(units) / (p.BuyQty + p.FreeQty) * p.FreeQty
+	discount := common.ZeroMoney()
+	for _, u := range units[:free] {
+		discount = discount.Add(u)
+	}
+	return discount.Round(2)
+}
+
+func mostExpensiveTopping(items []*OrderItem) common.Money {
+	best := common.ZeroMoney()
+	for _, item := range items {
+		for _, t := range item.toppings {
+			if t.Price.GreaterThan(best) {
+				best = t.Price
+			}
+		}
+	}
+	return best
+}
+
+// PromoUsage - число применений промокода другими заказами.
+type PromoUsage struct {
+	Total      int
+	ByCustomer int
+}
+
+// CheckUsage - проверяет общий лимит и лимит на клиента.
+func (p *Promo) CheckUsage(u PromoUsage) error {
+	if p.UsageLimit > 0 && u.Total >= p.UsageLimit {
+		return ErrPromoLimitReached
+	}
+	if p.PerCustomerLimit > 0 && u.ByCustomer >= p.PerCustomerLimit {
+		return ErrPromoCustomerLimit
+	}
+	return nil
+}
+
+// Redemption - факт применения промокода к заказу. На заказ приходится не более одной.
+type Redemption struct {
+	Code       string
+	OrderID    string
+	CustomerID string
+	Discount   common.Money
+	RedeemedAt time.Time
+}
+
+type PromoRepository interface {
+	// Create сохраняет новый промокод, ErrPromoExists - если код уже занят.
+	Create(ctx context.Context, p *Promo) error
+	// Update заменяет определение существующего промокода, ErrPromoNotFound - если его нет.
+	Update(ctx context.Context, p *Promo) error
+	FindByCode(ctx context.Context, code string) (*Promo, error)
+	// Usage считает применения промокода, не учитывая заказ excludeOrderID.
+	Usage(ctx context.Context, code, customerID, excludeOrderID string) (PromoUsage, error)
+	// Redeem атомарно проверяет лимиты промокода и записывает применение,
+	// заменяя предыдущее применение для того же заказа.
+	Redeem(ctx context.Context, r Redemption, p *Promo) error
+	// Release снимает применение промокода с заказа (например, при отмене).
+	Release(ctx context.Context, orderID string) error
+}
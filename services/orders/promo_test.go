@@ -0,0 +1,110 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestPromo_Evaluate_Types(t *testing.T) {
+	now := time.Now()
+	order := NewOrder("cust-1", DeliveryAddress{City: "Moscow", Street: "Arbat"})
+	_ = order.AddItem("p1", "Pizza", 3, common.NewMoney(500), ItemOptions{}, []Topping{{Name: "Cheese", Price: common.NewMoney(50)}})
+	_ = order.AddItem("p2", "Cola", 1, common.NewMoney(100), ItemOptions{}, nil)
+	_ = order.SetDeliveryPrice(common.NewMoney(150))
+	// Позиции: 3 * 550 + 100 = 1750
+
+	tests := []struct {
+		name  string
+		promo Promo
+		want  common.Money
+	}{
+		{"fixed amount", Promo{Type: PromoFixedAmount, Amount: common.NewMoney(200)}, common.NewMoney(200)},
+		{"percentage", Promo{Type: PromoPercentage, Percent: decimal.NewFromInt(10)}, common.NewMoney(175)},
+		{"percentage capped", Promo{Type: PromoPercentage, Percent: decimal.NewFromInt(50), MaxDiscount: common.NewMoney(300)}, common.NewMoney(300)},
+		{"percentage on product", Promo{Type: PromoPercentage, Percent: decimal.NewFromInt(10), ProductIDs: []string{"p2"}}, common.NewMoney(10)},
+		{"percentage on category", Promo{Type: PromoPercentage, Percent: decimal.NewFromInt(10), CategoryIDs: []int{1}}, common.NewMoney(165)},
+		{"free delivery", Promo{Type: PromoFreeDelivery}, common.NewMoney(150)},
+		{"buy 2 get 1", Promo{Type: PromoBuyNGetM, BuyQty: 2, FreeQty: 1}, common.NewMoney(100)},
+		{"free topping", Promo{Type: PromoFreeTopping}, common.NewMoney(50)},
+		{"fixed capped by subtotal", Promo{Type: PromoFixedAmount, Amount: common.NewMoney(500), ProductIDs: []string{"p2"}}, common.NewMoney(100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.promo.Code = "TEST"
+			tt.promo.Active = true
+			tt.promo.ValidFrom = now.Add(-time.Hour)
+			if err := tt.promo.Validate(); err != nil {
+				t.Fatalf("invalid promo: %v", err)
+			}
+
+			got, err := tt.promo.Evaluate(order, PromoContext{Now: now, Categories: map[string]int{"p1": 1, "p2": 4}})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPromo_Evaluate_Rejections(t *testing.T) {
+	now := time.Now()
+	order := NewOrder("cust-1", DeliveryAddress{City: "Moscow", Street: "Arbat"})
+	_ = order.AddItem("p1", "Pizza", 1, common.NewMoney(500), ItemOptions{}, nil)
+
+	base := func() Promo {
+		return Promo{Code: "TEST", Type: PromoFixedAmount, Amount: common.NewMoney(100), Active: true, ValidFrom: now.Add(-time.Hour)}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(p *Promo)
+		wantErr error
+	}{
+		{"inactive", func(p *Promo) { p.Active = false }, ErrPromoInactive},
+		{"not started", func(p *Promo) { p.ValidFrom = now.Add(time.Hour) }, ErrPromoExpired},
+		{"expired", func(p *Promo) { p.ValidTo = now.Add(-time.Minute) }, ErrPromoExpired},
+		{"min order value", func(p *Promo) { p.MinOrderValue = common.NewMoney(1000) }, ErrPromoMinOrderValue},
+		{"other products", func(p *Promo) { p.ProductIDs = []string{"p2"} }, ErrPromoNotApplicable},
+		{"no toppings", func(p *Promo) { p.Type = PromoFreeTopping }, ErrPromoNotApplicable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := base()
+			tt.modify(&p)
+			_, err := p.Evaluate(order, PromoContext{Now: now})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPromo_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		promo Promo
+	}{
+		{"empty code", Promo{Type: PromoFreeDelivery}},
+		{"unknown type", Promo{Code: "X", Type: "gift"}},
+		{"zero amount", Promo{Code: "X", Type: PromoFixedAmount}},
+		{"percent over 100", Promo{Code: "X", Type: PromoPercentage, Percent: decimal.NewFromInt(150)}},
+		{"buy n without m", Promo{Code: "X", Type: PromoBuyNGetM, BuyQty: 2}},
+		{"negative limit", Promo{Code: "X", Type: PromoFreeDelivery, UsageLimit: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.promo.Validate(); !errors.Is(err, ErrInvalidPromo) {
+				t.Errorf("expected ErrInvalidPromo, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+var ErrSagaNotFound = errors.New("order saga not found")
+
+// SagaState - шаг оркестрации заказа.
+type SagaState string
+
+const (
+	SagaAwaitingPayment SagaState = "awaiting_payment"
+	// SagaPaid - оплачен и ждет передачи на кухню (предзаказ - до времени приготовления).
+	SagaPaid       SagaState = "paid"
+	SagaCooking    SagaState = "cooking"
+	SagaDelivering SagaState = "delivering"
+	SagaCompleted  SagaState = "completed"
+	// SagaCompensating - шаг не удался, выполняются компенсации: снятие тикета, возврат, отмена заказа.
+	SagaCompensating SagaState = "compensating"
+	SagaCompensated  SagaState = "compensated"
+	// SagaFailed - компенсация невозможна автоматически, нужен менеджер.
+	SagaFailed SagaState = "failed"
+)
+
+// OrderSaga - сохраняемое состояние оркестрации заказа от оплаты до вручения.
+type OrderSaga struct {
+	OrderID   string
+	State     SagaState
+	PaymentID string
+	// Amount - сумма, на которую запущен платеж. Нулевая у бесплатных заказов и у саг,
+	// созданных до того, как сумма стала сохраняться.
+	Amount common.Money
+	// Tickets - тикеты кухни по точкам, у разделенного заказа их несколько.
+	Tickets []SagaTicket
+	// Deadline - крайний срок текущего шага, нулевой - без ограничения.
+	Deadline time.Time
+
+	// CancelReason и FailureReason заполняются при компенсации.
+	CancelReason  CancelReason
+	FailureReason string
+	// Выполненные компенсации: повторный проход не вызывает их второй раз.
+	// TicketCanceled - сняты все тикеты, PaymentSettled - платеж возвращен, отклонен или его не было.
+	TicketCanceled bool
+	PaymentSettled bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SagaTicket - тикет кухни одной точки.
+type SagaTicket struct {
+	StoreID  string
+	TicketID string
+}
+
+func NewOrderSaga(orderID, paymentID string, now time.Time, paymentTimeout time.Duration) *OrderSaga {
+	return &OrderSaga{
+		OrderID:   orderID,
+		State:     SagaAwaitingPayment,
+		PaymentID: paymentID,
+		Deadline:  now.Add(paymentTimeout),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// TicketFor - тикет точки, если заказ уже передан на ее кухню.
+func (s *OrderSaga) TicketFor(storeID string) (string, bool) {
+	for _, t := range s.Tickets {
+		if t.StoreID == storeID {
+			return t.TicketID, true
+		}
+	}
+	return "", false
+}
+
+func (s *OrderSaga) AddTicket(storeID, ticketID string) {
+	for i, t := range s.Tickets {
+		if t.StoreID == storeID {
+			s.Tickets[i].TicketID = ticketID
+			return
+		}
+	}
+	s.Tickets = append(s.Tickets, SagaTicket{StoreID: storeID, TicketID: ticketID})
+}
+
+// Active - сага еще требует действий оркестратора.
+func (s *OrderSaga) Active() bool {
+	switch s.State {
+	case SagaCompleted, SagaCompensated, SagaFailed:
+		return false
+	}
+	return true
+}
+
+// MoveTo - переход к следующему шагу со своим сроком, нулевой timeout - без срока.
+func (s *OrderSaga) MoveTo(state SagaState, now time.Time, timeout time.Duration) {
+	s.State = state
+	s.Deadline = time.Time{}
+	if timeout > 0 {
+		s.Deadline = now.Add(timeout)
+	}
+	s.UpdatedAt = now
+}
+
+func (s *OrderSaga) TimedOut(now time.Time) bool {
+	return !s.Deadline.IsZero() && !now.Before(s.Deadline)
+}
+
+// Compensate - запускает откат уже выполненных шагов.
+func (s *OrderSaga) Compensate(reason CancelReason, details string, now time.Time) {
+	s.CancelReason = reason
+	s.FailureReason = details
+	s.MoveTo(SagaCompensating, now, 0)
+}
+
+// Fail - сага остановлена и требует ручного разбора.
+func (s *OrderSaga) Fail(details string, now time.Time) {
+	s.FailureReason = details
+	s.MoveTo(SagaFailed, now, 0)
+}
+
+type SagaRepository interface {
+	Save(ctx context.Context, s *OrderSaga) error
+	FindByOrderID(ctx context.Context, orderID string) (*OrderSaga, error)
+	// ListActive - саги, по которым оркестратору еще есть что делать.
+	ListActive(ctx context.Context) ([]*OrderSaga, error)
+}
+
+// PaymentState, TicketState, DeliveryState - состояния во внешних сервисах,
+// значения совпадают со статусами treasury, kitchen и logistics.
+type PaymentState string
+
+const (
+	PaymentWaiting  PaymentState = "waiting"
+	PaymentSuccess  PaymentState = "success"
+	PaymentDeclined PaymentState = "declined"
+	PaymentRefunded PaymentState = "refund"
+)
+
+type TicketState string
+
+const (
+	TicketQueued   TicketState = "queued"
+	TicketCooking  TicketState = "cooking"
+	TicketReady    TicketState = "ready"
+	TicketCanceled TicketState = "canceled"
+)
+
+type DeliveryState string
+
+const (
+	DeliveryPending   DeliveryState = "pending"
+	DeliveryAssigned  DeliveryState = "assigned"
+	DeliveryOnWay     DeliveryState = "on_way"
+	DeliveryDelivered DeliveryState = "delivered"
+	DeliveryFailed    DeliveryState = "failed"
+)
+
+// DeliveryProgress - состояние доставки в logistics.
+type DeliveryProgress struct {
+	State DeliveryState
+	// Courier - координаты курьера, nil - курьер еще не передавал их.
+	Courier *GeoPoint
+}
+
+// PaymentGateway - оплата заказа в treasury.
+type PaymentGateway interface {
+	InitiatePayment(ctx context.Context, orderID string, amount common.Money) (paymentID string, err error)
+	PaymentStatus(ctx context.Context, orderID string) (PaymentState, error)
+	DeclinePayment(ctx context.Context, orderID string) error
+	RefundPayment(ctx context.Context, orderID string) error
+}
+
+// KitchenGateway - тикеты на кухнях точек.
+type KitchenGateway interface {
+	// AcceptOrder - тикет на часть заказа, которую готовит точка.
+	AcceptOrder(ctx context.Context, o *Order, storeID string) (ticketID string, err error)
+	TicketStatus(ctx context.Context, ticketID string) (TicketState, error)
+	CancelTicket(ctx context.Context, ticketID string) error
+}
+
+// DeliveryGateway - доставка в logistics.
+type DeliveryGateway interface {
+	CreateDelivery(ctx context.Context, o *Order) error
+	DeliveryStatus(ctx context.Context, orderID string) (DeliveryProgress, error)
+}
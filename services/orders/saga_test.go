@@ -0,0 +1,33 @@
+package orders
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderSaga_Lifecycle(t *testing.T) {
+	now := time.Now()
+	s := NewOrderSaga("order-1", "payment-1", now, 15*time.Minute)
+
+	if !s.Active() || s.State != SagaAwaitingPayment {
+		t.Fatalf("new saga must await payment, got %s", s.State)
+	}
+	if s.TimedOut(now.Add(14*time.Minute)) || !s.TimedOut(now.Add(15*time.Minute)) {
+		t.Error("payment step must time out exactly at its deadline")
+	}
+
+	s.MoveTo(SagaPaid, now, 0)
+	if !s.Deadline.IsZero() || s.TimedOut(now.Add(24*time.Hour)) {
+		t.Error("step without timeout must never time out")
+	}
+
+	s.Compensate(CancelReasonKitchenFailure, "kitchen timed out", now)
+	if s.State != SagaCompensating || !s.Active() || s.CancelReason != CancelReasonKitchenFailure {
+		t.Errorf("unexpected compensating saga: %+v", s)
+	}
+
+	s.Fail("order must be resolved manually", now)
+	if s.Active() {
+		t.Error("failed saga must not be active")
+	}
+}
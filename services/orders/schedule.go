@@ -0,0 +1,137 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrDeliveryTooSoon = errors.New("requested delivery time is too soon")
+	ErrDeliveryTooFar  = errors.New("requested delivery time is too far ahead")
+	ErrStoreClosed     = errors.New("store is closed at requested time")
+	ErrSlotFull        = errors.New("delivery slot is fully booked")
+	ErrPreOrderHeld    = errors.New("pre-order is held until its kitchen release time")
+)
+
+// WorkingHours - часы работы за день. Интервал [Opens, Closes) может переходить через полночь,
+// совпадающие границы означают круглосуточную работу.
+type WorkingHours struct {
+	Opens  TimeOfDay
+	Closes TimeOfDay
+}
+
+func (h WorkingHours) overnight() bool { return h.Opens > h.Closes }
+
+// DateLayout - формат даты особого дня в расписании.
+const DateLayout = "2006-01-02"
+
+// SpecialDay - часы работы в конкретную дату вместо обычных, например в праздник.
+type SpecialDay struct {
+	Closed bool
+	Hours  WorkingHours
+}
+
+// StoreSchedule - часы работы и параметры слотов предзаказов.
+type StoreSchedule struct {
+	// Hours - часы работы по дням недели, отсутствующий день - выходной.
+	Hours    map[time.Weekday]WorkingHours
+	Location *time.Location
+	// SpecialDays - особые дни по дате в формате DateLayout, заменяют часы дня недели.
+	SpecialDays map[string]SpecialDay
+
+	SlotLength time.Duration
+	// SlotCapacity - максимум предзаказов на слот, ноль - без ограничения.
+	SlotCapacity int
+
+	// MinAdvance - минимальный запас времени между оформлением и доставкой предзаказа.
+	MinAdvance time.Duration
+	MaxAdvance time.Duration
+	// KitchenLeadTime - за сколько до времени доставки предзаказ передается на кухню.
+	KitchenLeadTime time.Duration
+}
+
+// IsOpen - работает ли заведение в указанный момент. Для интервалов через полночь
+// ночная часть относится к дню открытия.
+func (s *StoreSchedule) IsOpen(at time.Time) bool {
+	if s.Location != nil {
+		at = at.In(s.Location)
+	}
+	tod := TimeOfDayOf(at)
+
+	if h, ok := s.hoursOn(at); ok {
+		if h.Opens == h.Closes {
+			return true
+		}
+		if !h.overnight() && tod >= h.Opens && tod < h.Closes {
+			return true
+		}
+		if h.overnight() && tod >= h.Opens {
+			return true
+		}
+	}
+	if h, ok := s.hoursOn(at.AddDate(0, 0, -1)); ok && h.overnight() && tod < h.Closes {
+		return true
+	}
+	return false
+}
+
+// hoursOn - часы работы в день date, false - выходной.
+func (s *StoreSchedule) hoursOn(date time.Time) (WorkingHours, bool) {
+	if day, ok := s.SpecialDays[date.Format(DateLayout)]; ok {
+		return day.Hours, !day.Closed
+	}
+	h, ok := s.Hours[date.Weekday()]
+	return h, ok
+}
+
+// ValidateDeliveryTime - проверяет запрошенное время предзаказа без учета загрузки слота.
+func (s *StoreSchedule) ValidateDeliveryTime(at, now time.Time) error {
+	if at.Before(now.Add(s.MinAdvance)) {
+		return fmt.Errorf("%w: earliest is %s", ErrDeliveryTooSoon, now.Add(s.MinAdvance).Format(time.RFC3339))
+	}
+	if s.MaxAdvance > 0 && at.After(now.Add(s.MaxAdvance)) {
+		return ErrDeliveryTooFar
+	}
+	if !s.IsOpen(at) {
+		return fmt.Errorf("%w: %s", ErrStoreClosed, at.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// Slot - границы слота [from, to), в который попадает время доставки.
+func (s *StoreSchedule) Slot(at time.Time) (time.Time, time.Time) {
+	if s.SlotLength <= 0 {
+		return at, at.Add(time.Nanosecond)
+	}
+	if s.Location != nil {
+		at = at.In(s.Location)
+	}
+	midnight := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+	from := midnight.Add(at.Sub(midnight) / s.SlotLength * s.SlotLength)
+	return from, from.Add(s.SlotLength)
+}
+
+// ReleaseAt - момент передачи предзаказа на кухню.
+func (s *StoreSchedule) ReleaseAt(deliverAt time.Time) time.Time {
+	return deliverAt.Add(-s.KitchenLeadTime)
+}
+
+// SlotBooking - место в слоте доставки [From, To), которое занимает оформленный предзаказ.
+type SlotBooking struct {
+	OrderID   string
+	DeliverAt time.Time
+	From      time.Time
+	To        time.Time
+}
+
+// SlotBookings - брони слотов доставки. Корзины без оформления мест не занимают.
+type SlotBookings interface {
+	// Book атомарно проверяет, что в слоте меньше capacity броней, и записывает бронь,
+	// ErrSlotFull - мест нет. Повторная бронь того же заказа ничего не меняет,
+	// capacity <= 0 - без ограничения.
+	Book(ctx context.Context, b SlotBooking, capacity int) error
+	// Release снимает бронь заказа, например при отмене.
+	Release(ctx context.Context, orderID string) error
+}
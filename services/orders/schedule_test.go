@@ -0,0 +1,148 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func TestStoreSchedule_IsOpen(t *testing.T) {
+	schedule := &StoreSchedule{
+		Hours: map[time.Weekday]WorkingHours{
+			time.Friday:   {Opens: 10 * 60, Closes: 2 * 60}, // до 02:00 субботы
+			time.Saturday: {Opens: 12 * 60, Closes: 23 * 60},
+			time.Monday:   {},
+		},
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"friday day", time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC), true},
+		{"friday before opening", time.Date(2026, 10, 16, 9, 59, 0, 0, time.UTC), false},
+		{"friday night on saturday", time.Date(2026, 10, 17, 1, 30, 0, 0, time.UTC), true},
+		{"saturday after friday night", time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC), false},
+		{"saturday closing", time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC), false},
+		{"sunday day off", time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC), false},
+		{"monday around the clock", time.Date(2026, 10, 19, 4, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.IsOpen(tt.at); got != tt.want {
+				t.Errorf("IsOpen(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStoreSchedule_SpecialDays(t *testing.T) {
+	schedule := &StoreSchedule{
+		Hours: map[time.Weekday]WorkingHours{
+			time.Friday:   {Opens: 10 * 60, Closes: 2 * 60},
+			time.Saturday: {Opens: 12 * 60, Closes: 23 * 60},
+		},
+		SpecialDays: map[string]SpecialDay{
+			"2026-10-16": {Closed: true},
+			"2026-10-18": {Hours: WorkingHours{Opens: 12 * 60, Closes: 18 * 60}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"closed friday", time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC), false},
+		{"no night after closed friday", time.Date(2026, 10, 17, 1, 0, 0, 0, time.UTC), false},
+		{"regular saturday", time.Date(2026, 10, 17, 13, 0, 0, 0, time.UTC), true},
+		{"sunday opened for holiday", time.Date(2026, 10, 18, 13, 0, 0, 0, time.UTC), true},
+		{"holiday closes early", time.Date(2026, 10, 18, 18, 30, 0, 0, time.UTC), false},
+		{"next friday as usual", time.Date(2026, 10, 23, 15, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.IsOpen(tt.at); got != tt.want {
+				t.Errorf("IsOpen(%v) = %v, want %v", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStoreSchedule_ValidateDeliveryTime(t *testing.T) {
+	schedule := &StoreSchedule{
+		Hours:      map[time.Weekday]WorkingHours{time.Monday: {Opens: 10 * 60, Closes: 22 * 60}},
+		MinAdvance: time.Hour,
+		MaxAdvance: 24 * time.Hour,
+	}
+	now := time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want error
+	}{
+		{"valid", now.Add(2 * time.Hour), nil},
+		{"too soon", now.Add(30 * time.Minute), ErrDeliveryTooSoon},
+		{"too far", now.Add(48 * time.Hour), ErrDeliveryTooFar},
+		{"closed", time.Date(2026, 10, 19, 22, 30, 0, 0, time.UTC), ErrStoreClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schedule.ValidateDeliveryTime(tt.at, now)
+			if !errors.Is(err, tt.want) && !(tt.want == nil && err == nil) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestStoreSchedule_Slot(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	schedule := &StoreSchedule{Location: moscow, SlotLength: 30 * time.Minute, KitchenLeadTime: 40 * time.Minute}
+
+	at := time.Date(2026, 10, 19, 16, 45, 0, 0, time.UTC) // 19:45 MSK
+	from, to := schedule.Slot(at)
+	if !from.Equal(time.Date(2026, 10, 19, 19, 30, 0, 0, moscow)) || to.Sub(from) != 30*time.Minute {
+		t.Errorf("expected slot 19:30-20:00 MSK, got %v - %v", from, to)
+	}
+	if got := schedule.ReleaseAt(at); !got.Equal(at.Add(-40 * time.Minute)) {
+		t.Errorf("expected release 40 minutes before delivery, got %v", got)
+	}
+}
+
+func TestOrder_ScheduleDelivery(t *testing.T) {
+	order := NewOrder("customer-1", DeliveryAddress{City: "Moscow", Street: "Tverskaya"})
+	if order.IsPreOrder() {
+		t.Fatal("new order must be delivered as soon as possible")
+	}
+
+	at := time.Now().Add(3 * time.Hour)
+	if err := order.ScheduleDelivery(at); err != nil {
+		t.Fatalf("failed to schedule: %v", err)
+	}
+	if !order.IsPreOrder() || !order.ScheduledFor().Equal(at) {
+		t.Errorf("expected pre-order for %v, got %v", at, order.ScheduledFor())
+	}
+
+	filter := OrderFilter{ScheduledFrom: at.Add(-time.Minute), ScheduledTo: at.Add(time.Minute)}
+	if !filter.Match(order) {
+		t.Error("expected order to match its slot")
+	}
+	if (OrderFilter{ScheduledTo: at}).Match(order) {
+		t.Error("expected order to be outside [from, at)")
+	}
+
+	if err := order.AddItem("p1", "Pizza", 1, common.NewMoney(500), ItemOptions{}, nil); err != nil {
+		t.Fatalf("failed to add item: %v", err)
+	}
+	if err := order.MarkPaid(SystemActor, ""); err != nil {
+		t.Fatalf("failed to pay: %v", err)
+	}
+	if err := order.ScheduleDelivery(at.Add(time.Hour)); !errors.Is(err, ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked after payment, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrInvalidStores      = errors.New("invalid store network")
+	ErrNoStoreForZone     = errors.New("no store serves delivery zone")
+	ErrProductNotProduced = errors.New("product is not produced by any store serving the address")
+)
+
+// Store - точка приготовления (кухня), из которой доставляются заказы.
+type Store struct {
+	ID   string
+	Name string
+	// Zones - ID зон доставки, которые обслуживает точка, пусто - все зоны.
+	Zones []string
+	// Excluded - товары, которые точка не готовит.
+	Excluded []string
+	// Capacity - сколько заказов точка готовит одновременно, 0 - без ограничения.
+	Capacity int
+}
+
+func (s Store) Covers(zoneID string) bool {
+	if len(s.Zones) == 0 {
+		return true
+	}
+	for _, z := range s.Zones {
+		if z == zoneID {
+			return true
+		}
+	}
+	return false
+}
+
+// Produces - точка готовит позицию целиком, для пиццы "пополам" - обе половины.
+func (s Store) Produces(item *OrderItem) bool {
+	ids := []string{item.ProductID()}
+	for _, h := range item.Halves() {
+		ids = append(ids, h.ProductID)
+	}
+	for _, id := range ids {
+		for _, excluded := range s.Excluded {
+			if id == excluded {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// loadRatio - загрузка точки, точки без ограничения считаются свободными.
+func (s Store) loadRatio(active int) float64 {
+	if s.Capacity <= 0 {
+		return 0
+	}
+	return float64(active) / float64(s.Capacity)
+}
+
+// StoreNetwork - все точки сети. При равной загрузке выигрывает точка, указанная раньше.
+type StoreNetwork struct {
+	Stores []Store
+}
+
+func (n *StoreNetwork) Validate() error {
+	if len(n.Stores) == 0 {
+		return fmt.Errorf("%w: no stores", ErrInvalidStores)
+	}
+	seen := make(map[string]struct{}, len(n.Stores))
+	for _, s := range n.Stores {
+		if s.ID == "" {
+			return fmt.Errorf("%w: store ID is required", ErrInvalidStores)
+		}
+		if _, dup := seen[s.ID]; dup {
+			return fmt.Errorf("%w: duplicate store %s", ErrInvalidStores, s.ID)
+		}
+		if s.Capacity < 0 {
+			return fmt.Errorf("%w: negative capacity of store %s", ErrInvalidStores, s.ID)
+		}
+		seen[s.ID] = struct{}{}
+	}
+	return nil
+}
+
+// Assign - точка для каждой строки заказа (ID строки -> ID точки).
+// Заказ целиком уходит в наименее загруженную точку, которая готовит все позиции.
+// Если такой нет, заказ делится: каждая позиция - в наименее загруженную точку, которая ее готовит.
+func (n *StoreNetwork) Assign(zoneID string, items []*OrderItem, load map[string]int) (map[string]string, error) {
+	candidates := make([]Store, 0, len(n.Stores))
+	for _, s := range n.Stores {
+		if s.Covers(zoneID) {
+			candidates = append(candidates, s)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoStoreForZone, zoneID)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].loadRatio(load[candidates[i].ID]) < candidates[j].loadRatio(load[candidates[j].ID])
+	})
+
+	for _, s := range candidates {
+		if producesAll(s, items) {
+			return assignAll(s.ID, items), nil
+		}
+	}
+
+	result := make(map[string]string, len(items))
+	for _, item := range items {
+		for _, s := range candidates {
+			if s.Produces(item) {
+				result[item.ID()] = s.ID
+				break
+			}
+		}
+		if _, ok := result[item.ID()]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrProductNotProduced, item.ProductName())
+		}
+	}
+	return result, nil
+}
+
+func producesAll(s Store, items []*OrderItem) bool {
+	for _, item := range items {
+		if !s.Produces(item) {
+			return false
+		}
+	}
+	return true
+}
+
+func assignAll(storeID string, items []*OrderItem) map[string]string {
+	result := make(map[string]string, len(items))
+	for _, item := range items {
+		result[item.ID()] = storeID
+	}
+	return result
+}
+
+// StoreLoad - текущая загрузка точек: число оплаченных и готовящихся заказов по ID точки.
+type StoreLoad interface {
+	ActiveOrdersByStore(ctx context.Context) (map[string]int, error)
+}
+
+// StoreAssigner - выбор точек приготовления для позиций заказа.
+type StoreAssigner interface {
+	AssignStores(ctx context.Context, zoneID string, items []*OrderItem) (map[string]string, error)
+}
+
+// StoreRouter - StoreAssigner по сети точек с учетом их текущей загрузки.
+type StoreRouter struct {
+	network *StoreNetwork
+	load    StoreLoad
+}
+
+func NewStoreRouter(network *StoreNetwork, load StoreLoad) *StoreRouter {
+	return &StoreRouter{network: network, load: load}
+}
+
+func (r *StoreRouter) AssignStores(ctx context.Context, zoneID string, items []*OrderItem) (map[string]string, error) {
+	load, err := r.load.ActiveOrdersByStore(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load store utilization: %w", err)
+	}
+	return r.network.Assign(zoneID, items, load)
+}
@@ -0,0 +1,103 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+)
+
+func storeTestOrder(t *testing.T, productIDs ...string) *Order {
+	t.Helper()
+	o := NewOrder("cust1", DeliveryAddress{City: "Moscow", Street: "Red Square"})
+	for _, id := range productIDs {
+		if err := o.AddItem(id, "Product "+id, 1, common.NewMoney(500), ItemOptions{}, nil); err != nil {
+			t.Fatalf("failed to add item: %v", err)
+		}
+	}
+	return o
+}
+
+func TestStoreNetwork_Assign(t *testing.T) {
+	network := &StoreNetwork{Stores: []Store{
+		{ID: "north", Zones: []string{"center", "north"}, Capacity: 10},
+		{ID: "south", Zones: []string{"center", "south"}, Capacity: 10, Excluded: []string{"calzone"}},
+	}}
+	o := storeTestOrder(t, "pepperoni", "calzone")
+
+	// Заказ целиком уходит в точку, которая готовит все, даже если она загружена сильнее
+	byLine, err := network.Assign("center", o.Items(), map[string]int{"north": 8, "south": 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, item := range o.Items() {
+		if byLine[item.ID()] != "north" {
+			t.Errorf("expected whole order in north, got %v", byLine)
+		}
+	}
+
+	// Без калзоне выигрывает менее загруженная точка
+	simple := storeTestOrder(t, "pepperoni")
+	byLine, _ = network.Assign("center", simple.Items(), map[string]int{"north": 8, "south": 1})
+	if byLine[simple.Items()[0].ID()] != "south" {
+		t.Errorf("expected least loaded store, got %v", byLine)
+	}
+
+	if _, err := network.Assign("west", o.Items(), nil); !errors.Is(err, ErrNoStoreForZone) {
+		t.Errorf("expected ErrNoStoreForZone, got %v", err)
+	}
+	if _, err := network.Assign("south", o.Items(), nil); !errors.Is(err, ErrProductNotProduced) {
+		t.Errorf("expected ErrProductNotProduced, got %v", err)
+	}
+}
+
+func TestStoreNetwork_AssignSplitsOrder(t *testing.T) {
+	network := &StoreNetwork{Stores: []Store{
+		{ID: "pizza", Excluded: []string{"sushi"}},
+		{ID: "sushi", Excluded: []string{"pepperoni"}},
+	}}
+	o := storeTestOrder(t, "pepperoni", "sushi")
+
+	byLine, err := network.Assign("any", o.Items(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := o.AssignStores(byLine); err != nil {
+		t.Fatalf("failed to assign stores: %v", err)
+	}
+	if ids := o.StoreIDs(); len(ids) != 2 || ids[0] != "pizza" || ids[1] != "sushi" {
+		t.Fatalf("expected order split between stores, got %v", ids)
+	}
+	if items := o.ItemsForStore("sushi"); len(items) != 1 || items[0].ProductID() != "sushi" {
+		t.Errorf("unexpected items of sushi store: %+v", items)
+	}
+
+	if err := o.AssignStores(map[string]string{}); !errors.Is(err, ErrItemNotFound) {
+		t.Errorf("expected ErrItemNotFound for unassigned line, got %v", err)
+	}
+}
+
+type staticLoad map[string]int
+
+func (l staticLoad) ActiveOrdersByStore(ctx context.Context) (map[string]int, error) {
+	return l, nil
+}
+
+func TestStoreRouter_AssignStores(t *testing.T) {
+	network := &StoreNetwork{Stores: []Store{
+		{ID: "north", Capacity: 10},
+		{ID: "south", Capacity: 20},
+	}}
+	router := NewStoreRouter(network, staticLoad{"north": 4, "south": 10})
+	o := storeTestOrder(t, "pepperoni")
+
+	// 4/10 меньше 10/20 - загрузка считается относительно вместимости
+	byLine, err := router.AssignStores(context.Background(), "any", o.Items())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if byLine[o.Items()[0].ID()] != "north" {
+		t.Errorf("expected north, got %v", byLine)
+	}
+}
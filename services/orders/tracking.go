@@ -0,0 +1,50 @@
+package orders
+
+import "time"
+
+// TrackingKind - что изменилось в заказе.
+type TrackingKind string
+
+const (
+	TrackingStatus TrackingKind = "status"
+	// TrackingKitchen - состояние тикета на кухне одной из точек заказа.
+	TrackingKitchen TrackingKind = "kitchen"
+	// TrackingCourier - состояние доставки и координаты курьера.
+	TrackingCourier TrackingKind = "courier"
+)
+
+// TrackingUpdate - изменение заказа для клиента, который за ним следит.
+// Заполнены только поля, относящиеся к Kind.
+type TrackingUpdate struct {
+	OrderID string
+	Kind    TrackingKind
+	At      time.Time
+
+	Status OrderStatus
+
+	StoreID string
+	Ticket  TicketState
+
+	Delivery DeliveryState
+	// Courier - последние координаты курьера, nil - неизвестны.
+	Courier *GeoPoint
+}
+
+// Final - после этого обновления заказ больше не меняется.
+func (u TrackingUpdate) Final() bool {
+	return u.Kind == TrackingStatus && (u.Status == StatusCompleted || u.Status == StatusCanceled)
+}
+
+// TrackingPublisher - источник обновлений: события заказа из outbox, события кухни
+// и логистики из брокера и опрос этих сервисов сагой.
+type TrackingPublisher interface {
+	PublishUpdate(u TrackingUpdate)
+}
+
+// TrackingFeed - pub/sub обновлений заказов внутри процесса.
+type TrackingFeed interface {
+	TrackingPublisher
+	// Subscribe - последние известные обновления заказа и канал новых.
+	// cancel закрывает подписку, канал после этого не используется.
+	Subscribe(orderID string) (latest []TrackingUpdate, updates <-chan TrackingUpdate, cancel func())
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+type AddAddressInput struct {
+	CustomerID string
+	Label      string
+	Address    orders.DeliveryAddress
+	// MakeDefault - сделать адрес основным. Первый адрес клиента становится основным всегда.
+	MakeDefault bool
+}
+
+func (uc *OrderUseCase) AddAddress(ctx context.Context, input AddAddressInput) (*orders.SavedAddress, error) {
+	if input.CustomerID == "" {
+		return nil, fmt.Errorf("%w: customer ID is required", ErrInvalidInput)
+	}
+	if err := validateAddress(input.Address); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.addresses.ListByCustomer(ctx, input.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load address book: %w", err)
+	}
+
+	saved := orders.NewSavedAddress(input.CustomerID, input.Label, input.Address)
+	saved.IsDefault = len(existing) == 0
+	if err := uc.addresses.Save(ctx, saved); err != nil {
+		return nil, fmt.Errorf("failed to save address: %w", err)
+	}
+
+	if input.MakeDefault && !saved.IsDefault {
+		return uc.SetDefaultAddress(ctx, input.CustomerID, saved.ID)
+	}
+	return saved, nil
+}
+
+type UpdateAddressInput struct {
+	CustomerID string
+	AddressID  string
+	Label      string
+	Address    orders.DeliveryAddress
+}
+
+func (uc *OrderUseCase) UpdateAddress(ctx context.Context, input UpdateAddressInput) (*orders.SavedAddress, error) {
+	if err := validateAddress(input.Address); err != nil {
+		return nil, err
+	}
+
+	saved, err := uc.customerAddress(ctx, input.CustomerID, input.AddressID)
+	if err != nil {
+		return nil, err
+	}
+	saved.Label = input.Label
+	saved.Address = input.Address
+
+	if err := uc.addresses.Save(ctx, saved); err != nil {
+		return nil, fmt.Errorf("failed to save address: %w", err)
+	}
+	return saved, nil
+}
+
+func (uc *OrderUseCase) ListAddresses(ctx context.Context, customerID string) ([]*orders.SavedAddress, error) {
+	if customerID == "" {
+		return nil, fmt.Errorf("%w: customer ID is required", ErrInvalidInput)
+	}
+
+	list, err := uc.addresses.ListByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load address book: %w", err)
+	}
+	return list, nil
+}
+
+// DeleteAddress - удаляет адрес. Если он был основным, основным становится самый старый из оставшихся.
+func (uc *OrderUseCase) DeleteAddress(ctx context.Context, customerID, addressID string) error {
+	saved, err := uc.customerAddress(ctx, customerID, addressID)
+	if err != nil {
+		return err
+	}
+	if err := uc.addresses.Delete(ctx, saved.ID); err != nil {
+		return fmt.Errorf("failed to delete address: %w", err)
+	}
+	if !saved.IsDefault {
+		return nil
+	}
+
+	rest, err := uc.addresses.ListByCustomer(ctx, customerID)
+	if err != nil {
+		return fmt.Errorf("failed to load address book: %w", err)
+	}
+	if len(rest) == 0 {
+		return nil
+	}
+	if err := uc.addresses.SetDefault(ctx, customerID, rest[0].ID); err != nil {
+		return fmt.Errorf("failed to set default address: %w", err)
+	}
+	return nil
+}
+
+func (uc *OrderUseCase) SetDefaultAddress(ctx context.Context, customerID, addressID string) (*orders.SavedAddress, error) {
+	saved, err := uc.customerAddress(ctx, customerID, addressID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.addresses.SetDefault(ctx, customerID, saved.ID); err != nil {
+		return nil, fmt.Errorf("failed to set default address: %w", err)
+	}
+	saved.IsDefault = true
+	return saved, nil
+}
+
+// customerAddress - адрес клиента. Чужой адрес выглядит как несуществующий.
+func (uc *OrderUseCase) customerAddress(ctx context.Context, customerID, addressID string) (*orders.SavedAddress, error) {
+	if customerID == "" || addressID == "" {
+		return nil, fmt.Errorf("%w: customer ID and address ID are required", ErrInvalidInput)
+	}
+
+	saved, err := uc.addresses.FindByID(ctx, addressID)
+	if err != nil {
+		return nil, err
+	}
+	if saved.CustomerID != customerID {
+		return nil, fmt.Errorf("%w: %s", orders.ErrAddressNotFound, addressID)
+	}
+	return saved, nil
+}
+
+// resolveAddress - адрес доставки заказа: из адресной книги, явно указанный или основной.
+func (uc *OrderUseCase) resolveAddress(ctx context.Context, input CreateOrderInput) (orders.DeliveryAddress, error) {
+	if input.AddressID != "" {
+		saved, err := uc.customerAddress(ctx, input.CustomerID, input.AddressID)
+		if err != nil {
+			return orders.DeliveryAddress{}, err
+		}
+		return saved.Address, nil
+	}
+	if input.Address.City != "" || input.Address.Street != "" {
+		return input.Address, nil
+	}
+
+	book, err := uc.addresses.ListByCustomer(ctx, input.CustomerID)
+	if err != nil {
+		return orders.DeliveryAddress{}, fmt.Errorf("failed to load address book: %w", err)
+	}
+	for _, saved := range book {
+		if saved.IsDefault {
+			return saved.Address, nil
+		}
+	}
+	return input.Address, nil
+}
+
+func validateAddress(addr orders.DeliveryAddress) error {
+	if addr.City == "" || addr.Street == "" {
+		return fmt.Errorf("%w: incomplete delivery address", ErrInvalidInput)
+	}
+	if addr.Location != nil && (addr.Location.Lat < -90 || addr.Location.Lat > 90 ||
+		addr.Location.Lng < -180 || addr.Location.Lng > 180) {
+		return fmt.Errorf("%w: coordinates out of range", ErrInvalidInput)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+func TestOrderUseCase_AddressBook(t *testing.T) {
+	uc := newTestUseCase(NewMockRepo())
+	ctx := context.Background()
+
+	home, err := uc.AddAddress(ctx, AddAddressInput{
+		CustomerID: "cust1",
+		Label:      "Home",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Arbat", House: "10", Apartment: "5", Floor: "2"},
+	})
+	if err != nil {
+		t.Fatalf("failed to add address: %v", err)
+	}
+	if !home.IsDefault {
+		t.Error("first address must become default")
+	}
+
+	work, err := uc.AddAddress(ctx, AddAddressInput{
+		CustomerID:  "cust1",
+		Label:       "Work",
+		Address:     orders.DeliveryAddress{City: "Moscow", Street: "Tverskaya", House: "1", Comment: "reception"},
+		MakeDefault: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to add address: %v", err)
+	}
+
+	list, err := uc.ListAddresses(ctx, "cust1")
+	if err != nil {
+		t.Fatalf("failed to list: %v", err)
+	}
+	if len(list) != 2 || list[0].IsDefault || !list[1].IsDefault {
+		t.Fatalf("expected work address to be the only default, got %+v", list)
+	}
+
+	if _, err := uc.UpdateAddress(ctx, UpdateAddressInput{
+		CustomerID: "cust2", AddressID: home.ID, Address: home.Address,
+	}); !errors.Is(err, orders.ErrAddressNotFound) {
+		t.Errorf("expected foreign address to be hidden, got %v", err)
+	}
+
+	items := []OrderItemInput{{ProductID: "p1", Quantity: 1}}
+
+	// Без адреса заказ уходит на основной адрес, по ID - на выбранный
+	order, err := uc.CreateOrder(ctx, CreateOrderInput{CustomerID: "cust1", Items: items})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	if order.Address() != work.Address {
+		t.Errorf("expected default address, got %+v", order.Address())
+	}
+	order, err = uc.CreateOrder(ctx, CreateOrderInput{CustomerID: "cust1", AddressID: home.ID, Items: items})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	if order.Address().House != "10" || order.Address().Floor != "2" {
+		t.Errorf("expected home address, got %+v", order.Address())
+	}
+	if _, err := uc.CreateOrder(ctx, CreateOrderInput{CustomerID: "cust2", AddressID: home.ID, Items: items}); !errors.Is(err, orders.ErrAddressNotFound) {
+		t.Errorf("expected ErrAddressNotFound for foreign address, got %v", err)
+	}
+
+	if err := uc.DeleteAddress(ctx, "cust1", work.ID); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	list, _ = uc.ListAddresses(ctx, "cust1")
+	if len(list) != 1 || list[0].ID != home.ID || !list[0].IsDefault {
+		t.Errorf("expected remaining address to become default, got %+v", list)
+	}
+}
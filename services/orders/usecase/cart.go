@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+func (uc *OrderUseCase) AddItem(ctx context.Context, orderID string, item OrderItemInput) (*orders.Order, error) {
+	return uc.editCart(ctx, orderID, "add item to", func(o *orders.Order) error {
+		return uc.addPricedItems(ctx, o, []OrderItemInput{item})
+	})
+}
+
+// UpdateItemInput - изменение строки корзины. Нулевое количество не меняется,
+// топпинги заменяются только при ReplaceToppings.
+type UpdateItemInput struct {
+	OrderID         string
+	LineID          string
+	Quantity        int
+	ReplaceToppings bool
+	Toppings        []string
+}
+
+func (uc *OrderUseCase) UpdateItem(ctx context.Context, input UpdateItemInput) (*orders.Order, error) {
+	if input.Quantity < 0 {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidInput, orders.ErrInvalidQty)
+	}
+
+	return uc.editCart(ctx, input.OrderID, "update item of", func(o *orders.Order) error {
+		if input.Quantity > 0 {
+			if err := o.UpdateItemQuantity(input.LineID, input.Quantity); err != nil {
+				return err
+			}
+		}
+		if !input.ReplaceToppings {
+			return nil
+		}
+
+		item, ok := o.Item(input.LineID)
+		if !ok {
+			return fmt.Errorf("%w: %s", orders.ErrItemNotFound, input.LineID)
+		}
+		productID, halfID := lineProducts(item)
+		ids := []string{productID}
+		if halfID != "" {
+			ids = append(ids, halfID)
+		}
+		products, err := uc.pricer.PriceProducts(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to fetch prices from catalog: %w", err)
+		}
+		// Для пиццы "пополам" доступны топпинги обеих половин
+		product, _, err := uc.itemProduct(products, productID, halfID)
+		if err != nil {
+			return err
+		}
+		toppings, err := product.ResolveToppings(input.Toppings, item.Size().Code)
+		if err != nil {
+			return err
+		}
+		return o.ReplaceToppings(input.LineID, toppings)
+	})
+}
+
+func (uc *OrderUseCase) RemoveItem(ctx context.Context, orderID, lineID string) (*orders.Order, error) {
+	return uc.editCart(ctx, orderID, "remove item from", func(o *orders.Order) error {
+		return o.RemoveItem(lineID)
+	})
+}
+
+// editCart - правка корзины с пересчетом доставки и промокода.
+// После запуска оплаты корзина не меняется, чтобы сумма заказа совпала с платежом.
+func (uc *OrderUseCase) editCart(ctx context.Context, orderID, action string, edit func(*orders.Order) error) (*orders.Order, error) {
+	return uc.transition(ctx, orderID, action, func(o *orders.Order) error {
+		if err := edit(o); err != nil {
+			return err
+		}
+		return uc.refreshTotals(ctx, o)
+	})
+}
+
+// refreshTotals - пересчитывает доставку и сборы по новой сумме позиций и скидку по промокоду.
+// Промокод, который перестал подходить к заказу, снимается.
+func (uc *OrderUseCase) refreshTotals(ctx context.Context, o *orders.Order) error {
+	if err := uc.policy.CheckCustomer(o.CustomerID()); err != nil {
+		return err
+	}
+	if err := uc.policy.CheckItems(o); err != nil {
+		return err
+	}
+	delivery, err := uc.delivery.QuoteDelivery(o.Address(), o.ItemsTotal(), time.Now())
+	if err != nil {
+		return err
+	}
+	if err := o.SetDeliveryPrice(delivery.Price); err != nil {
+		return err
+	}
+	if err := uc.applyFees(o, o.PaymentMethod()); err != nil {
+		return err
+	}
+	if err := uc.assignStores(ctx, o, delivery.ZoneID); err != nil {
+		return err
+	}
+
+	if o.PromoCode() == "" {
+		return nil
+	}
+
+	promo, err := uc.promos.FindByCode(ctx, o.PromoCode())
+	if err != nil && !errors.Is(err, orders.ErrPromoNotFound) {
+		return fmt.Errorf("failed to find promo %s: %w", o.PromoCode(), err)
+	}
+	if err == nil {
+		discount, evalErr := uc.evaluatePromo(ctx, o, promo)
+		if evalErr == nil {
+			return uc.redeemPromo(ctx, o, promo, discount)
+		}
+		if !orders.IsPromoRejection(evalErr) {
+			return evalErr
+		}
+	}
+
+	if err := uc.promos.Release(ctx, o.ID()); err != nil {
+		return fmt.Errorf("failed to release promo of order %s: %w", o.ID(), err)
+	}
+	return o.ClearPromoCode()
+}
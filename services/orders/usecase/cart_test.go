@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+func TestOrderUseCase_EditCart(t *testing.T) {
+	repo := NewMockRepo()
+	tariff := &orders.DeliveryTariff{
+		Zones: []orders.DeliveryZone{{ID: "any", Name: "Any", BasePrice: common.NewMoney(200), FreeDeliveryFrom: common.NewMoney(1000)}},
+	}
+	promos := repository.NewInMemoryPromoRepository()
+	uc := NewOrderUseCase(repo, defaultPricer(), promos, tariff, alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	ctx := context.Background()
+
+	_, err := uc.CreatePromo(ctx, &orders.Promo{
+		Code:          "BIG",
+		Type:          orders.PromoFixedAmount,
+		Amount:        common.NewMoney(100),
+		Active:        true,
+		ValidFrom:     time.Now().Add(-time.Hour),
+		MinOrderValue: common.NewMoney(1000),
+	})
+	if err != nil {
+		t.Fatalf("failed to create promo: %v", err)
+	}
+
+	order, err := uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Arbat"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	if _, err := uc.ApplyPromo(ctx, order.ID(), "BIG"); err != nil {
+		t.Fatalf("failed to apply promo: %v", err)
+	}
+	line := order.Items()[0].ID()
+
+	// 2 * (500 + 50): доставка бесплатна, промокод действует
+	order, err = uc.UpdateItem(ctx, UpdateItemInput{
+		OrderID: order.ID(), LineID: line, ReplaceToppings: true, Toppings: []string{"Cheese"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !order.FinalPrice().Equal(common.NewMoney(1000)) || order.PromoCode() != "BIG" {
+		t.Errorf("expected 1000 with promo, got %v with %q", order.FinalPrice(), order.PromoCode())
+	}
+
+	// 1 * 550: появляется доставка, промокод перестает подходить по минимальной сумме
+	order, err = uc.UpdateItem(ctx, UpdateItemInput{OrderID: order.ID(), LineID: line, Quantity: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !order.FinalPrice().Equal(common.NewMoney(750)) || order.PromoCode() != "" {
+		t.Errorf("expected 750 without promo, got %v with %q", order.FinalPrice(), order.PromoCode())
+	}
+	if usage, _ := promos.Usage(ctx, "BIG", "cust1", ""); usage.Total != 0 {
+		t.Errorf("expected promo redemption to be released, got %+v", usage)
+	}
+
+	order, err = uc.AddItem(ctx, order.ID(), OrderItemInput{ProductID: "p1", Quantity: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(order.Items()) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(order.Items()))
+	}
+
+	order, err = uc.RemoveItem(ctx, order.ID(), line)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(order.Items()) != 1 || order.Items()[0].ID() == line {
+		t.Errorf("expected the first line to be removed")
+	}
+
+	if _, err := uc.AddItem(ctx, order.ID(), OrderItemInput{ProductID: "p2", Quantity: 1}); !errors.Is(err, orders.ErrProductUnavailable) {
+		t.Errorf("expected ErrProductUnavailable, got %v", err)
+	}
+
+	_, _ = uc.PayOrder(ctx, TransitionInput{OrderID: order.ID()})
+	if _, err := uc.RemoveItem(ctx, order.ID(), order.Items()[0].ID()); !errors.Is(err, orders.ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// IdempotencyUseCase - повторное выполнение запросов с тем же ключом
+// возвращает сохраненный ответ вместо новой операции.
+type IdempotencyUseCase struct {
+	store orders.IdempotencyStore
+	ttl   time.Duration
+	// lockTimeout - сколько ключ занят выполняемым запросом, прежде чем повтор сможет его перехватить.
+	lockTimeout time.Duration
+}
+
+func NewIdempotencyUseCase(store orders.IdempotencyStore, ttl, lockTimeout time.Duration) *IdempotencyUseCase {
+	return &IdempotencyUseCase{store: store, ttl: ttl, lockTimeout: lockTimeout}
+}
+
+// Do - выполняет run не более одного раза на ключ. Без ключа run выполняется как есть.
+// Ответ сериализует вызывающий: use case не знает транспортного формата.
+func (uc *IdempotencyUseCase) Do(ctx context.Context, operation, key, requestHash string, run func() ([]byte, error)) ([]byte, error) {
+	if key == "" {
+		return run()
+	}
+
+	now := time.Now()
+	token, _ := uuid.NewV7()
+	existing, reserved, err := uc.store.Reserve(ctx, orders.IdempotencyRecord{
+		Operation:   operation,
+		Key:         key,
+		Token:       token.String(),
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(uc.ttl),
+		LockedUntil: now.Add(uc.lockTimeout),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	if !reserved {
+		if existing.RequestHash != requestHash {
+			return nil, fmt.Errorf("%w: %s", orders.ErrIdempotencyKeyReused, key)
+		}
+		if !existing.Completed() {
+			return nil, fmt.Errorf("%w: %s", orders.ErrIdempotencyKeyInFlight, key)
+		}
+		return existing.Response, nil
+	}
+
+	response, err := run()
+	// Резерв снимается и после отключения клиента, иначе ключ останется занятым до lockTimeout
+	storeCtx := context.WithoutCancel(ctx)
+	if err != nil {
+		// Ошибки не кешируются: исправленный повтор с тем же ключом выполнится заново
+		if releaseErr := uc.store.Release(storeCtx, operation, key, token.String()); releaseErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to release idempotency key: %w", releaseErr))
+		}
+		return nil, err
+	}
+
+	if err := uc.store.Complete(storeCtx, operation, key, token.String(), response); err != nil {
+		return nil, fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return response, nil
+}
+
+// PurgeExpired - удаляет ключи с истекшим TTL, вызывается планировщиком.
+func (uc *IdempotencyUseCase) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	purged, err := uc.store.PurgeExpired(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+	return purged, nil
+}
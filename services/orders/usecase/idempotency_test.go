@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+func TestIdempotencyUseCase_Do(t *testing.T) {
+	uc := NewIdempotencyUseCase(repository.NewInMemoryIdempotencyStore(), time.Hour, time.Minute)
+	ctx := context.Background()
+
+	calls := 0
+	run := func() ([]byte, error) {
+		calls++
+		return []byte("order-1"), nil
+	}
+
+	first, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", run)
+	if err != nil {
+		t.Fatalf("first call failed: %v", err)
+	}
+	retry, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", run)
+	if err != nil {
+		t.Fatalf("retry failed: %v", err)
+	}
+	if calls != 1 || string(first) != string(retry) {
+		t.Errorf("expected stored response on retry, got %d calls, %q vs %q", calls, first, retry)
+	}
+
+	if _, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-b", run); !errors.Is(err, orders.ErrIdempotencyKeyReused) {
+		t.Errorf("expected ErrIdempotencyKeyReused, got %v", err)
+	}
+
+	// Ключ действует в пределах операции
+	if _, err := uc.Do(ctx, "PayOrder", "key-1", "hash-b", run); err != nil || calls != 2 {
+		t.Errorf("expected separate key space per operation, got %d calls (%v)", calls, err)
+	}
+
+	// Без ключа запрос выполняется каждый раз
+	for range 2 {
+		if _, err := uc.Do(ctx, "CreateOrder", "", "hash-a", run); err != nil {
+			t.Fatalf("call without key failed: %v", err)
+		}
+	}
+	if calls != 4 {
+		t.Errorf("expected requests without key to run every time, got %d calls", calls)
+	}
+}
+
+func TestIdempotencyUseCase_FailureAndExpiry(t *testing.T) {
+	uc := NewIdempotencyUseCase(repository.NewInMemoryIdempotencyStore(), 10*time.Millisecond, time.Minute)
+	ctx := context.Background()
+
+	failed := errors.New("catalog unavailable")
+	if _, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", func() ([]byte, error) { return nil, failed }); !errors.Is(err, failed) {
+		t.Fatalf("expected run error, got %v", err)
+	}
+
+	// Ошибка не запоминается, повтор выполняется заново
+	res, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", func() ([]byte, error) { return []byte("ok"), nil })
+	if err != nil || string(res) != "ok" {
+		t.Fatalf("expected retry after failure to run, got %q (%v)", res, err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	res, err = uc.Do(ctx, "CreateOrder", "key-1", "hash-b", func() ([]byte, error) { return []byte("new"), nil })
+	if err != nil || string(res) != "new" {
+		t.Errorf("expected expired key to be reusable, got %q (%v)", res, err)
+	}
+}
+
+func TestIdempotencyUseCase_StaleReservation(t *testing.T) {
+	store := repository.NewInMemoryIdempotencyStore()
+	uc := NewIdempotencyUseCase(store, time.Hour, 10*time.Millisecond)
+	ctx := context.Background()
+
+	// Процесс упал между резервом и сохранением ответа
+	now := time.Now()
+	if _, reserved, err := store.Reserve(ctx, orders.IdempotencyRecord{
+		Operation: "CreateOrder", Key: "key-1", RequestHash: "hash-a",
+		CreatedAt: now, ExpiresAt: now.Add(time.Hour), LockedUntil: now.Add(10 * time.Millisecond),
+	}); err != nil || !reserved {
+		t.Fatalf("failed to reserve: %v", err)
+	}
+
+	run := func() ([]byte, error) { return []byte("order-1"), nil }
+	if _, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", run); !errors.Is(err, orders.ErrIdempotencyKeyInFlight) {
+		t.Fatalf("expected ErrIdempotencyKeyInFlight while reservation is fresh, got %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	res, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", run)
+	if err != nil || string(res) != "order-1" {
+		t.Fatalf("expected stale reservation to be taken over, got %q (%v)", res, err)
+	}
+	if res, _ := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", func() ([]byte, error) { return []byte("again"), nil }); string(res) != "order-1" {
+		t.Errorf("expected stored response after takeover, got %q", res)
+	}
+}
+
+func TestIdempotencyUseCase_LateCallKeepsTakeover(t *testing.T) {
+	uc := NewIdempotencyUseCase(repository.NewInMemoryIdempotencyStore(), time.Hour, 10*time.Millisecond)
+	ctx := context.Background()
+
+	for key, lateErr := range map[string]error{"key-ok": nil, "key-failed": errors.New("catalog unavailable")} {
+		// Первый запрос завис дольше lockTimeout, ключ перехватил повтор
+		_, _ = uc.Do(ctx, "CreateOrder", key, "hash-a", func() ([]byte, error) {
+			time.Sleep(20 * time.Millisecond)
+			res, err := uc.Do(ctx, "CreateOrder", key, "hash-a", func() ([]byte, error) { return []byte("retry"), nil })
+			if err != nil || string(res) != "retry" {
+				t.Fatalf("expected takeover, got %q (%v)", res, err)
+			}
+			return []byte("late"), lateErr
+		})
+
+		res, err := uc.Do(ctx, "CreateOrder", key, "hash-a", func() ([]byte, error) { return []byte("again"), nil })
+		if err != nil || string(res) != "retry" {
+			t.Errorf("expected late call (err=%v) to keep retry response, got %q (%v)", lateErr, res, err)
+		}
+	}
+}
+
+func TestIdempotencyUseCase_PurgeExpired(t *testing.T) {
+	uc := NewIdempotencyUseCase(repository.NewInMemoryIdempotencyStore(), time.Minute, time.Minute)
+	ctx := context.Background()
+
+	if _, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", func() ([]byte, error) { return []byte("ok"), nil }); err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if purged, err := uc.PurgeExpired(ctx, time.Now()); err != nil || purged != 0 {
+		t.Errorf("expected live key to stay, got %d purged (%v)", purged, err)
+	}
+	if purged, err := uc.PurgeExpired(ctx, time.Now().Add(2*time.Minute)); err != nil || purged != 1 {
+		t.Errorf("expected expired key to be purged, got %d (%v)", purged, err)
+	}
+}
+
+// ctxAwareStore - хранилище, которое, как и база, отказывает по отмененному контексту.
+type ctxAwareStore struct {
+	orders.IdempotencyStore
+}
+
+func (s ctxAwareStore) Release(ctx context.Context, operation, key, token string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.IdempotencyStore.Release(ctx, operation, key, token)
+}
+
+func TestIdempotencyUseCase_ReleasesOnCanceledContext(t *testing.T) {
+	uc := NewIdempotencyUseCase(ctxAwareStore{repository.NewInMemoryIdempotencyStore()}, time.Hour, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Клиент отключился во время запроса
+	_, err := uc.Do(ctx, "CreateOrder", "key-1", "hash-a", func() ([]byte, error) {
+		cancel()
+		return nil, context.Canceled
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	res, err := uc.Do(context.Background(), "CreateOrder", "key-1", "hash-a", func() ([]byte, error) { return []byte("ok"), nil })
+	if err != nil || string(res) != "ok" {
+		t.Errorf("expected key to be released, got %q (%v)", res, err)
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// OutboxRelay - переносит события из outbox в брокер.
+type OutboxRelay struct {
+	outbox    orders.Outbox
+	publisher orders.EventPublisher
+	batchSize int
+}
+
+func NewOutboxRelay(outbox orders.Outbox, publisher orders.EventPublisher, batchSize int) *OutboxRelay {
+	return &OutboxRelay{outbox: outbox, publisher: publisher, batchSize: batchSize}
+}
+
+// RelayPending - публикует накопленные события по порядку записи и возвращает их число.
+// На первой ошибке проход останавливается: следующие события не обгоняют неотправленное.
+func (r *OutboxRelay) RelayPending(ctx context.Context, now time.Time) (int, error) {
+	pending, err := r.outbox.FetchPending(ctx, r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch outbox: %w", err)
+	}
+
+	for i, msg := range pending {
+		if err := r.publisher.Publish(ctx, msg); err != nil {
+			if markErr := r.outbox.MarkFailed(ctx, msg.ID, err); markErr != nil {
+				err = errors.Join(err, markErr)
+			}
+			return i, fmt.Errorf("failed to publish event %s: %w", msg.ID, err)
+		}
+		// Если отметка не сохранится, событие уйдет повторно: получатели дедуплицируют по ID
+		if err := r.outbox.MarkPublished(ctx, msg.ID, now); err != nil {
+			return i + 1, err
+		}
+	}
+	return len(pending), nil
+}
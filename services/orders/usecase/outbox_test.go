@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/broker"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	repo, outbox := repository.NewInMemoryOrderStore()
+	uc := NewOrderUseCase(repo, defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	ctx := context.Background()
+
+	order, err := uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	if _, err := uc.PayOrder(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to pay: %v", err)
+	}
+
+	bus := broker.NewInMemoryBroker()
+	var received []orders.EventType
+	failing := true
+	bus.Subscribe("", func(ctx context.Context, msg orders.OutboxMessage) error {
+		if failing {
+			return errors.New("consumer is down")
+		}
+		received = append(received, msg.Topic)
+		return nil
+	})
+	relay := NewOutboxRelay(outbox, bus, 10)
+
+	if n, err := relay.RelayPending(ctx, time.Now()); err == nil || n != 0 {
+		t.Fatalf("expected failed pass, got %d published (%v)", n, err)
+	}
+
+	failing = false
+	n, err := relay.RelayPending(ctx, time.Now())
+	if err != nil {
+		t.Fatalf("failed to relay: %v", err)
+	}
+	if n != 2 || len(received) != 2 || received[0] != orders.EventOrderCreated || received[1] != orders.EventOrderPaid {
+		t.Fatalf("expected created and paid in order, got %v", received)
+	}
+
+	if n, err := relay.RelayPending(ctx, time.Now()); err != nil || n != 0 {
+		t.Errorf("expected empty outbox after relay, got %d (%v)", n, err)
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// checkOpen - заказ без времени доставки принимается только в часы работы.
+func (uc *OrderUseCase) checkOpen(now time.Time) error {
+	if !uc.schedule.IsOpen(now) {
+		return fmt.Errorf("%w: %s", orders.ErrStoreClosed, now.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// checkDeliverySlot - время предзаказа в часах работы и в слоте есть место.
+func (uc *OrderUseCase) checkDeliverySlot(ctx context.Context, at time.Time) error {
+	if err := uc.schedule.ValidateDeliveryTime(at, time.Now()); err != nil {
+		return err
+	}
+	if uc.schedule.SlotCapacity <= 0 {
+		return nil
+	}
+
+	from, to := uc.schedule.Slot(at)
+	booked, err := uc.repo.List(ctx, orders.OrderFilter{ScheduledFrom: from, ScheduledTo: to})
+	if err != nil {
+		return fmt.Errorf("failed to load booked slot: %w", err)
+	}
+
+	// Брошенные корзины места не держат. Окончательно место бронируется при оформлении
+	taken := 0
+	for _, o := range booked {
+		if o.Status() != orders.StatusCanceled && (o.CheckoutStarted() || o.Status() != orders.StatusCreated) {
+			taken++
+		}
+	}
+	if taken >= uc.schedule.SlotCapacity {
+		return fmt.Errorf("%w: %s - %s", orders.ErrSlotFull, from.Format("15:04"), to.Format("15:04"))
+	}
+	return nil
+}
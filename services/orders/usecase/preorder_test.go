@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+func TestOrderUseCase_PreOrders(t *testing.T) {
+	repo := NewMockRepo()
+	schedule := alwaysOpen()
+	schedule.SlotCapacity = 1
+	schedule.MinAdvance = time.Hour
+	uc := NewOrderUseCase(repo, defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), schedule, repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	ctx := context.Background()
+
+	deliverAt := time.Now().Add(3 * time.Hour)
+	input := CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+		DeliverAt:  deliverAt,
+	}
+
+	soon := input
+	soon.DeliverAt = time.Now().Add(10 * time.Minute)
+	if _, err := uc.CreateOrder(ctx, soon); !errors.Is(err, orders.ErrDeliveryTooSoon) {
+		t.Fatalf("expected ErrDeliveryTooSoon, got %v", err)
+	}
+
+	order, err := uc.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("failed to create pre-order: %v", err)
+	}
+	if !order.IsPreOrder() || !order.ScheduledFor().Equal(deliverAt) {
+		t.Fatalf("expected pre-order for %v, got %v", deliverAt, order.ScheduledFor())
+	}
+
+	// Неоплаченная корзина место в слоте не держит
+	if _, err := uc.CreateOrder(ctx, input); err != nil {
+		t.Fatalf("unpaid cart must not take the slot: %v", err)
+	}
+
+	if _, err := uc.PayOrder(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to pay: %v", err)
+	}
+	if _, err := uc.CreateOrder(ctx, input); !errors.Is(err, orders.ErrSlotFull) {
+		t.Fatalf("expected ErrSlotFull, got %v", err)
+	}
+	operator := orders.Actor{ID: "op1", Role: orders.RoleOperator}
+	if _, err := uc.SendToKitchen(ctx, TransitionInput{OrderID: order.ID(), Actor: operator}); !errors.Is(err, orders.ErrPreOrderHeld) {
+		t.Fatalf("expected ErrPreOrderHeld, got %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+func (uc *OrderUseCase) CreatePromo(ctx context.Context, promo *orders.Promo) (*orders.Promo, error) {
+	promo.Code = orders.NormalizePromoCode(promo.Code)
+	if err := promo.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.promos.Create(ctx, promo); err != nil {
+		return nil, fmt.Errorf("failed to create promo %s: %w", promo.Code, err)
+	}
+	return promo, nil
+}
+
+// UpdatePromo - меняет условия существующего промокода. Уже сделанные применения сохраняются.
+func (uc *OrderUseCase) UpdatePromo(ctx context.Context, promo *orders.Promo) (*orders.Promo, error) {
+	promo.Code = orders.NormalizePromoCode(promo.Code)
+	if err := promo.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.promos.Update(ctx, promo); err != nil {
+		return nil, fmt.Errorf("failed to update promo %s: %w", promo.Code, err)
+	}
+	return promo, nil
+}
+
+// PromoQuote - результат проверки промокода для заказа.
+type PromoQuote struct {
+	Discount   common.Money
+	FinalPrice common.Money
+}
+
+// ValidatePromo - рассчитывает скидку по промокоду, не применяя его к заказу.
+func (uc *OrderUseCase) ValidatePromo(ctx context.Context, orderID, code string) (*PromoQuote, error) {
+	order, promo, err := uc.loadPromoTarget(ctx, orderID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := uc.evaluatePromo(ctx, order, promo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Новый промокод заменяет уже примененную к заказу скидку
+	return &PromoQuote{
+		Discount:   discount,
+		FinalPrice: order.FinalPrice().Add(order.Discount()).Sub(discount),
+	}, nil
+}
+
+// ApplyPromo - применяет промокод к заказу и фиксирует его использование.
+// Повторное применение заменяет ранее примененный к заказу промокод.
+func (uc *OrderUseCase) ApplyPromo(ctx context.Context, orderID, code string) (*orders.Order, error) {
+	return retryOnConflict(func() (*orders.Order, error) {
+		order, promo, err := uc.loadPromoTarget(ctx, orderID, code)
+		if err != nil {
+			return nil, err
+		}
+		if !order.Editable() {
+			return nil, fmt.Errorf("could not apply promo to order %s: %w", orderID, orders.ErrOrderLocked)
+		}
+
+		discount, err := uc.evaluatePromo(ctx, order, promo)
+		if err != nil {
+			return nil, err
+		}
+
+		prevCode, prevDiscount := order.PromoCode(), order.Discount()
+		if err := uc.redeemPromo(ctx, order, promo, discount); err != nil {
+			return nil, err
+		}
+		if err := uc.repo.Save(ctx, order); err != nil {
+			// Скидка не попала в заказ: применение не должно расходовать лимиты промокода
+			if undoErr := uc.undoRedemption(context.WithoutCancel(ctx), order, prevCode, prevDiscount); undoErr != nil {
+				err = errors.Join(err, undoErr)
+			}
+			return nil, fmt.Errorf("failed to update order %s: %w", orderID, err)
+		}
+		return order, nil
+	})
+}
+
+// undoRedemption - возвращает учет промокода к сохраненному состоянию заказа: снимает
+// новое применение или восстанавливает промокод, который был у заказа раньше.
+func (uc *OrderUseCase) undoRedemption(ctx context.Context, order *orders.Order, prevCode string, prevDiscount common.Money) error {
+	if prevCode == "" {
+		if err := uc.promos.Release(ctx, order.ID()); err != nil {
+			return fmt.Errorf("failed to release promo of order %s: %w", order.ID(), err)
+		}
+		return nil
+	}
+
+	prev, err := uc.promos.FindByCode(ctx, prevCode)
+	if err != nil {
+		return fmt.Errorf("failed to find promo %s: %w", prevCode, err)
+	}
+	if err := uc.promos.Redeem(ctx, orders.Redemption{
+		Code:       prevCode,
+		OrderID:    order.ID(),
+		CustomerID: order.CustomerID(),
+		Discount:   prevDiscount,
+		RedeemedAt: time.Now(),
+	}, prev); err != nil {
+		return fmt.Errorf("failed to restore promo %s of order %s: %w", prevCode, order.ID(), err)
+	}
+	return nil
+}
+
+// redeemPromo - фиксирует применение промокода и проставляет скидку в заказ.
+func (uc *OrderUseCase) redeemPromo(ctx context.Context, order *orders.Order, promo *orders.Promo, discount common.Money) error {
+	redemption := orders.Redemption{
+		Code:       promo.Code,
+		OrderID:    order.ID(),
+		CustomerID: order.CustomerID(),
+		Discount:   discount,
+		RedeemedAt: time.Now(),
+	}
+	if err := uc.promos.Redeem(ctx, redemption, promo); err != nil {
+		return fmt.Errorf("failed to redeem promo %s: %w", promo.Code, err)
+	}
+
+	if err := order.ApplyPromoCode(promo.Code, discount); err != nil {
+		return fmt.Errorf("could not apply promo to order %s: %w", order.ID(), err)
+	}
+	return nil
+}
+
+func (uc *OrderUseCase) loadPromoTarget(ctx context.Context, orderID, code string) (*orders.Order, *orders.Promo, error) {
+	code = orders.NormalizePromoCode(code)
+	if orderID == "" || code == "" {
+		return nil, nil, fmt.Errorf("%w: order ID and promo code are required", ErrInvalidInput)
+	}
+
+	order, err := uc.repo.FindByID(ctx, orderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find order %s: %w", orderID, err)
+	}
+
+	promo, err := uc.promos.FindByCode(ctx, code)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find promo %s: %w", code, err)
+	}
+
+	return order, promo, nil
+}
+
+func (uc *OrderUseCase) evaluatePromo(ctx context.Context, order *orders.Order, promo *orders.Promo) (common.Money, error) {
+	usage, err := uc.promos.Usage(ctx, promo.Code, order.CustomerID(), order.ID())
+	if err != nil {
+		return common.ZeroMoney(), fmt.Errorf("failed to load usage of promo %s: %w", promo.Code, err)
+	}
+	if err := promo.CheckUsage(usage); err != nil {
+		return common.ZeroMoney(), err
+	}
+
+	pc := orders.PromoContext{Now: time.Now()}
+
+	// Категории нужны только для промокодов, ограниченных категориями
+	if len(promo.CategoryIDs) > 0 {
+		ids := make([]string, 0, len(order.Items()))
+		for _, item := range order.Items() {
+			ids = append(ids, item.ProductID())
+		}
+		products, err := uc.pricer.PriceProducts(ctx, ids)
+		if err != nil {
+			return common.ZeroMoney(), fmt.Errorf("failed to fetch categories from catalog: %w", err)
+		}
+		pc.Categories = make(map[string]int, len(products))
+		for id, p := range products {
+			pc.Categories[id] = p.CategoryID
+		}
+	}
+
+	return promo.Evaluate(order, pc)
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+func TestOrderUseCase_ApplyPromo(t *testing.T) {
+	repo := NewMockRepo()
+	uc := newTestUseCase(repo)
+	ctx := context.Background()
+
+	_, err := uc.CreatePromo(ctx, &orders.Promo{
+		Code:             " once ",
+		Type:             orders.PromoFixedAmount,
+		Amount:           common.NewMoney(100),
+		Active:           true,
+		ValidFrom:        time.Now().Add(-time.Hour),
+		PerCustomerLimit: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to create promo: %v", err)
+	}
+
+	newOrder := func() *orders.Order {
+		o, err := uc.CreateOrder(ctx, CreateOrderInput{
+			CustomerID: "cust1",
+			Address:    orders.DeliveryAddress{City: "Moscow", Street: "Arbat"},
+			Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+		})
+		if err != nil {
+			t.Fatalf("failed to create order: %v", err)
+		}
+		return o
+	}
+
+	first := newOrder()
+	quote, err := uc.ValidatePromo(ctx, first.ID(), "ONCE")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !quote.Discount.Equal(common.NewMoney(100)) || !quote.FinalPrice.Equal(common.NewMoney(400)) {
+		t.Errorf("expected discount 100 and price 400, got %v and %v", quote.Discount, quote.FinalPrice)
+	}
+
+	applied, err := uc.ApplyPromo(ctx, first.ID(), "once")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !applied.FinalPrice().Equal(common.NewMoney(400)) || applied.PromoCode() != "ONCE" {
+		t.Errorf("expected 400 with ONCE, got %v with %s", applied.FinalPrice(), applied.PromoCode())
+	}
+
+	// Повторное применение к тому же заказу не расходует лимит
+	if _, err := uc.ApplyPromo(ctx, first.ID(), "ONCE"); err != nil {
+		t.Errorf("reapplying to the same order should succeed, got %v", err)
+	}
+
+	second := newOrder()
+	if _, err := uc.ApplyPromo(ctx, second.ID(), "ONCE"); !errors.Is(err, orders.ErrPromoCustomerLimit) {
+		t.Errorf("expected ErrPromoCustomerLimit, got %v", err)
+	}
+
+	// После отмены первого заказа промокод снова доступен
+	_, err = uc.CancelOrder(ctx, CancelOrderInput{
+		OrderID: first.ID(),
+		Reason:  orders.CancelReasonCustomerRequest,
+		Actor:   orders.Actor{ID: "cust1", Role: orders.RoleCustomer},
+	})
+	if err != nil {
+		t.Fatalf("failed to cancel order: %v", err)
+	}
+	if _, err := uc.ApplyPromo(ctx, second.ID(), "ONCE"); err != nil {
+		t.Errorf("expected promo to be released, got %v", err)
+	}
+
+	if _, err := uc.ApplyPromo(ctx, second.ID(), "MISSING"); !errors.Is(err, orders.ErrPromoNotFound) {
+		t.Errorf("expected ErrPromoNotFound, got %v", err)
+	}
+}
+
+func TestOrderUseCase_ApplyPromoReleasesOnFailedSave(t *testing.T) {
+	ctx := context.Background()
+	repo := &racingRepo{OrderRepository: repository.NewInMemoryOrderRepository()}
+	uc := NewOrderUseCase(repo, defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+
+	for _, code := range []string{"ONCE", "OTHER"} {
+		_, err := uc.CreatePromo(ctx, &orders.Promo{
+			Code:             code,
+			Type:             orders.PromoFixedAmount,
+			Amount:           common.NewMoney(100),
+			Active:           true,
+			ValidFrom:        time.Now().Add(-time.Hour),
+			PerCustomerLimit: 1,
+		})
+		if err != nil {
+			t.Fatalf("failed to create promo: %v", err)
+		}
+	}
+
+	newOrder := func() *orders.Order {
+		o, err := uc.CreateOrder(ctx, CreateOrderInput{
+			CustomerID: "cust1",
+			Address:    orders.DeliveryAddress{City: "Moscow", Street: "Arbat"},
+			Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+		})
+		if err != nil {
+			t.Fatalf("failed to create order: %v", err)
+		}
+		return o
+	}
+
+	first := newOrder()
+	repo.conflicts = maxSaveAttempts
+	if _, err := uc.ApplyPromo(ctx, first.ID(), "ONCE"); !errors.Is(err, orders.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+
+	// Несохраненное применение не расходует лимит покупателя
+	second := newOrder()
+	if _, err := uc.ApplyPromo(ctx, second.ID(), "ONCE"); err != nil {
+		t.Fatalf("expected promo to stay available, got %v", err)
+	}
+
+	// При неудачной замене у заказа остается прежний промокод
+	repo.conflicts = maxSaveAttempts
+	if _, err := uc.ApplyPromo(ctx, second.ID(), "OTHER"); !errors.Is(err, orders.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	if _, err := uc.ApplyPromo(ctx, newOrder().ID(), "ONCE"); !errors.Is(err, orders.ErrPromoCustomerLimit) {
+		t.Errorf("expected previous promo to stay redeemed, got %v", err)
+	}
+	if _, err := uc.ApplyPromo(ctx, newOrder().ID(), "OTHER"); err != nil {
+		t.Errorf("expected replacement promo to stay available, got %v", err)
+	}
+}
+
+func TestOrderUseCase_CreatePromoRejectsDuplicate(t *testing.T) {
+	uc := newTestUseCase(NewMockRepo())
+	ctx := context.Background()
+
+	promo := func(amount float64) *orders.Promo {
+		return &orders.Promo{
+			Code:      "summer",
+			Type:      orders.PromoFixedAmount,
+			Amount:    common.NewMoney(amount),
+			Active:    true,
+			ValidFrom: time.Now().Add(-time.Hour),
+		}
+	}
+	if _, err := uc.CreatePromo(ctx, promo(100)); err != nil {
+		t.Fatalf("failed to create promo: %v", err)
+	}
+	if _, err := uc.CreatePromo(ctx, promo(500)); !errors.Is(err, orders.ErrPromoExists) {
+		t.Fatalf("expected ErrPromoExists, got %v", err)
+	}
+
+	order, err := uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Arbat"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	quote, err := uc.ValidatePromo(ctx, order.ID(), "SUMMER")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !quote.Discount.Equal(common.NewMoney(100)) {
+		t.Errorf("duplicate must not change the promo, got discount %v", quote.Discount)
+	}
+
+	if _, err := uc.UpdatePromo(ctx, promo(200)); err != nil {
+		t.Fatalf("failed to update promo: %v", err)
+	}
+	quote, err = uc.ValidatePromo(ctx, order.ID(), "SUMMER")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !quote.Discount.Equal(common.NewMoney(200)) {
+		t.Errorf("expected updated discount 200, got %v", quote.Discount)
+	}
+
+	missing := promo(100)
+	missing.Code = "autumn"
+	if _, err := uc.UpdatePromo(ctx, missing); !errors.Is(err, orders.ErrPromoNotFound) {
+		t.Errorf("expected ErrPromoNotFound, got %v", err)
+	}
+}
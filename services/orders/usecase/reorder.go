@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+)
+
+var (
+	ErrForeignOrder     = errors.New("order belongs to another customer")
+	ErrNothingToReorder = errors.New("none of the ordered products is available")
+)
+
+// ReorderChangeKind - чем новый заказ отличается от исходного.
+type ReorderChangeKind string
+
+const (
+	// ReorderItemDropped - товар снят с продажи или удален из каталога.
+	ReorderItemDropped ReorderChangeKind = "item_dropped"
+	// ReorderToppingDropped - топпинг больше нельзя добавить к товару.
+	ReorderToppingDropped ReorderChangeKind = "topping_dropped"
+	ReorderPriceChanged   ReorderChangeKind = "price_changed"
+)
+
+// ReorderChange - отличие строки нового заказа от исходной.
+type ReorderChange struct {
+	Kind        ReorderChangeKind
+	ProductID   string
+	ProductName string
+	// Topping - название снятого топпинга, только для ReorderToppingDropped.
+	Topping string
+	// OldPrice и NewPrice - стоимость строки в исходном и новом заказе.
+	OldPrice common.Money
+	NewPrice common.Money
+}
+
+type ReorderInput struct {
+	OrderID    string
+	CustomerID string
+}
+
+type ReorderResult struct {
+	Order   *orders.Order
+	Changes []ReorderChange
+}
+
+// Reorder - новый заказ с позициями и топпингами прошлого заказа клиента по текущим ценам каталога.
+// Недоступные товары и топпинги пропускаются, все отличия возвращаются в Changes.
+func (uc *OrderUseCase) Reorder(ctx context.Context, input ReorderInput) (*ReorderResult, error) {
+	if input.CustomerID == "" {
+		return nil, fmt.Errorf("%w: customer ID is required", ErrInvalidInput)
+	}
+
+	original, err := uc.GetOrder(ctx, input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if original.CustomerID() != input.CustomerID {
+		return nil, fmt.Errorf("%w: %s", ErrForeignOrder, input.OrderID)
+	}
+	if err := uc.policy.CheckCustomer(input.CustomerID); err != nil {
+		return nil, err
+	}
+	if err := uc.checkOpen(time.Now()); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(original.Items()))
+	for _, item := range original.Items() {
+		productID, halfID := lineProducts(item)
+		ids = append(ids, productID)
+		if halfID != "" {
+			ids = append(ids, halfID)
+		}
+	}
+	products, err := uc.pricer.PriceProducts(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch prices from catalog: %w", err)
+	}
+
+	order := orders.NewOrder(original.CustomerID(), original.Address())
+	var changes []ReorderChange
+	for _, item := range original.Items() {
+		change := ReorderChange{
+			ProductID:   item.ProductID(),
+			ProductName: item.ProductName(),
+			OldPrice:    item.CalculateTotal(),
+			NewPrice:    common.ZeroMoney(),
+		}
+
+		// Снятая с продажи половина, исчезнувший размер или тесто - позицию не собрать
+		productID, halfID := lineProducts(item)
+		product, halves, err := uc.itemProduct(products, productID, halfID)
+		var opts orders.ItemOptions
+		if err == nil {
+			opts, err = resolveOptions(product, item.Size().Code, item.Crust().Code)
+		}
+		if err != nil || !product.IsAvailable {
+			change.Kind = ReorderItemDropped
+			changes = append(changes, change)
+			continue
+		}
+		opts.Halves = halves
+
+		// Топпинг, который больше нельзя добавить или который превышает новые лимиты, отбрасывается
+		toppings := make([]orders.Topping, 0, len(item.Toppings()))
+		names := make([]string, 0, len(item.Toppings()))
+		for _, t := range item.Toppings() {
+			resolved, err := product.ResolveToppings(append(names, t.Name), opts.Size.Code)
+			if err != nil {
+				dropped := change
+				dropped.Kind = ReorderToppingDropped
+				dropped.Topping = t.Name
+				changes = append(changes, dropped)
+				continue
+			}
+			names = append(names, t.Name)
+			toppings = resolved
+		}
+
+		if err := order.AddItem(
+			product.ProductID,
+			product.Name,
+			item.Quantity(),
+			product.BasePrice,
+			opts,
+			toppings,
+		); err != nil {
+			return nil, fmt.Errorf("failed to add item %s to order: %w", item.ProductID(), err)
+		}
+
+		lines := order.Items()
+		change.NewPrice = lines[len(lines)-1].CalculateTotal()
+		if !change.NewPrice.Equal(change.OldPrice) {
+			change.Kind = ReorderPriceChanged
+			changes = append(changes, change)
+		}
+	}
+
+	if len(order.Items()) == 0 {
+		return nil, fmt.Errorf("%w: order %s", ErrNothingToReorder, input.OrderID)
+	}
+	if err := uc.policy.CheckItems(order); err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.delivery.QuoteDelivery(order.Address(), order.ItemsTotal(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := order.SetDeliveryPrice(delivery.Price); err != nil {
+		return nil, err
+	}
+	// Способ оплаты переносится, чаевые клиент указывает заново
+	if err := uc.applyFees(order, original.PaymentMethod()); err != nil {
+		return nil, err
+	}
+	if err := uc.assignStores(ctx, order, delivery.ZoneID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Save(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to save order to repository: %w", err)
+	}
+	return &ReorderResult{Order: order, Changes: changes}, nil
+}
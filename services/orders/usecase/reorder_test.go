@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+func TestOrderUseCase_Reorder(t *testing.T) {
+	pricer := NewMockPricer(
+		orders.PricedProduct{ProductID: "p1", Name: "Pizza", BasePrice: common.NewMoney(500), IsAvailable: true,
+			AllowedToppings: []orders.AllowedTopping{
+				{Name: "Cheese", Price: common.NewMoney(50)},
+				{Name: "Olives", Price: common.NewMoney(30)},
+			}},
+		orders.PricedProduct{ProductID: "p3", Name: "Cola", BasePrice: common.NewMoney(100), IsAvailable: true},
+		orders.PricedProduct{ProductID: "p4", Name: "Salad", BasePrice: common.NewMoney(300), IsAvailable: true},
+	)
+	repo := NewMockRepo()
+	uc := NewOrderUseCase(repo, pricer, repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	ctx := context.Background()
+
+	original, err := uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items: []OrderItemInput{
+			{ProductID: "p1", Quantity: 2, Toppings: []string{"Cheese", "Olives"}},
+			{ProductID: "p3", Quantity: 1},
+			{ProductID: "p4", Quantity: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	// С прошлой пятницы каталог изменился
+	pricer.products["p1"] = orders.PricedProduct{ProductID: "p1", Name: "Pizza", BasePrice: common.NewMoney(550), IsAvailable: true,
+		AllowedToppings: []orders.AllowedTopping{{Name: "Cheese", Price: common.NewMoney(50)}}}
+	pricer.products["p3"] = orders.PricedProduct{ProductID: "p3", Name: "Cola", BasePrice: common.NewMoney(100), IsAvailable: false}
+
+	if _, err := uc.Reorder(ctx, ReorderInput{OrderID: original.ID(), CustomerID: "cust2"}); !errors.Is(err, ErrForeignOrder) {
+		t.Fatalf("expected ErrForeignOrder, got %v", err)
+	}
+
+	result, err := uc.Reorder(ctx, ReorderInput{OrderID: original.ID(), CustomerID: "cust1"})
+	if err != nil {
+		t.Fatalf("failed to reorder: %v", err)
+	}
+
+	order := result.Order
+	if order.ID() == original.ID() || order.Status() != orders.StatusCreated || order.Address() != original.Address() {
+		t.Fatalf("expected new created order at the same address, got %+v", order.Snapshot())
+	}
+	items := order.Items()
+	if len(items) != 2 || items[0].ProductID() != "p1" || items[1].ProductID() != "p4" {
+		t.Fatalf("expected pizza and salad, got %d items", len(items))
+	}
+	if len(items[0].Toppings()) != 1 || !items[0].CalculateTotal().Equal(common.NewMoney(1200)) {
+		t.Errorf("expected pizza with cheese at new price, got %s", items[0].CalculateTotal())
+	}
+
+	kinds := make(map[ReorderChangeKind]ReorderChange)
+	for _, c := range result.Changes {
+		kinds[c.Kind] = c
+	}
+	if len(result.Changes) != 3 {
+		t.Fatalf("expected 3 changes, got %+v", result.Changes)
+	}
+	if c := kinds[ReorderItemDropped]; c.ProductID != "p3" {
+		t.Errorf("expected cola to be dropped, got %+v", c)
+	}
+	if c := kinds[ReorderToppingDropped]; c.ProductID != "p1" || c.Topping != "Olives" {
+		t.Errorf("expected olives to be dropped, got %+v", c)
+	}
+	if c := kinds[ReorderPriceChanged]; !c.OldPrice.Equal(common.NewMoney(1160)) || !c.NewPrice.Equal(common.NewMoney(1200)) {
+		t.Errorf("expected pizza price change 1160 -> 1200, got %+v", c)
+	}
+
+	pricer.products["p1"] = orders.PricedProduct{ProductID: "p1", IsAvailable: false}
+	pricer.products["p4"] = orders.PricedProduct{ProductID: "p4", IsAvailable: false}
+	if _, err := uc.Reorder(ctx, ReorderInput{OrderID: original.ID(), CustomerID: "cust1"}); !errors.Is(err, ErrNothingToReorder) {
+		t.Errorf("expected ErrNothingToReorder, got %v", err)
+	}
+}
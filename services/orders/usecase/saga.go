@@ -0,0 +1,457 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// maxSagaSteps - ограничение шагов саги за один проход, чтобы ошибка в переходах не зациклила оркестратор.
+const maxSagaSteps = 8
+
+// SagaTimeouts - сколько ждать каждый внешний шаг до запуска компенсации.
+type SagaTimeouts struct {
+	Payment  time.Duration
+	Kitchen  time.Duration
+	Delivery time.Duration
+}
+
+// OrderSagaUseCase - оркестрация заказа: оплата в treasury, тикет на кухне,
+// доставка в logistics. Внешние шаги опрашиваются, при сбое выполняются компенсации.
+type OrderSagaUseCase struct {
+	orders   *OrderUseCase
+	sagas    orders.SagaRepository
+	slots    orders.SlotBookings
+	payments orders.PaymentGateway
+	kitchen  orders.KitchenGateway
+	delivery orders.DeliveryGateway
+	tracking orders.TrackingPublisher
+	timeouts SagaTimeouts
+}
+
+func NewOrderSagaUseCase(
+	orderUC *OrderUseCase,
+	sagas orders.SagaRepository,
+	slots orders.SlotBookings,
+	payments orders.PaymentGateway,
+	kitchen orders.KitchenGateway,
+	delivery orders.DeliveryGateway,
+	tracking orders.TrackingPublisher,
+	timeouts SagaTimeouts,
+) *OrderSagaUseCase {
+	return &OrderSagaUseCase{
+		orders:   orderUC,
+		sagas:    sagas,
+		slots:    slots,
+		payments: payments,
+		kitchen:  kitchen,
+		delivery: delivery,
+		tracking: tracking,
+		timeouts: timeouts,
+	}
+}
+
+// StartCheckout - запускает оплату заказа. Заказ становится оплаченным после
+// подтверждения платежа, повторный вызов возвращает заказ без нового платежа.
+// Бесплатный заказ и заказ с оплатой курьеру считаются оплаченными сразу.
+// До запуска платежа заказ закрывается для правок: платеж идет на его FinalPrice.
+func (uc *OrderSagaUseCase) StartCheckout(ctx context.Context, in TransitionInput) (*orders.Order, error) {
+	order, err := uc.orders.GetOrder(ctx, in.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.sagas.FindByOrderID(ctx, order.ID())
+	if err == nil {
+		return order, nil
+	}
+	if !errors.Is(err, orders.ErrSagaNotFound) {
+		return nil, fmt.Errorf("failed to load saga of order %s: %w", order.ID(), err)
+	}
+
+	// Заказ без онлайн-платежа оплачивается до сохранения саги: если оно не удалось,
+	// повтор достраивает сагу для уже оплаченного заказа
+	offline := !order.FinalPrice().IsPositive() || order.PaymentMethod().PaidOnDelivery()
+	if order.Status() != orders.StatusCreated && !(offline && order.Status() == orders.StatusPaid) {
+		return nil, fmt.Errorf("could not pay order %s: %w: order is %s",
+			order.ID(), orders.ErrInvalidTransition, order.Status())
+	}
+
+	// Клиента могли заблокировать уже после создания корзины
+	if order.Status() == orders.StatusCreated {
+		if err := uc.orders.policy.CheckCustomer(order.CustomerID()); err != nil {
+			return nil, err
+		}
+		if err := uc.bookSlot(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+
+	// Заказ уже закрыт, если прошлый запуск оплаты прервался до сохранения саги
+	if !order.CheckoutStarted() {
+		order, err = uc.orders.transition(ctx, order.ID(), "check out", func(o *orders.Order) error {
+			return o.StartCheckout()
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	var saga *orders.OrderSaga
+	if !offline {
+		paymentID, err := uc.payments.InitiatePayment(ctx, order.ID(), order.FinalPrice())
+		if err != nil {
+			return nil, fmt.Errorf("failed to initiate payment of order %s: %w", order.ID(), err)
+		}
+		saga = orders.NewOrderSaga(order.ID(), paymentID, now, uc.timeouts.Payment)
+		saga.Amount = order.FinalPrice()
+	} else {
+		// Бесплатный заказ (например, по промокоду) оплачивать нечем, наличные
+		// и карту при получении принимает курьер
+		if order.Status() == orders.StatusCreated {
+			if in.Note == "" && order.FinalPrice().IsPositive() {
+				in.Note = "payment on delivery: " + string(order.PaymentMethod())
+			}
+			if order, err = uc.orders.PayOrder(ctx, in); err != nil {
+				return nil, err
+			}
+		}
+		saga = orders.NewOrderSaga(order.ID(), "", now, 0)
+		saga.PaymentSettled = true
+		saga.MoveTo(orders.SagaPaid, now, 0)
+	}
+
+	if err := uc.sagas.Save(ctx, saga); err != nil {
+		return nil, fmt.Errorf("failed to save saga of order %s: %w", order.ID(), err)
+	}
+	return order, nil
+}
+
+// bookSlot - предзаказ занимает место в слоте доставки при оформлении, а не при создании корзины.
+func (uc *OrderSagaUseCase) bookSlot(ctx context.Context, order *orders.Order) error {
+	if !order.IsPreOrder() {
+		return nil
+	}
+	schedule := uc.orders.schedule
+	from, to := schedule.Slot(order.ScheduledFor())
+	err := uc.slots.Book(ctx, orders.SlotBooking{
+		OrderID:   order.ID(),
+		DeliverAt: order.ScheduledFor(),
+		From:      from,
+		To:        to,
+	}, schedule.SlotCapacity)
+	if errors.Is(err, orders.ErrSlotFull) {
+		return fmt.Errorf("%w: %s - %s", orders.ErrSlotFull, from.Format("15:04"), to.Format("15:04"))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to book delivery slot of order %s: %w", order.ID(), err)
+	}
+	return nil
+}
+
+// GetSaga - состояние оркестрации заказа.
+func (uc *OrderSagaUseCase) GetSaga(ctx context.Context, orderID string) (*orders.OrderSaga, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+	}
+	return uc.sagas.FindByOrderID(ctx, orderID)
+}
+
+// Advance - проход по активным сагам. Возвращает число саг, сделавших хотя бы один шаг.
+// Ошибка шага не останавливает остальные саги, шаг повторится на следующем проходе.
+func (uc *OrderSagaUseCase) Advance(ctx context.Context, now time.Time) (int, error) {
+	active, err := uc.sagas.ListActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list active sagas: %w", err)
+	}
+
+	advanced := 0
+	var errs []error
+	for _, saga := range active {
+		moved, err := uc.advance(ctx, saga, now)
+		if moved {
+			advanced++
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("saga of order %s: %w", saga.OrderID, err))
+		}
+	}
+	return advanced, errors.Join(errs...)
+}
+
+// advance - выполняет шаги саги, пока они не упрутся в ожидание внешнего сервиса.
+// Состояние сохраняется после каждого шага.
+func (uc *OrderSagaUseCase) advance(ctx context.Context, saga *orders.OrderSaga, now time.Time) (bool, error) {
+	moved := false
+	for i := 0; i < maxSagaSteps && saga.Active(); i++ {
+		progressed, err := uc.step(ctx, saga, now)
+		if err != nil {
+			return moved, err
+		}
+		if !progressed {
+			break
+		}
+		if err := uc.sagas.Save(ctx, saga); err != nil {
+			return moved, fmt.Errorf("failed to save saga: %w", err)
+		}
+		moved = true
+	}
+	return moved, nil
+}
+
+func (uc *OrderSagaUseCase) step(ctx context.Context, saga *orders.OrderSaga, now time.Time) (bool, error) {
+	order, err := uc.orders.GetOrder(ctx, saga.OrderID)
+	if err != nil {
+		return false, err
+	}
+
+	// Заказ могли отменить или завершить вручную, мимо саги
+	if saga.State != orders.SagaCompensating {
+		switch order.Status() {
+		case orders.StatusCanceled:
+			saga.Compensate(order.Cancellation().Reason, "order canceled", now)
+			return true, nil
+		case orders.StatusCompleted:
+			saga.MoveTo(orders.SagaCompleted, now, 0)
+			return true, nil
+		}
+	}
+
+	switch saga.State {
+	case orders.SagaAwaitingPayment:
+		return uc.awaitPayment(ctx, saga, order, now)
+	case orders.SagaPaid:
+		return uc.sendToKitchen(ctx, saga, order, now)
+	case orders.SagaCooking:
+		return uc.awaitKitchen(ctx, saga, order, now)
+	case orders.SagaDelivering:
+		return uc.awaitDelivery(ctx, saga, order, now)
+	case orders.SagaCompensating:
+		return uc.compensate(ctx, saga, order, now)
+	}
+	return false, nil
+}
+
+func (uc *OrderSagaUseCase) awaitPayment(ctx context.Context, saga *orders.OrderSaga, order *orders.Order, now time.Time) (bool, error) {
+	state, err := uc.payments.PaymentStatus(ctx, saga.OrderID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get payment status: %w", err)
+	}
+
+	switch state {
+	case orders.PaymentSuccess:
+		if order.Status() == orders.StatusCreated {
+			// Оплаченная сумма должна совпасть с заказом, иначе деньги возвращаются
+			if saga.Amount.IsPositive() && !saga.Amount.Equal(order.FinalPrice()) {
+				saga.Compensate(orders.CancelReasonPaymentFailed,
+					fmt.Sprintf("paid %s, order costs %s", saga.Amount, order.FinalPrice()), now)
+				return true, nil
+			}
+			if _, err := uc.orders.PayOrder(ctx, systemInput(order, "payment "+saga.PaymentID+" confirmed")); err != nil {
+				return false, err
+			}
+		}
+		saga.MoveTo(orders.SagaPaid, now, 0)
+		return true, nil
+	case orders.PaymentDeclined, orders.PaymentRefunded:
+		saga.PaymentSettled = true
+		saga.Compensate(orders.CancelReasonPaymentFailed, "payment declined", now)
+		return true, nil
+	}
+
+	if saga.TimedOut(now) {
+		saga.Compensate(orders.CancelReasonPaymentFailed, "payment timed out", now)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (uc *OrderSagaUseCase) sendToKitchen(ctx context.Context, saga *orders.OrderSaga, order *orders.Order, now time.Time) (bool, error) {
+	// Предзаказ уходит на кухню не раньше времени приготовления, держит его только сага
+	if order.IsPreOrder() && now.Before(uc.orders.schedule.ReleaseAt(order.ScheduledFor())) {
+		return false, nil
+	}
+
+	// Кухня не создает второй тикет на заказ в точке, поэтому шаг можно повторять
+	tickets := make([]string, 0, len(saga.Tickets))
+	for _, storeID := range fulfilmentStores(order) {
+		ticketID, ok := saga.TicketFor(storeID)
+		if !ok {
+			var err error
+			if ticketID, err = uc.kitchen.AcceptOrder(ctx, order, storeID); err != nil {
+				return false, fmt.Errorf("failed to create kitchen ticket in store %s: %w", storeID, err)
+			}
+			saga.AddTicket(storeID, ticketID)
+		}
+		tickets = append(tickets, ticketID)
+	}
+
+	// Прошлый проход мог прерваться после передачи заказа на кухню
+	if order.Status() == orders.StatusPaid {
+		note := "kitchen tickets " + strings.Join(tickets, ", ")
+		if _, err := uc.orders.SendToKitchen(ctx, systemInput(order, note)); err != nil {
+			return false, err
+		}
+	}
+	saga.MoveTo(orders.SagaCooking, now, uc.timeouts.Kitchen)
+	return true, nil
+}
+
+func (uc *OrderSagaUseCase) awaitKitchen(ctx context.Context, saga *orders.OrderSaga, order *orders.Order, now time.Time) (bool, error) {
+	// Разделенный заказ готов, когда готовы тикеты всех точек
+	ready := true
+	for _, t := range saga.Tickets {
+		state, err := uc.kitchen.TicketStatus(ctx, t.TicketID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get ticket status: %w", err)
+		}
+		uc.tracking.PublishUpdate(orders.TrackingUpdate{
+			OrderID: saga.OrderID, Kind: orders.TrackingKitchen, At: now, StoreID: t.StoreID, Ticket: state,
+		})
+		if state == orders.TicketCanceled {
+			saga.Compensate(orders.CancelReasonKitchenFailure, "kitchen ticket "+t.TicketID+" canceled", now)
+			return true, nil
+		}
+		ready = ready && state == orders.TicketReady
+	}
+
+	if ready {
+		var err error
+		if order.Status() == orders.StatusCooking {
+			if order, err = uc.orders.MarkReady(ctx, systemInput(order, "kitchen tickets ready")); err != nil {
+				return false, err
+			}
+		}
+		if err := uc.delivery.CreateDelivery(ctx, order); err != nil {
+			return false, fmt.Errorf("failed to create delivery: %w", err)
+		}
+		saga.MoveTo(orders.SagaDelivering, now, uc.timeouts.Delivery)
+		return true, nil
+	}
+
+	if saga.TimedOut(now) {
+		saga.Compensate(orders.CancelReasonKitchenFailure, "kitchen timed out", now)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (uc *OrderSagaUseCase) awaitDelivery(ctx context.Context, saga *orders.OrderSaga, order *orders.Order, now time.Time) (bool, error) {
+	progress, err := uc.delivery.DeliveryStatus(ctx, saga.OrderID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get delivery status: %w", err)
+	}
+	uc.tracking.PublishUpdate(orders.TrackingUpdate{
+		OrderID: saga.OrderID, Kind: orders.TrackingCourier, At: now, Delivery: progress.State, Courier: progress.Courier,
+	})
+	state := progress.State
+
+	switch state {
+	case orders.DeliveryOnWay, orders.DeliveryDelivered:
+		shipped := false
+		if order.Status() == orders.StatusReady {
+			if order, err = uc.orders.ShipToDelivery(ctx, systemInput(order, "picked up by courier")); err != nil {
+				return false, err
+			}
+			shipped = true
+		}
+		if state == orders.DeliveryOnWay {
+			return shipped, nil
+		}
+		if _, err := uc.orders.CompleteDelivery(ctx, systemInput(order, "delivered")); err != nil {
+			return false, err
+		}
+		saga.MoveTo(orders.SagaCompleted, now, 0)
+		return true, nil
+	case orders.DeliveryFailed:
+		saga.Compensate(orders.CancelReasonDeliveryFailed, "delivery failed", now)
+		return true, nil
+	}
+
+	if saga.TimedOut(now) {
+		saga.Compensate(orders.CancelReasonDeliveryFailed, "delivery timed out", now)
+		return true, nil
+	}
+	return false, nil
+}
+
+// compensate - отменяет заказ, снимает тикет и возвращает деньги, по одному действию за шаг.
+// Заказ отменяется первым: если системе это запрещено, деньги не возвращаются до решения менеджера.
+func (uc *OrderSagaUseCase) compensate(ctx context.Context, saga *orders.OrderSaga, order *orders.Order, now time.Time) (bool, error) {
+	if order.Status() != orders.StatusCanceled {
+		_, err := uc.orders.CancelOrder(ctx, CancelOrderInput{
+			OrderID: order.ID(),
+			Reason:  saga.CancelReason,
+			Comment: saga.FailureReason,
+			Actor:   orders.SystemActor,
+		})
+		if errors.Is(err, orders.ErrCancelForbidden) || errors.Is(err, orders.ErrInvalidTransition) {
+			saga.Fail(fmt.Sprintf("%s, order must be resolved manually: %v", saga.FailureReason, err), now)
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if len(saga.Tickets) > 0 && !saga.TicketCanceled {
+		for _, t := range saga.Tickets {
+			state, err := uc.kitchen.TicketStatus(ctx, t.TicketID)
+			if err != nil {
+				return false, fmt.Errorf("failed to get ticket status: %w", err)
+			}
+			// Готовый тикет отменять поздно, а отмененный - незачем
+			if state != orders.TicketReady && state != orders.TicketCanceled {
+				if err := uc.kitchen.CancelTicket(ctx, t.TicketID); err != nil {
+					return false, fmt.Errorf("failed to cancel kitchen ticket: %w", err)
+				}
+			}
+		}
+		saga.TicketCanceled = true
+		return true, nil
+	}
+
+	if !saga.PaymentSettled {
+		state, err := uc.payments.PaymentStatus(ctx, saga.OrderID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get payment status: %w", err)
+		}
+		switch state {
+		case orders.PaymentSuccess:
+			if err := uc.payments.RefundPayment(ctx, saga.OrderID); err != nil {
+				return false, fmt.Errorf("failed to refund payment: %w", err)
+			}
+		case orders.PaymentWaiting:
+			if err := uc.payments.DeclinePayment(ctx, saga.OrderID); err != nil {
+				return false, fmt.Errorf("failed to decline payment: %w", err)
+			}
+		}
+		saga.PaymentSettled = true
+		return true, nil
+	}
+
+	if err := uc.slots.Release(ctx, saga.OrderID); err != nil {
+		return false, fmt.Errorf("failed to release delivery slot: %w", err)
+	}
+	saga.MoveTo(orders.SagaCompensated, now, 0)
+	return true, nil
+}
+
+func systemInput(order *orders.Order, note string) TransitionInput {
+	return TransitionInput{OrderID: order.ID(), Actor: orders.SystemActor, Note: note}
+}
+
+// fulfilmentStores - точки, на кухни которых уходит заказ.
+// Заказы, созданные до появления точек, готовит кухня по умолчанию.
+func fulfilmentStores(order *orders.Order) []string {
+	if ids := order.StoreIDs(); len(ids) > 0 {
+		return ids
+	}
+	return []string{""}
+}
@@ -0,0 +1,552 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/pkg/common"
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/broker"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+// fakeServices - treasury, kitchen и logistics в одном месте, состояния выставляет тест.
+type fakeServices struct {
+	payments map[string]orders.PaymentState
+	tickets  map[string]orders.TicketState
+	delivery map[string]orders.DeliveryState
+	couriers map[string]*orders.GeoPoint
+
+	refunded  []string
+	declined  []string
+	canceled  []string
+	kitchenUp bool
+}
+
+func newFakeServices() *fakeServices {
+	return &fakeServices{
+		payments:  make(map[string]orders.PaymentState),
+		tickets:   make(map[string]orders.TicketState),
+		delivery:  make(map[string]orders.DeliveryState),
+		couriers:  make(map[string]*orders.GeoPoint),
+		kitchenUp: true,
+	}
+}
+
+func (f *fakeServices) InitiatePayment(ctx context.Context, orderID string, amount common.Money) (string, error) {
+	if _, ok := f.payments[orderID]; !ok {
+		f.payments[orderID] = orders.PaymentWaiting
+	}
+	return "pay-" + orderID, nil
+}
+
+func (f *fakeServices) PaymentStatus(ctx context.Context, orderID string) (orders.PaymentState, error) {
+	return f.payments[orderID], nil
+}
+
+func (f *fakeServices) DeclinePayment(ctx context.Context, orderID string) error {
+	f.payments[orderID] = orders.PaymentDeclined
+	f.declined = append(f.declined, orderID)
+	return nil
+}
+
+func (f *fakeServices) RefundPayment(ctx context.Context, orderID string) error {
+	f.payments[orderID] = orders.PaymentRefunded
+	f.refunded = append(f.refunded, orderID)
+	return nil
+}
+
+func (f *fakeServices) AcceptOrder(ctx context.Context, o *orders.Order, storeID string) (string, error) {
+	if !f.kitchenUp {
+		return "", errors.New("kitchen is unavailable")
+	}
+	id := "ticket-" + o.ID() + "-" + storeID
+	if _, ok := f.tickets[id]; !ok {
+		f.tickets[id] = orders.TicketQueued
+	}
+	return id, nil
+}
+
+func (f *fakeServices) TicketStatus(ctx context.Context, ticketID string) (orders.TicketState, error) {
+	return f.tickets[ticketID], nil
+}
+
+func (f *fakeServices) CancelTicket(ctx context.Context, ticketID string) error {
+	f.tickets[ticketID] = orders.TicketCanceled
+	f.canceled = append(f.canceled, ticketID)
+	return nil
+}
+
+func (f *fakeServices) CreateDelivery(ctx context.Context, o *orders.Order) error {
+	if _, ok := f.delivery[o.ID()]; !ok {
+		f.delivery[o.ID()] = orders.DeliveryAssigned
+	}
+	return nil
+}
+
+func (f *fakeServices) DeliveryStatus(ctx context.Context, orderID string) (orders.DeliveryProgress, error) {
+	return orders.DeliveryProgress{State: f.delivery[orderID], Courier: f.couriers[orderID]}, nil
+}
+
+var testSagaTimeouts = SagaTimeouts{Payment: 15 * time.Minute, Kitchen: time.Hour, Delivery: 2 * time.Hour}
+
+func newTestSaga(t *testing.T) (*OrderSagaUseCase, *OrderUseCase, *fakeServices, *orders.Order) {
+	t.Helper()
+
+	orderUC := newTestUseCase(NewMockRepo())
+	services := newFakeServices()
+	sagaUC := NewOrderSagaUseCase(orderUC, repository.NewInMemorySagaRepository(), repository.NewInMemorySlotBookings(),
+		services, services, services, broker.NewTrackingHub(), testSagaTimeouts)
+
+	order, err := orderUC.CreateOrder(context.Background(), CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	return sagaUC, orderUC, services, order
+}
+
+func advanceSaga(t *testing.T, uc *OrderSagaUseCase, now time.Time) {
+	t.Helper()
+	if _, err := uc.Advance(context.Background(), now); err != nil {
+		t.Fatalf("failed to advance sagas: %v", err)
+	}
+}
+
+func TestOrderSagaUseCase_HappyPath(t *testing.T) {
+	uc, orderUC, services, order := newTestSaga(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	// Повторный запуск не создает второй платеж
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("repeated checkout failed: %v", err)
+	}
+
+	advanceSaga(t, uc, now)
+	if order.Status() != orders.StatusCreated {
+		t.Fatalf("order must wait for payment, got %s", order.Status())
+	}
+
+	services.payments[order.ID()] = orders.PaymentSuccess
+	advanceSaga(t, uc, now)
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCooking || order.Status() != orders.StatusCooking || len(saga.Tickets) != 1 {
+		t.Fatalf("expected order on kitchen, got saga %s, order %s", saga.State, order.Status())
+	}
+
+	services.tickets[saga.Tickets[0].TicketID] = orders.TicketReady
+	advanceSaga(t, uc, now)
+	if order.Status() != orders.StatusReady || services.delivery[order.ID()] != orders.DeliveryAssigned {
+		t.Fatalf("expected ready order with delivery, got %s", order.Status())
+	}
+
+	services.delivery[order.ID()] = orders.DeliveryOnWay
+	advanceSaga(t, uc, now)
+	if order.Status() != orders.StatusDelivering {
+		t.Fatalf("expected order in delivery, got %s", order.Status())
+	}
+
+	services.delivery[order.ID()] = orders.DeliveryDelivered
+	advanceSaga(t, uc, now)
+	saga, _ = uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCompleted || order.Status() != orders.StatusCompleted {
+		t.Fatalf("expected completed saga, got %s, order %s", saga.State, order.Status())
+	}
+
+	history, err := orderUC.GetOrderHistory(ctx, order.ID())
+	if err != nil {
+		t.Fatalf("failed to load history: %v", err)
+	}
+	if len(history) != 6 || history[1].Actor != orders.SystemActor {
+		t.Errorf("expected full history by system actor, got %+v", history)
+	}
+}
+
+func TestOrderSagaUseCase_CheckoutLocksOrder(t *testing.T) {
+	uc, orderUC, services, order := newTestSaga(t)
+	ctx := context.Background()
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	charged := order.FinalPrice()
+
+	// Пока платеж идет, сумма заказа не меняется
+	if _, err := orderUC.AddItem(ctx, order.ID(), OrderItemInput{ProductID: "p1", Quantity: 1}); !errors.Is(err, orders.ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked on cart edit, got %v", err)
+	}
+	if _, err := orderUC.SetTip(ctx, order.ID(), common.NewMoney(100)); !errors.Is(err, orders.ErrOrderLocked) {
+		t.Errorf("expected ErrOrderLocked on tip change, got %v", err)
+	}
+	if !order.FinalPrice().Equal(charged) || len(order.Items()) != 1 {
+		t.Fatalf("order changed after checkout: %s, %d items", order.FinalPrice(), len(order.Items()))
+	}
+
+	// Сумма платежа разошлась с заказом - платеж возвращается, заказ отменяется
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if !saga.Amount.Equal(charged) {
+		t.Fatalf("expected saga amount %s, got %s", charged, saga.Amount)
+	}
+	saga.Amount = charged.Add(common.NewMoney(100))
+	if err := uc.sagas.Save(ctx, saga); err != nil {
+		t.Fatalf("failed to save saga: %v", err)
+	}
+
+	services.payments[order.ID()] = orders.PaymentSuccess
+	advanceSaga(t, uc, time.Now())
+	saga, _ = uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCompensated || order.Status() != orders.StatusCanceled {
+		t.Fatalf("expected compensated saga and canceled order, got %s, %s", saga.State, order.Status())
+	}
+	if len(services.refunded) != 1 {
+		t.Errorf("expected payment with wrong amount to be refunded, got %v", services.refunded)
+	}
+}
+
+// flakySagaRepo - первые fails сохранений саги падают.
+type flakySagaRepo struct {
+	orders.SagaRepository
+	fails int
+}
+
+func (r *flakySagaRepo) Save(ctx context.Context, s *orders.OrderSaga) error {
+	if r.fails > 0 {
+		r.fails--
+		return errors.New("connection reset")
+	}
+	return r.SagaRepository.Save(ctx, s)
+}
+
+func TestOrderSagaUseCase_FreeOrderRecoversSaga(t *testing.T) {
+	uc, orderUC, services, order := newTestSaga(t)
+	ctx := context.Background()
+	sagas := &flakySagaRepo{SagaRepository: uc.sagas, fails: 1}
+	uc.sagas = sagas
+
+	_, err := orderUC.CreatePromo(ctx, &orders.Promo{
+		Code: "FREE", Type: orders.PromoFixedAmount, Amount: common.NewMoney(1000), Active: true, ValidFrom: time.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("failed to create promo: %v", err)
+	}
+	if _, err := orderUC.ApplyPromo(ctx, order.ID(), "FREE"); err != nil {
+		t.Fatalf("failed to apply promo: %v", err)
+	}
+	if order.FinalPrice().IsPositive() {
+		t.Fatalf("expected free order, got %s", order.FinalPrice())
+	}
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err == nil {
+		t.Fatal("expected saga save to fail")
+	}
+	if order.Status() != orders.StatusPaid {
+		t.Fatalf("free order is paid before the saga, got %s", order.Status())
+	}
+
+	// Повтор достраивает сагу, и заказ уходит на кухню
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("retry must create the missing saga: %v", err)
+	}
+	advanceSaga(t, uc, time.Now())
+	saga, err := uc.GetSaga(ctx, order.ID())
+	if err != nil {
+		t.Fatalf("failed to load saga: %v", err)
+	}
+	if saga.State != orders.SagaCooking || order.Status() != orders.StatusCooking {
+		t.Errorf("expected order on kitchen, got saga %s, order %s", saga.State, order.Status())
+	}
+	if _, ok := services.payments[order.ID()]; ok {
+		t.Error("free order must not be charged")
+	}
+}
+
+func TestOrderSagaUseCase_CashOrderSkipsOnlinePayment(t *testing.T) {
+	uc, orderUC, services, _ := newTestSaga(t)
+	ctx := context.Background()
+
+	order, err := orderUC.CreateOrder(ctx, CreateOrderInput{
+		CustomerID:    "cust1",
+		Address:       orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:         []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+		PaymentMethod: orders.PaymentCash,
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	if _, ok := services.payments[order.ID()]; ok {
+		t.Fatal("cash order must not start an online payment")
+	}
+
+	// Таймаут оплаты к заказу с оплатой курьеру не относится
+	advanceSaga(t, uc, time.Now().Add(testSagaTimeouts.Payment))
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCooking || order.Status() != orders.StatusCooking {
+		t.Errorf("expected cash order on kitchen, got saga %s, order %s", saga.State, order.Status())
+	}
+}
+
+func TestOrderSagaUseCase_BlockedCustomerCannotCheckOut(t *testing.T) {
+	uc, orderUC, services, order := newTestSaga(t)
+	orderUC.policy.BlockedCustomers = map[string]bool{order.CustomerID(): true}
+
+	if _, err := uc.StartCheckout(context.Background(), TransitionInput{OrderID: order.ID()}); !errors.Is(err, orders.ErrCustomerBlocked) {
+		t.Fatalf("expected ErrCustomerBlocked, got %v", err)
+	}
+	if order.CheckoutStarted() || len(services.payments) != 0 {
+		t.Error("blocked customer must not start a payment")
+	}
+}
+
+func TestOrderSagaUseCase_PaymentTimeout(t *testing.T) {
+	uc, _, services, order := newTestSaga(t)
+	ctx := context.Background()
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+
+	advanceSaga(t, uc, time.Now().Add(testSagaTimeouts.Payment))
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCompensated {
+		t.Fatalf("expected compensated saga, got %s", saga.State)
+	}
+	if order.Status() != orders.StatusCanceled || order.Cancellation().Reason != orders.CancelReasonPaymentFailed {
+		t.Errorf("expected order canceled for payment, got %s", order.Status())
+	}
+	if len(services.declined) != 1 || len(services.refunded) != 0 {
+		t.Errorf("expected waiting payment to be declined, got declined=%v refunded=%v", services.declined, services.refunded)
+	}
+}
+
+func TestOrderSagaUseCase_KitchenFailureCompensates(t *testing.T) {
+	uc, _, services, order := newTestSaga(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	services.payments[order.ID()] = orders.PaymentSuccess
+	services.kitchenUp = false
+
+	// Недоступная кухня - повод повторить шаг, а не откатывать заказ
+	if _, err := uc.Advance(ctx, now); err == nil {
+		t.Fatal("expected kitchen error")
+	}
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaPaid || order.Status() != orders.StatusPaid {
+		t.Fatalf("expected paid saga waiting for kitchen, got %s", saga.State)
+	}
+
+	services.kitchenUp = true
+	advanceSaga(t, uc, now)
+	advanceSaga(t, uc, now.Add(testSagaTimeouts.Kitchen))
+
+	saga, _ = uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCompensated || order.Status() != orders.StatusCanceled {
+		t.Fatalf("expected compensated saga, got %s, order %s", saga.State, order.Status())
+	}
+	if len(services.canceled) != 1 || len(services.refunded) != 1 {
+		t.Errorf("expected ticket cancel and refund, got canceled=%v refunded=%v", services.canceled, services.refunded)
+	}
+}
+
+func TestOrderSagaUseCase_DeliveryFailureNeedsManager(t *testing.T) {
+	uc, _, services, order := newTestSaga(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	services.payments[order.ID()] = orders.PaymentSuccess
+	advanceSaga(t, uc, now)
+	services.tickets["ticket-"+order.ID()+"-main"] = orders.TicketReady
+	services.delivery[order.ID()] = orders.DeliveryOnWay
+	advanceSaga(t, uc, now)
+
+	services.delivery[order.ID()] = orders.DeliveryFailed
+	advanceSaga(t, uc, now)
+
+	// Заказ в доставке система отменить не может, деньги не возвращаются до решения менеджера
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaFailed || order.Status() != orders.StatusDelivering {
+		t.Fatalf("expected failed saga, got %s, order %s", saga.State, order.Status())
+	}
+	if len(services.refunded) != 0 {
+		t.Errorf("refund must wait for manager, got %v", services.refunded)
+	}
+}
+
+func TestOrderSagaUseCase_CanceledByCustomer(t *testing.T) {
+	uc, orderUC, services, order := newTestSaga(t)
+	ctx := context.Background()
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	_, err := orderUC.CancelOrder(ctx, CancelOrderInput{
+		OrderID: order.ID(),
+		Reason:  orders.CancelReasonCustomerRequest,
+		Actor:   orders.Actor{ID: "cust1", Role: orders.RoleCustomer},
+	})
+	if err != nil {
+		t.Fatalf("failed to cancel: %v", err)
+	}
+
+	advanceSaga(t, uc, time.Now())
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCompensated || saga.CancelReason != orders.CancelReasonCustomerRequest {
+		t.Fatalf("expected compensated saga, got %s (%s)", saga.State, saga.CancelReason)
+	}
+	if services.payments[order.ID()] != orders.PaymentDeclined {
+		t.Errorf("expected pending payment to be declined, got %s", services.payments[order.ID()])
+	}
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: "missing"}); !errors.Is(err, orders.ErrOrderNotFound) {
+		t.Errorf("expected ErrOrderNotFound, got %v", err)
+	}
+}
+
+func TestOrderSagaUseCase_SplitOrderWaitsForAllStores(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	pricer := NewMockPricer(
+		orders.PricedProduct{ProductID: "p1", Name: "Pizza", BasePrice: common.NewMoney(500), IsAvailable: true},
+		orders.PricedProduct{ProductID: "p3", Name: "Wings", BasePrice: common.NewMoney(300), IsAvailable: true},
+	)
+	repo := NewMockRepo()
+	network := &orders.StoreNetwork{Stores: []orders.Store{
+		{ID: "pizza", Excluded: []string{"p3"}},
+		{ID: "grill", Excluded: []string{"p1"}},
+	}}
+	orderUC := NewOrderUseCase(repo, pricer, repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(),
+		repository.NewInMemoryAddressBook(), orders.HalfPricingMax, orders.NewStoreRouter(network, repository.NewOrderStoreLoad(repo)), noFees(), noLimits())
+	services := newFakeServices()
+	uc := NewOrderSagaUseCase(orderUC, repository.NewInMemorySagaRepository(), repository.NewInMemorySlotBookings(),
+		services, services, services, broker.NewTrackingHub(), testSagaTimeouts)
+
+	order, err := orderUC.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}, {ProductID: "p3", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+	if ids := order.StoreIDs(); len(ids) != 2 {
+		t.Fatalf("expected order split between two stores, got %v", ids)
+	}
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	services.payments[order.ID()] = orders.PaymentSuccess
+	advanceSaga(t, uc, now)
+
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	pizzaTicket, _ := saga.TicketFor("pizza")
+	grillTicket, ok := saga.TicketFor("grill")
+	if len(saga.Tickets) != 2 || !ok {
+		t.Fatalf("expected ticket per store, got %+v", saga.Tickets)
+	}
+
+	services.tickets[pizzaTicket] = orders.TicketReady
+	advanceSaga(t, uc, now)
+	if order.Status() != orders.StatusCooking {
+		t.Fatalf("order must wait for the second store, got %s", order.Status())
+	}
+
+	services.tickets[grillTicket] = orders.TicketReady
+	advanceSaga(t, uc, now)
+	if order.Status() != orders.StatusReady || services.delivery[order.ID()] != orders.DeliveryAssigned {
+		t.Fatalf("expected ready order with delivery, got %s", order.Status())
+	}
+}
+
+func TestOrderSagaUseCase_PreOrderWaitsForRelease(t *testing.T) {
+	uc, orderUC, services, _ := newTestSaga(t)
+	ctx := context.Background()
+
+	deliverAt := time.Now().Add(3 * time.Hour)
+	order, err := orderUC.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+		DeliverAt:  deliverAt,
+	})
+	if err != nil {
+		t.Fatalf("failed to create pre-order: %v", err)
+	}
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	services.payments[order.ID()] = orders.PaymentSuccess
+
+	advanceSaga(t, uc, time.Now())
+	saga, _ := uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaPaid || order.Status() != orders.StatusPaid || len(services.tickets) != 0 {
+		t.Fatalf("pre-order must wait for release, got saga %s, order %s", saga.State, order.Status())
+	}
+
+	// Заказ на кухне появляется вместе с тикетом
+	advanceSaga(t, uc, orderUC.schedule.ReleaseAt(deliverAt))
+	saga, _ = uc.GetSaga(ctx, order.ID())
+	if saga.State != orders.SagaCooking || order.Status() != orders.StatusCooking || len(saga.Tickets) != 1 {
+		t.Errorf("expected released pre-order with ticket, got saga %s, order %s", saga.State, order.Status())
+	}
+}
+
+func TestOrderSagaUseCase_CheckoutBooksSlot(t *testing.T) {
+	uc, orderUC, services, _ := newTestSaga(t)
+	ctx := context.Background()
+	orderUC.schedule.SlotCapacity = 1
+
+	input := CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+		DeliverAt:  time.Now().Add(3 * time.Hour),
+	}
+	first, err := orderUC.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("failed to create pre-order: %v", err)
+	}
+	second, err := orderUC.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("carts must not take the slot: %v", err)
+	}
+
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: first.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: second.ID()}); !errors.Is(err, orders.ErrSlotFull) {
+		t.Fatalf("expected ErrSlotFull, got %v", err)
+	}
+	if second.CheckoutStarted() {
+		t.Error("order without a slot must stay editable")
+	}
+
+	// Отмененный заказ освобождает место
+	services.payments[first.ID()] = orders.PaymentDeclined
+	advanceSaga(t, uc, time.Now())
+	if first.Status() != orders.StatusCanceled {
+		t.Fatalf("expected first order canceled, got %s", first.Status())
+	}
+	if _, err := uc.StartCheckout(ctx, TransitionInput{OrderID: second.ID()}); err != nil {
+		t.Errorf("expected released slot to be available, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+)
+
+// TrackingUseCase - отслеживание заказа клиентом в реальном времени.
+type TrackingUseCase struct {
+	orders *OrderUseCase
+	feed   orders.TrackingFeed
+}
+
+func NewTrackingUseCase(orderUC *OrderUseCase, feed orders.TrackingFeed) *TrackingUseCase {
+	return &TrackingUseCase{orders: orderUC, feed: feed}
+}
+
+type WatchOrderInput struct {
+	OrderID    string
+	CustomerID string
+}
+
+// WatchOrder - сначала текущее состояние заказа, затем его изменения. Канал закрывается
+// после завершения или отмены заказа либо при отмене ctx.
+func (uc *TrackingUseCase) WatchOrder(ctx context.Context, input WatchOrderInput) (<-chan orders.TrackingUpdate, error) {
+	if input.CustomerID == "" {
+		return nil, fmt.Errorf("%w: customer ID is required", ErrInvalidInput)
+	}
+
+	// Подписка до чтения заказа: изменение между ними не потеряется
+	latest, updates, cancel := uc.feed.Subscribe(input.OrderID)
+	order, err := uc.orders.GetOrder(ctx, input.OrderID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if order.CustomerID() != input.CustomerID {
+		cancel()
+		return nil, fmt.Errorf("%w: %s", ErrForeignOrder, input.OrderID)
+	}
+
+	current := orders.TrackingUpdate{OrderID: order.ID(), Kind: orders.TrackingStatus, At: time.Now(), Status: order.Status()}
+	initial := []orders.TrackingUpdate{current}
+	for _, u := range latest {
+		if u.Kind != orders.TrackingStatus {
+			initial = append(initial, u)
+		}
+	}
+
+	out := make(chan orders.TrackingUpdate, len(initial))
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		last := order.Status()
+		for _, u := range initial {
+			out <- u
+		}
+		if current.Final() {
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case u := <-updates:
+				// Статус из outbox может отставать от прочитанного заказа
+				if u.Kind == orders.TrackingStatus {
+					if u.Status <= last {
+						continue
+					}
+					last = u.Status
+				}
+				select {
+				case out <- u:
+				case <-ctx.Done():
+					return
+				}
+				if u.Final() {
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
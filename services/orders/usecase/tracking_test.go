@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/broker"
+	"github.com/versoit/diploma/services/orders/internal/repository"
+)
+
+func nextUpdate(t *testing.T, updates <-chan orders.TrackingUpdate) orders.TrackingUpdate {
+	t.Helper()
+	select {
+	case u, ok := <-updates:
+		if !ok {
+			t.Fatal("tracking stream closed unexpectedly")
+		}
+		return u
+	case <-time.After(time.Second):
+		t.Fatal("no tracking update")
+	}
+	return orders.TrackingUpdate{}
+}
+
+func TestTrackingUseCase_WatchOrder(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	orderUC := newTestUseCase(NewMockRepo())
+	services := newFakeServices()
+	hub := broker.NewTrackingHub()
+	sagaUC := NewOrderSagaUseCase(orderUC, repository.NewInMemorySagaRepository(), repository.NewInMemorySlotBookings(),
+		services, services, services, hub, testSagaTimeouts)
+	uc := NewTrackingUseCase(orderUC, hub)
+
+	order, err := orderUC.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	if _, err := uc.WatchOrder(ctx, WatchOrderInput{OrderID: order.ID(), CustomerID: "cust2"}); !errors.Is(err, ErrForeignOrder) {
+		t.Fatalf("expected ErrForeignOrder, got %v", err)
+	}
+
+	updates, err := uc.WatchOrder(ctx, WatchOrderInput{OrderID: order.ID(), CustomerID: "cust1"})
+	if err != nil {
+		t.Fatalf("failed to watch order: %v", err)
+	}
+	if u := nextUpdate(t, updates); u.Kind != orders.TrackingStatus || u.Status != orders.StatusCreated {
+		t.Fatalf("expected current status first, got %+v", u)
+	}
+
+	if _, err := sagaUC.StartCheckout(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+		t.Fatalf("failed to start checkout: %v", err)
+	}
+	services.payments[order.ID()] = orders.PaymentSuccess
+	advanceSaga(t, sagaUC, now)
+	if u := nextUpdate(t, updates); u.Kind != orders.TrackingKitchen || u.Ticket != orders.TicketQueued || u.StoreID != "main" {
+		t.Fatalf("expected kitchen progress, got %+v", u)
+	}
+
+	services.tickets["ticket-"+order.ID()+"-main"] = orders.TicketReady
+	services.delivery[order.ID()] = orders.DeliveryOnWay
+	services.couriers[order.ID()] = &orders.GeoPoint{Lat: 55.75, Lng: 37.62}
+	advanceSaga(t, sagaUC, now)
+	nextUpdate(t, updates) // кухня: готово
+	if u := nextUpdate(t, updates); u.Kind != orders.TrackingCourier || u.Courier == nil || u.Courier.Lat != 55.75 {
+		t.Fatalf("expected courier location, got %+v", u)
+	}
+
+	hub.PublishUpdate(orders.TrackingUpdate{OrderID: order.ID(), Kind: orders.TrackingStatus, Status: orders.StatusCompleted})
+	if u := nextUpdate(t, updates); !u.Final() {
+		t.Fatalf("expected final status, got %+v", u)
+	}
+	if _, ok := <-updates; ok {
+		t.Error("stream must close after the order is completed")
+	}
+}
+
+func TestTrackingUseCase_WatchOrderStopsOnCancel(t *testing.T) {
+	orderUC := newTestUseCase(NewMockRepo())
+	uc := NewTrackingUseCase(orderUC, broker.NewTrackingHub())
+
+	order, err := orderUC.CreateOrder(context.Background(), CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := uc.WatchOrder(ctx, WatchOrderInput{OrderID: order.ID(), CustomerID: "cust1"})
+	if err != nil {
+		t.Fatalf("failed to watch order: %v", err)
+	}
+	nextUpdate(t, updates)
+	cancel()
+	if _, ok := <-updates; ok {
+		t.Error("stream must close when the client disconnects")
+	}
+
+	if _, err := uc.WatchOrder(context.Background(), WatchOrderInput{OrderID: "missing", CustomerID: "cust1"}); !errors.Is(err, orders.ErrOrderNotFound) {
+		t.Errorf("expected ErrOrderNotFound, got %v", err)
+	}
+}
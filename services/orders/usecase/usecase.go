@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/versoit/diploma/pkg/common"
 	"github.com/versoit/diploma/services/orders"
@@ -13,85 +14,482 @@ var (
 	ErrInvalidInput = errors.New("invalid input data")
 )
 
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
 type CreateOrderInput struct {
 	CustomerID string
 	Address    orders.DeliveryAddress
-	Items      []OrderItemInput
+	// AddressID - адрес из адресной книги клиента, заменяет Address.
+	// Без адреса и AddressID берется основной адрес клиента.
+	AddressID string
+	Items     []OrderItemInput
+	// DeliverAt - время доставки предзаказа, нулевое - как можно скорее.
+	DeliverAt time.Time
+	// PaymentMethod - пусто - orders.DefaultPaymentMethod.
+	PaymentMethod orders.PaymentMethod
+	Tip           common.Money
 }
 
+// OrderItemInput - позиция, запрошенная клиентом.
+// Цены не принимаются от клиента, они берутся из каталога.
 type OrderItemInput struct {
 	ProductID string
-	Name      string
 	Quantity  int
-	BasePrice common.Money
-	SizeMult  float64
-	Toppings  []orders.Topping
+	Toppings  []string
+	// Size и Crust - коды вариантов из каталога, пусто - вариант по умолчанию.
+	Size  string
+	Crust string
+	// HalfProductID - вторая половина пиццы "пополам", ProductID - первая.
+	HalfProductID string
 }
 
 type OrderUseCase struct {
-	repo orders.OrderRepository
+	repo      orders.OrderRepository
+	pricer    orders.ProductPricer
+	promos    orders.PromoRepository
+	delivery  orders.DeliveryPricer
+	schedule  *orders.StoreSchedule
+	addresses orders.AddressBook
+	// halfPricing - цена пиццы "пополам": по дорогой половине или средняя.
+	halfPricing orders.HalfPricing
+	stores      orders.StoreAssigner
+	fees        *orders.FeePolicy
+	policy      *orders.OrderPolicy
 }
 
-func NewOrderUseCase(repo orders.OrderRepository) *OrderUseCase {
-	return &OrderUseCase{repo: repo}
+func NewOrderUseCase(
+	repo orders.OrderRepository,
+	pricer orders.ProductPricer,
+	promos orders.PromoRepository,
+	delivery orders.DeliveryPricer,
+	schedule *orders.StoreSchedule,
+	addresses orders.AddressBook,
+	halfPricing orders.HalfPricing,
+	stores orders.StoreAssigner,
+	fees *orders.FeePolicy,
+	policy *orders.OrderPolicy,
+) *OrderUseCase {
+	return &OrderUseCase{
+		repo:      repo,
+		pricer:    pricer,
+		promos:    promos,
+		delivery:  delivery,
+		schedule:  schedule,
+		addresses: addresses,
+
+		halfPricing: halfPricing,
+		stores:      stores,
+		fees:        fees,
+		policy:      policy,
+	}
 }
 
 func (uc *OrderUseCase) CreateOrder(ctx context.Context, input CreateOrderInput) (*orders.Order, error) {
+	order, _, err := uc.buildOrder(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Save(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to save order to repository: %w", err)
+	}
+
+	return order, nil
+}
+
+// OrderQuote - предварительный расчет заказа без его создания.
+type OrderQuote struct {
+	Order    *orders.Order
+	Delivery orders.DeliveryQuote
+}
+
+// QuoteOrder - считает заказ так же, как CreateOrder, но ничего не сохраняет.
+func (uc *OrderUseCase) QuoteOrder(ctx context.Context, input CreateOrderInput) (*OrderQuote, error) {
+	order, delivery, err := uc.buildOrder(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderQuote{Order: order, Delivery: delivery}, nil
+}
+
+// buildOrder - валидация, цены каталога и стоимость доставки.
+func (uc *OrderUseCase) buildOrder(ctx context.Context, input CreateOrderInput) (*orders.Order, orders.DeliveryQuote, error) {
 	// Валидация входных данных
 	if input.CustomerID == "" {
-		return nil, fmt.Errorf("%w: customer ID is required", ErrInvalidInput)
+		return nil, orders.DeliveryQuote{}, fmt.Errorf("%w: customer ID is required", ErrInvalidInput)
 	}
 	if len(input.Items) == 0 {
-		return nil, fmt.Errorf("%w: order must have at least one item", ErrInvalidInput)
+		return nil, orders.DeliveryQuote{}, fmt.Errorf("%w: order must have at least one item", ErrInvalidInput)
+	}
+	if err := uc.policy.CheckCustomer(input.CustomerID); err != nil {
+		return nil, orders.DeliveryQuote{}, err
+	}
+
+	address, err := uc.resolveAddress(ctx, input)
+	if err != nil {
+		return nil, orders.DeliveryQuote{}, err
 	}
-	if input.Address.City == "" || input.Address.Street == "" {
-		return nil, fmt.Errorf("%w: incomplete delivery address", ErrInvalidInput)
+	if err := validateAddress(address); err != nil {
+		return nil, orders.DeliveryQuote{}, err
 	}
 
 	// Проверка контекста перед началом тяжелой операции
 	if err := ctx.Err(); err != nil {
-		return nil, err
+		return nil, orders.DeliveryQuote{}, err
 	}
 
-	order := orders.NewOrder(input.CustomerID, input.Address)
+	order := orders.NewOrder(input.CustomerID, address)
+	if err := uc.addPricedItems(ctx, order, input.Items); err != nil {
+		return nil, orders.DeliveryQuote{}, err
+	}
+	if err := uc.policy.CheckItems(order); err != nil {
+		return nil, orders.DeliveryQuote{}, err
+	}
+
+	// Надбавка часов пик считается по времени доставки, а не оформления
+	deliverAt := time.Now()
+	if input.DeliverAt.IsZero() {
+		if err := uc.checkOpen(deliverAt); err != nil {
+			return nil, orders.DeliveryQuote{}, err
+		}
+	} else {
+		if err := uc.checkDeliverySlot(ctx, input.DeliverAt); err != nil {
+			return nil, orders.DeliveryQuote{}, err
+		}
+		if err := order.ScheduleDelivery(input.DeliverAt); err != nil {
+			return nil, orders.DeliveryQuote{}, err
+		}
+		deliverAt = input.DeliverAt
+	}
+
+	delivery, err := uc.delivery.QuoteDelivery(order.Address(), order.ItemsTotal(), deliverAt)
+	if err != nil {
+		return nil, orders.DeliveryQuote{}, err
+	}
+	if err := order.SetDeliveryPrice(delivery.Price); err != nil {
+		return nil, orders.DeliveryQuote{}, err
+	}
+
+	method := input.PaymentMethod
+	if method == "" {
+		method = orders.DefaultPaymentMethod
+	}
+	if err := uc.applyFees(order, method); err != nil {
+		return nil, orders.DeliveryQuote{}, err
+	}
+	if !input.Tip.IsZero() {
+		if err := order.SetTip(input.Tip); err != nil {
+			return nil, orders.DeliveryQuote{}, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+	}
+
+	if err := uc.assignStores(ctx, order, delivery.ZoneID); err != nil {
+		return nil, orders.DeliveryQuote{}, err
+	}
+
+	return order, delivery, nil
+}
+
+// applyFees - сборы заказа по способу оплаты и текущей сумме позиций.
+func (uc *OrderUseCase) applyFees(order *orders.Order, method orders.PaymentMethod) error {
+	fees, err := uc.fees.Quote(method, order.ItemsTotal())
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidInput, err)
+	}
+	return order.SetFees(method, fees)
+}
+
+// assignStores - распределяет позиции по точкам, обслуживающим зону доставки.
+func (uc *OrderUseCase) assignStores(ctx context.Context, order *orders.Order, zoneID string) error {
+	byLine, err := uc.stores.AssignStores(ctx, zoneID, order.Items())
+	if err != nil {
+		return err
+	}
+	return order.AssignStores(byLine)
+}
+
+// addPricedItems - добавляет позиции по ценам каталога, отклоняя недоступные товары.
+func (uc *OrderUseCase) addPricedItems(ctx context.Context, order *orders.Order, items []OrderItemInput) error {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.ProductID == "" {
+			return fmt.Errorf("%w: product ID is required", ErrInvalidInput)
+		}
+		ids = append(ids, item.ProductID)
+		if item.HalfProductID != "" {
+			ids = append(ids, item.HalfProductID)
+		}
+	}
+
+	products, err := uc.pricer.PriceProducts(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to fetch prices from catalog: %w", err)
+	}
+
+	for _, item := range items {
+		product, halves, err := uc.itemProduct(products, item.ProductID, item.HalfProductID)
+		if err != nil {
+			return err
+		}
+		if !product.IsAvailable {
+			return fmt.Errorf("%w: %s", orders.ErrProductUnavailable, product.Name)
+		}
+
+		opts, err := resolveOptions(product, item.Size, item.Crust)
+		if err != nil {
+			return err
+		}
+		opts.Halves = halves
+
+		toppings, err := product.ResolveToppings(item.Toppings, opts.Size.Code)
+		if err != nil {
+			return err
+		}
 
-	for _, item := range input.Items {
 		if err := order.AddItem(
-			item.ProductID,
-			item.Name,
+			product.ProductID,
+			product.Name,
 			item.Quantity,
-			item.BasePrice,
-			item.SizeMult,
-			item.Toppings,
+			product.BasePrice,
+			opts,
+			toppings,
 		); err != nil {
-			return nil, fmt.Errorf("failed to add item %s to order: %w", item.ProductID, err)
+			return fmt.Errorf("failed to add item %s to order: %w", item.ProductID, err)
 		}
 	}
 
-	if err := uc.repo.Save(ctx, order); err != nil {
-		return nil, fmt.Errorf("failed to save order to repository: %w", err)
+	return nil
+}
+
+// itemProduct - товар позиции по ценам каталога, для пиццы "пополам" - собранный из двух половин.
+// Пицца "пополам" доступна, только если доступны обе половины.
+func (uc *OrderUseCase) itemProduct(products map[string]orders.PricedProduct, productID, halfID string) (orders.PricedProduct, []orders.PizzaHalf, error) {
+	product, ok := products[productID]
+	if !ok {
+		return orders.PricedProduct{}, nil, fmt.Errorf("%w: %s", orders.ErrUnknownProduct, productID)
+	}
+	if halfID == "" {
+		return product, nil, nil
 	}
 
-	return order, nil
+	half, ok := products[halfID]
+	if !ok {
+		return orders.PricedProduct{}, nil, fmt.Errorf("%w: %s", orders.ErrUnknownProduct, halfID)
+	}
+	return orders.CombineHalves(product, half, uc.halfPricing)
 }
 
-func (uc *OrderUseCase) PayOrder(ctx context.Context, orderID string) error {
+// lineProducts - ID товаров строки заказа: сам товар и вторая половина пиццы "пополам".
+func lineProducts(item *orders.OrderItem) (productID, halfID string) {
+	if halves := item.Halves(); len(halves) == 2 {
+		return halves[0].ProductID, halves[1].ProductID
+	}
+	return item.ProductID(), ""
+}
+
+func resolveOptions(product orders.PricedProduct, size, crust string) (orders.ItemOptions, error) {
+	var opts orders.ItemOptions
+	var err error
+	if opts.Size, err = product.ResolveSize(size); err != nil {
+		return orders.ItemOptions{}, err
+	}
+	if opts.Crust, err = product.ResolveCrust(crust); err != nil {
+		return orders.ItemOptions{}, err
+	}
+	return opts, nil
+}
+
+func (uc *OrderUseCase) GetOrder(ctx context.Context, orderID string) (*orders.Order, error) {
 	if orderID == "" {
-		return fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
 	}
 
 	order, err := uc.repo.FindByID(ctx, orderID)
 	if err != nil {
-		return fmt.Errorf("failed to find order %s: %w", orderID, err)
+		return nil, fmt.Errorf("failed to find order %s: %w", orderID, err)
 	}
 
-	if err := order.MarkPaid(); err != nil {
-		return fmt.Errorf("could not process payment for order %s: %w", orderID, err)
+	return order, nil
+}
+
+// OrderPage - страница выборки заказов.
+type OrderPage struct {
+	Orders  []*orders.Order
+	HasMore bool
+}
+
+func (uc *OrderUseCase) ListOrders(ctx context.Context, filter orders.OrderFilter) (*OrderPage, error) {
+	if filter.Offset < 0 {
+		return nil, fmt.Errorf("%w: offset cannot be negative", ErrInvalidInput)
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedTo.Before(filter.CreatedFrom) {
+		return nil, fmt.Errorf("%w: date range end is before its start", ErrInvalidInput)
 	}
 
-	if err := uc.repo.Save(ctx, order); err != nil {
-		return fmt.Errorf("failed to update order status after payment: %w", err)
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = DefaultPageSize
+	case filter.Limit > MaxPageSize:
+		filter.Limit = MaxPageSize
 	}
 
-	return nil
+	// Запрашиваем на один заказ больше, чтобы понять, есть ли следующая страница
+	pageSize := filter.Limit
+	filter.Limit++
+
+	list, err := uc.repo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	page := &OrderPage{Orders: list}
+	if len(list) > pageSize {
+		page.Orders = list[:pageSize]
+		page.HasMore = true
+	}
+	return page, nil
+}
+
+// TransitionInput - смена статуса заказа. Без указанного актора операция
+// считается выполненной системой.
+type TransitionInput struct {
+	OrderID string
+	Actor   orders.Actor
+	Note    string
+}
+
+func (in TransitionInput) actor() orders.Actor {
+	if in.Actor == (orders.Actor{}) {
+		return orders.SystemActor
+	}
+	return in.Actor
+}
+
+func (uc *OrderUseCase) PayOrder(ctx context.Context, in TransitionInput) (*orders.Order, error) {
+	return uc.changeStatus(ctx, in, "pay", (*orders.Order).MarkPaid)
+}
+
+func (uc *OrderUseCase) SendToKitchen(ctx context.Context, in TransitionInput) (*orders.Order, error) {
+	return uc.changeStatus(ctx, in, "send to kitchen", func(o *orders.Order, actor orders.Actor, note string) error {
+		// Сага сама держит предзаказ до времени передачи и сверяет его со своим временем прохода
+		if actor != orders.SystemActor && o.IsPreOrder() && time.Now().Before(uc.schedule.ReleaseAt(o.ScheduledFor())) {
+			return fmt.Errorf("%w: release at %s", orders.ErrPreOrderHeld,
+				uc.schedule.ReleaseAt(o.ScheduledFor()).Format(time.RFC3339))
+		}
+		return o.SendToKitchen(actor, note)
+	})
+}
+
+func (uc *OrderUseCase) MarkReady(ctx context.Context, in TransitionInput) (*orders.Order, error) {
+	return uc.changeStatus(ctx, in, "mark ready", (*orders.Order).MarkReady)
+}
+
+func (uc *OrderUseCase) ShipToDelivery(ctx context.Context, in TransitionInput) (*orders.Order, error) {
+	return uc.changeStatus(ctx, in, "ship", (*orders.Order).ShipToDelivery)
+}
+
+func (uc *OrderUseCase) CompleteDelivery(ctx context.Context, in TransitionInput) (*orders.Order, error) {
+	return uc.changeStatus(ctx, in, "complete delivery of", (*orders.Order).CompleteDelivery)
+}
+
+// SetTip - меняет чаевые курьеру до запуска оплаты заказа.
+func (uc *OrderUseCase) SetTip(ctx context.Context, orderID string, tip common.Money) (*orders.Order, error) {
+	return uc.transition(ctx, orderID, "set tip of", func(o *orders.Order) error {
+		return o.SetTip(tip)
+	})
+}
+
+func (uc *OrderUseCase) changeStatus(
+	ctx context.Context,
+	in TransitionInput,
+	action string,
+	apply func(o *orders.Order, actor orders.Actor, note string) error,
+) (*orders.Order, error) {
+	return uc.transition(ctx, in.OrderID, action, func(o *orders.Order) error {
+		return apply(o, in.actor(), in.Note)
+	})
+}
+
+// GetOrderHistory - история статусов заказа, от создания к последнему изменению.
+func (uc *OrderUseCase) GetOrderHistory(ctx context.Context, orderID string) ([]orders.StatusChange, error) {
+	if _, err := uc.GetOrder(ctx, orderID); err != nil {
+		return nil, err
+	}
+
+	history, err := uc.repo.History(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load history of order %s: %w", orderID, err)
+	}
+	return history, nil
+}
+
+type CancelOrderInput struct {
+	OrderID string
+	Reason  orders.CancelReason
+	Comment string
+	Actor   orders.Actor
+}
+
+func (uc *OrderUseCase) CancelOrder(ctx context.Context, input CancelOrderInput) (*orders.Order, error) {
+	if input.Actor.ID == "" {
+		return nil, fmt.Errorf("%w: actor ID is required", ErrInvalidInput)
+	}
+
+	order, err := uc.transition(ctx, input.OrderID, "cancel", func(o *orders.Order) error {
+		return o.Cancel(input.Reason, input.Comment, input.Actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Промокод отмененного заказа снова становится доступен клиенту
+	if order.PromoCode() != "" {
+		if err := uc.promos.Release(ctx, order.ID()); err != nil {
+			return nil, fmt.Errorf("failed to release promo of order %s: %w", order.ID(), err)
+		}
+	}
+
+	return order, nil
+}
+
+// maxSaveAttempts - сколько раз операция перечитывает заказ, если его сохранили параллельно.
+const maxSaveAttempts = 3
+
+// transition - загрузка заказа, смена статуса и сохранение.
+func (uc *OrderUseCase) transition(ctx context.Context, orderID, action string, apply func(*orders.Order) error) (*orders.Order, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+	}
+
+	return retryOnConflict(func() (*orders.Order, error) {
+		order, err := uc.repo.FindByID(ctx, orderID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find order %s: %w", orderID, err)
+		}
+
+		if err := apply(order); err != nil {
+			return nil, fmt.Errorf("could not %s order %s: %w", action, orderID, err)
+		}
+
+		if err := uc.repo.Save(ctx, order); err != nil {
+			return nil, fmt.Errorf("failed to update order %s status: %w", orderID, err)
+		}
+		return order, nil
+	})
+}
+
+// retryOnConflict - повторяет чтение-изменение-запись заказа, пока запись не перестанет
+// конфликтовать с параллельной, но не больше maxSaveAttempts раз.
+func retryOnConflict(op func() (*orders.Order, error)) (*orders.Order, error) {
+	var err error
+	for range maxSaveAttempts {
+		var order *orders.Order
+		if order, err = op(); !errors.Is(err, orders.ErrConcurrentModification) {
+			return order, err
+		}
+	}
+	return nil, err
 }
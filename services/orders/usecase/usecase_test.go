@@ -2,10 +2,14 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/versoit/diploma/pkg/common"
 	"github.com/versoit/diploma/services/orders"
+	"github.com/versoit/diploma/services/orders/internal/repository"
 )
 
 type MockOrderRepo struct {
@@ -23,6 +27,14 @@ func (m *MockOrderRepo) Save(ctx context.Context, o *orders.Order) error {
 	return nil
 }
 
+func (m *MockOrderRepo) History(ctx context.Context, orderID string) ([]orders.StatusChange, error) {
+	o, ok := m.store[orderID]
+	if !ok {
+		return nil, orders.ErrOrderNotFound
+	}
+	return o.PendingStatusChanges(), nil
+}
+
 func (m *MockOrderRepo) FindByID(ctx context.Context, id string) (*orders.Order, error) {
 	if o, ok := m.store[id]; ok {
 		return o, nil
@@ -30,9 +42,100 @@ func (m *MockOrderRepo) FindByID(ctx context.Context, id string) (*orders.Order,
 	return nil, orders.ErrOrderNotFound
 }
 
+func (m *MockOrderRepo) List(ctx context.Context, filter orders.OrderFilter) ([]*orders.Order, error) {
+	list := make([]*orders.Order, 0)
+	for _, o := range m.store {
+		if filter.Match(o) {
+			list = append(list, o)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID() > list[j].ID() })
+
+	if filter.Offset >= len(list) {
+		return []*orders.Order{}, nil
+	}
+	list = list[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(list) {
+		list = list[:filter.Limit]
+	}
+	return list, nil
+}
+
+type MockPricer struct {
+	products map[string]orders.PricedProduct
+}
+
+func NewMockPricer(products ...orders.PricedProduct) *MockPricer {
+	m := &MockPricer{products: make(map[string]orders.PricedProduct)}
+	for _, p := range products {
+		m.products[p.ProductID] = p
+	}
+	return m
+}
+
+func (m *MockPricer) PriceProducts(ctx context.Context, ids []string) (map[string]orders.PricedProduct, error) {
+	result := make(map[string]orders.PricedProduct)
+	for _, id := range ids {
+		if p, ok := m.products[id]; ok {
+			result[id] = p
+		}
+	}
+	return result, nil
+}
+
+func defaultPricer() *MockPricer {
+	return NewMockPricer(
+		orders.PricedProduct{ProductID: "p1", Name: "Pizza", BasePrice: common.NewMoney(500), IsAvailable: true,
+			AllowedToppings: []orders.AllowedTopping{{Name: "Cheese", Price: common.NewMoney(50), MaxCount: 2}}},
+		orders.PricedProduct{ProductID: "p2", Name: "Calzone", BasePrice: common.NewMoney(700), IsAvailable: false},
+	)
+}
+
+func freeDelivery() *orders.DeliveryTariff {
+	return &orders.DeliveryTariff{
+		Zones: []orders.DeliveryZone{{ID: "any", Name: "Any", BasePrice: common.ZeroMoney()}},
+	}
+}
+
+// alwaysOpen - круглосуточная работа, предзаказы без ограничений по слотам.
+func alwaysOpen() *orders.StoreSchedule {
+	hours := make(map[time.Weekday]orders.WorkingHours, 7)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		hours[d] = orders.WorkingHours{}
+	}
+	return &orders.StoreSchedule{Hours: hours, SlotLength: 30 * time.Minute, KitchenLeadTime: 30 * time.Minute}
+}
+
+// singleStore - одна точка на все зоны, загрузка считается по заказам в памяти.
+func singleStore() orders.StoreAssigner {
+	network := &orders.StoreNetwork{Stores: []orders.Store{{ID: "main", Name: "Main"}}}
+	return orders.NewStoreRouter(network, repository.NewOrderStoreLoad(repository.NewInMemoryOrderRepository()))
+}
+
+// noFees - без упаковки и сервисного сбора, принимаются карта и наличные.
+func noFees() *orders.FeePolicy {
+	return &orders.FeePolicy{
+		Packaging: common.ZeroMoney(),
+		Service: map[orders.PaymentMethod]orders.ServiceFee{
+			orders.PaymentCard: {Fixed: common.ZeroMoney()},
+			orders.PaymentCash: {Fixed: common.ZeroMoney()},
+		},
+	}
+}
+
+// noLimits - без ограничений на количество позиций и клиентов.
+func noLimits() *orders.OrderPolicy {
+	return &orders.OrderPolicy{}
+}
+
+// newTestUseCase - сценарий с каталогом по умолчанию и бесплатной доставкой.
+func newTestUseCase(repo *MockOrderRepo) *OrderUseCase {
+	return NewOrderUseCase(repo, defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+}
+
 func TestOrderUseCase_CreateOrder(t *testing.T) {
 	repo := NewMockRepo()
-	uc := NewOrderUseCase(repo)
+	uc := newTestUseCase(repo)
 
 	input := CreateOrderInput{
 		CustomerID: "cust1",
@@ -43,10 +146,7 @@ func TestOrderUseCase_CreateOrder(t *testing.T) {
 		Items: []OrderItemInput{
 			{
 				ProductID: "p1",
-				Name:      "Pizza",
 				Quantity:  1,
-				BasePrice: common.NewMoney(500),
-				SizeMult:  1.0,
 			},
 		},
 	}
@@ -64,7 +164,7 @@ func TestOrderUseCase_CreateOrder(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to find saved order: %v", err)
 	}
-	
+
 	if !savedOrder.FinalPrice().Equal(common.NewMoney(500)) {
 		t.Errorf("expected price 500, got %v", savedOrder.FinalPrice())
 	}
@@ -72,14 +172,14 @@ func TestOrderUseCase_CreateOrder(t *testing.T) {
 
 func TestOrderUseCase_PayOrder(t *testing.T) {
 	repo := NewMockRepo()
-	uc := NewOrderUseCase(repo)
+	uc := newTestUseCase(repo)
 
 	order := orders.NewOrder("cust1", orders.DeliveryAddress{})
 	if err := repo.Save(context.Background(), order); err != nil {
 		t.Fatalf("failed to save: %v", err)
 	}
 
-	err := uc.PayOrder(context.Background(), order.ID())
+	_, err := uc.PayOrder(context.Background(), TransitionInput{OrderID: order.ID()})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -88,4 +188,454 @@ func TestOrderUseCase_PayOrder(t *testing.T) {
 	if updatedOrder.Status() != orders.StatusPaid {
 		t.Errorf("expected status paid, got %v", updatedOrder.Status())
 	}
-}
\ No newline at end of file
+}
+func TestOrderUseCase_FullLifecycle(t *testing.T) {
+	repo := NewMockRepo()
+	uc := newTestUseCase(repo)
+	ctx := context.Background()
+
+	order := orders.NewOrder("cust1", orders.DeliveryAddress{})
+	_ = repo.Save(ctx, order)
+
+	steps := []func(context.Context, TransitionInput) (*orders.Order, error){
+		uc.PayOrder, uc.SendToKitchen, uc.MarkReady, uc.ShipToDelivery, uc.CompleteDelivery,
+	}
+	for _, step := range steps {
+		if _, err := step(ctx, TransitionInput{OrderID: order.ID()}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	got, err := uc.GetOrder(ctx, order.ID())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Status() != orders.StatusCompleted {
+		t.Errorf("expected status completed, got %v", got.Status())
+	}
+}
+
+func TestOrderUseCase_Transition_InvalidOrder(t *testing.T) {
+	repo := NewMockRepo()
+	uc := newTestUseCase(repo)
+	ctx := context.Background()
+
+	order := orders.NewOrder("cust1", orders.DeliveryAddress{})
+	_ = repo.Save(ctx, order)
+
+	if _, err := uc.MarkReady(ctx, TransitionInput{OrderID: order.ID()}); !errors.Is(err, orders.ErrInvalidTransition) {
+		t.Errorf("expected ErrInvalidTransition, got %v", err)
+	}
+	if _, err := uc.SendToKitchen(ctx, TransitionInput{OrderID: "missing"}); !errors.Is(err, orders.ErrOrderNotFound) {
+		t.Errorf("expected ErrOrderNotFound, got %v", err)
+	}
+}
+
+func TestOrderUseCase_ListOrders_Pagination(t *testing.T) {
+	repo := NewMockRepo()
+	uc := newTestUseCase(repo)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		_ = repo.Save(ctx, orders.NewOrder("cust1", orders.DeliveryAddress{}))
+	}
+	_ = repo.Save(ctx, orders.NewOrder("cust2", orders.DeliveryAddress{}))
+
+	page, err := uc.ListOrders(ctx, orders.OrderFilter{CustomerID: "cust1", Limit: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Orders) != 3 || !page.HasMore {
+		t.Fatalf("expected 3 orders and more pages, got %d (hasMore=%v)", len(page.Orders), page.HasMore)
+	}
+
+	page, err = uc.ListOrders(ctx, orders.OrderFilter{CustomerID: "cust1", Limit: 3, Offset: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Orders) != 2 || page.HasMore {
+		t.Errorf("expected last page of 2 orders, got %d (hasMore=%v)", len(page.Orders), page.HasMore)
+	}
+
+	paid := orders.StatusPaid
+	page, _ = uc.ListOrders(ctx, orders.OrderFilter{Status: &paid})
+	if len(page.Orders) != 0 {
+		t.Errorf("expected no paid orders, got %d", len(page.Orders))
+	}
+}
+
+func TestOrderUseCase_CancelOrder(t *testing.T) {
+	repo := NewMockRepo()
+	uc := newTestUseCase(repo)
+	ctx := context.Background()
+
+	order := orders.NewOrder("cust1", orders.DeliveryAddress{})
+	_ = repo.Save(ctx, order)
+
+	canceled, err := uc.CancelOrder(ctx, CancelOrderInput{
+		OrderID: order.ID(),
+		Reason:  orders.CancelReasonCustomerRequest,
+		Actor:   orders.Actor{ID: "cust1", Role: orders.RoleCustomer},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if canceled.Status() != orders.StatusCanceled || canceled.Cancellation().RequiresRefund() {
+		t.Errorf("unexpected cancellation state: %v %+v", canceled.Status(), canceled.Cancellation())
+	}
+
+	_, err = uc.CancelOrder(ctx, CancelOrderInput{OrderID: order.ID(), Reason: orders.CancelReasonOther})
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected ErrInvalidInput without actor, got %v", err)
+	}
+}
+
+func TestOrderUseCase_CreateOrder_PricesFromCatalog(t *testing.T) {
+	repo := NewMockRepo()
+	uc := newTestUseCase(repo)
+	ctx := context.Background()
+	addr := orders.DeliveryAddress{City: "Moscow", Street: "Arbat"}
+
+	order, err := uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    addr,
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 2, Toppings: []string{"Cheese"}}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !order.FinalPrice().Equal(common.NewMoney(1100)) { // (500 + 50) * 2
+		t.Errorf("expected 1100, got %v", order.FinalPrice())
+	}
+	if order.Items()[0].ProductName() != "Pizza" {
+		t.Errorf("expected catalog name, got %s", order.Items()[0].ProductName())
+	}
+
+	tests := []struct {
+		name    string
+		item    OrderItemInput
+		wantErr error
+	}{
+		{"unavailable product", OrderItemInput{ProductID: "p2", Quantity: 1}, orders.ErrProductUnavailable},
+		{"unknown product", OrderItemInput{ProductID: "p404", Quantity: 1}, orders.ErrUnknownProduct},
+		{"topping not allowed", OrderItemInput{ProductID: "p1", Quantity: 1, Toppings: []string{"Gold"}}, orders.ErrToppingNotAllowed},
+		{"too many toppings", OrderItemInput{ProductID: "p1", Quantity: 1, Toppings: []string{"Cheese", "Cheese", "Cheese"}}, orders.ErrTooManyToppings},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.CreateOrder(ctx, CreateOrderInput{CustomerID: "cust1", Address: addr, Items: []OrderItemInput{tt.item}})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOrderUseCase_QuoteOrder_DeliveryByZone(t *testing.T) {
+	repo := NewMockRepo()
+	tariff := &orders.DeliveryTariff{
+		Zones: []orders.DeliveryZone{
+			{ID: "center", Name: "Center", City: "Moscow", Districts: []string{"Arbat"},
+				BasePrice: common.NewMoney(100), FreeDeliveryFrom: common.NewMoney(1000)},
+			{ID: "moscow", Name: "Moscow", City: "Moscow",
+				BasePrice: common.NewMoney(250), MinOrderValue: common.NewMoney(900)},
+		},
+	}
+	uc := NewOrderUseCase(repo, defaultPricer(), repository.NewInMemoryPromoRepository(), tariff, alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	ctx := context.Background()
+
+	input := func(district string, qty int) CreateOrderInput {
+		return CreateOrderInput{
+			CustomerID: "cust1",
+			Address:    orders.DeliveryAddress{City: "Moscow", District: district, Street: "Main"},
+			Items:      []OrderItemInput{{ProductID: "p1", Quantity: qty}},
+		}
+	}
+
+	quote, err := uc.QuoteOrder(ctx, input("Arbat", 1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quote.Delivery.ZoneID != "center" || !quote.Order.FinalPrice().Equal(common.NewMoney(600)) {
+		t.Errorf("expected center zone and 600, got %s and %v", quote.Delivery.ZoneID, quote.Order.FinalPrice())
+	}
+	if len(repo.store) != 0 {
+		t.Error("quote must not persist the order")
+	}
+
+	quote, err = uc.QuoteOrder(ctx, input("Arbat", 2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !quote.Delivery.Price.IsZero() {
+		t.Errorf("expected free delivery above threshold, got %v", quote.Delivery.Price)
+	}
+
+	if _, err := uc.CreateOrder(ctx, input("Butovo", 1)); !errors.Is(err, orders.ErrBelowZoneMinimum) {
+		t.Errorf("expected ErrBelowZoneMinimum, got %v", err)
+	}
+
+	order, err := uc.CreateOrder(ctx, input("Butovo", 2))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !order.DeliveryPrice().Equal(common.NewMoney(250)) {
+		t.Errorf("expected delivery 250, got %v", order.DeliveryPrice())
+	}
+
+	_, err = uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Kazan", Street: "Baumana"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if !errors.Is(err, orders.ErrAddressNotServed) {
+		t.Errorf("expected ErrAddressNotServed, got %v", err)
+	}
+}
+
+func TestOrderUseCase_GetOrderHistory(t *testing.T) {
+	uc := NewOrderUseCase(repository.NewInMemoryOrderRepository(), defaultPricer(),
+		repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	ctx := context.Background()
+
+	order, err := uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Arbat"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	cook := orders.Actor{ID: "cook1", Role: orders.RoleOperator}
+	if _, err := uc.PayOrder(ctx, TransitionInput{OrderID: order.ID(), Note: "card"}); err != nil {
+		t.Fatalf("failed to pay: %v", err)
+	}
+	if _, err := uc.SendToKitchen(ctx, TransitionInput{OrderID: order.ID(), Actor: cook}); err != nil {
+		t.Fatalf("failed to send to kitchen: %v", err)
+	}
+
+	history, err := uc.GetOrderHistory(ctx, order.ID())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(history) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(history))
+	}
+	if history[1].Status != orders.StatusPaid || history[1].Actor != orders.SystemActor || history[1].Note != "card" {
+		t.Errorf("unexpected payment entry: %+v", history[1])
+	}
+	if history[2].Status != orders.StatusCooking || history[2].Actor != cook {
+		t.Errorf("unexpected kitchen entry: %+v", history[2])
+	}
+
+	if _, err := uc.GetOrderHistory(ctx, "missing"); !errors.Is(err, orders.ErrOrderNotFound) {
+		t.Errorf("expected ErrOrderNotFound, got %v", err)
+	}
+}
+
+func TestOrderUseCase_CreateOrder_SizesAndHalves(t *testing.T) {
+	sizes := []orders.SizeOption{
+		{Code: "25", Name: "25 см", Multiplier: 1},
+		{Code: "35", Name: "35 см", Multiplier: 1.5},
+	}
+	pricer := NewMockPricer(
+		orders.PricedProduct{ProductID: "p1", Name: "Pepperoni", BasePrice: common.NewMoney(600), IsAvailable: true,
+			HalvesAllowed: true, Sizes: sizes},
+		orders.PricedProduct{ProductID: "p2", Name: "Margherita", BasePrice: common.NewMoney(400), IsAvailable: true,
+			HalvesAllowed: true, Sizes: sizes},
+		orders.PricedProduct{ProductID: "p3", Name: "Cola", BasePrice: common.NewMoney(100), IsAvailable: true},
+	)
+	ctx := context.Background()
+	input := CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", HalfProductID: "p2", Size: "35", Quantity: 1}},
+	}
+
+	for _, tc := range []struct {
+		pricing  orders.HalfPricing
+		expected float64
+	}{
+		{orders.HalfPricingMax, 900},     // 600 * 1.5
+		{orders.HalfPricingAverage, 750}, // (600 + 400) / 2 * 1.5
+	} {
+		uc := NewOrderUseCase(NewMockRepo(), pricer, repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), tc.pricing, singleStore(), noFees(), noLimits())
+		order, err := uc.CreateOrder(ctx, input)
+		if err != nil {
+			t.Fatalf("failed to create order: %v", err)
+		}
+
+		item := order.Items()[0]
+		if !item.CalculateTotal().Equal(common.NewMoney(tc.expected)) {
+			t.Errorf("%s: expected %v, got %v", tc.pricing, tc.expected, item.CalculateTotal())
+		}
+		if item.ProductName() != "Pepperoni / Margherita" || len(item.Halves()) != 2 || item.Size().Code != "35" {
+			t.Errorf("%s: unexpected item %s %+v %+v", tc.pricing, item.ProductName(), item.Halves(), item.Size())
+		}
+	}
+
+	uc := NewOrderUseCase(NewMockRepo(), pricer, repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	input.Items = []OrderItemInput{{ProductID: "p1", HalfProductID: "p3", Quantity: 1}}
+	if _, err := uc.CreateOrder(ctx, input); !errors.Is(err, orders.ErrHalvesNotAllowed) {
+		t.Errorf("expected ErrHalvesNotAllowed, got %v", err)
+	}
+	input.Items = []OrderItemInput{{ProductID: "p1", Size: "40", Quantity: 1}}
+	if _, err := uc.CreateOrder(ctx, input); !errors.Is(err, orders.ErrUnknownSize) {
+		t.Errorf("expected ErrUnknownSize, got %v", err)
+	}
+}
+
+// racingRepo - перед сохранением заказ успевает изменить другой запрос, conflicts раз подряд.
+type racingRepo struct {
+	orders.OrderRepository
+	conflicts int
+	saves     int
+}
+
+func (r *racingRepo) Save(ctx context.Context, o *orders.Order) error {
+	r.saves++
+	if r.conflicts > 0 && o.Version() > 0 {
+		r.conflicts--
+		concurrent, err := r.OrderRepository.FindByID(ctx, o.ID())
+		if err != nil {
+			return err
+		}
+		if err := r.OrderRepository.Save(ctx, concurrent); err != nil {
+			return err
+		}
+	}
+	return r.OrderRepository.Save(ctx, o)
+}
+
+func TestOrderUseCase_RetriesConcurrentModification(t *testing.T) {
+	ctx := context.Background()
+	repo := &racingRepo{OrderRepository: repository.NewInMemoryOrderRepository()}
+	uc := NewOrderUseCase(repo, defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+
+	order, err := uc.CreateOrder(ctx, CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create order: %v", err)
+	}
+
+	repo.conflicts, repo.saves = 2, 0
+	paid, err := uc.PayOrder(ctx, TransitionInput{OrderID: order.ID()})
+	if err != nil {
+		t.Fatalf("expected pay to succeed after retries: %v", err)
+	}
+	if paid.Status() != orders.StatusPaid || repo.saves != maxSaveAttempts {
+		t.Errorf("expected paid order after %d attempts, got %s after %d", maxSaveAttempts, paid.Status(), repo.saves)
+	}
+
+	repo.conflicts = maxSaveAttempts
+	_, err = uc.SendToKitchen(ctx, TransitionInput{OrderID: order.ID()})
+	if !errors.Is(err, orders.ErrConcurrentModification) {
+		t.Fatalf("expected ErrConcurrentModification, got %v", err)
+	}
+	loaded, _ := uc.GetOrder(ctx, order.ID())
+	if loaded.Status() != orders.StatusPaid {
+		t.Errorf("failed save must not change the order, got %s", loaded.Status())
+	}
+}
+
+func TestOrderUseCase_FeesAndTip(t *testing.T) {
+	repo := NewMockRepo()
+	fees := &orders.FeePolicy{
+		Packaging: common.NewMoney(30),
+		Service: map[orders.PaymentMethod]orders.ServiceFee{
+			orders.PaymentCard: {Fixed: common.ZeroMoney()},
+			orders.PaymentCash: {Percent: 2, Fixed: common.ZeroMoney()},
+		},
+	}
+	uc := NewOrderUseCase(repo, defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), fees, noLimits())
+	ctx := context.Background()
+
+	input := CreateOrderInput{
+		CustomerID:    "cust1",
+		Address:       orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:         []OrderItemInput{{ProductID: "p1", Quantity: 1}},
+		PaymentMethod: orders.PaymentCash,
+		Tip:           common.NewMoney(50),
+	}
+	order, err := uc.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 500 + упаковка 30 + 2% сбора 10 + чаевые 50
+	if !order.FinalPrice().Equal(common.NewMoney(590)) {
+		t.Fatalf("expected 590, got %s", order.FinalPrice())
+	}
+
+	// Сбор пересчитывается вместе с корзиной
+	line := order.Items()[0].ID()
+	order, err = uc.UpdateItem(ctx, UpdateItemInput{OrderID: order.ID(), LineID: line, Quantity: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !order.ServiceFee().Equal(common.NewMoney(20)) {
+		t.Errorf("expected service fee 20, got %s", order.ServiceFee())
+	}
+
+	order, err = uc.SetTip(ctx, order.ID(), common.ZeroMoney())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !order.FinalPrice().Equal(common.NewMoney(1050)) {
+		t.Errorf("expected 1050 without tip, got %s", order.FinalPrice())
+	}
+
+	input.PaymentMethod = orders.PaymentCardOnDelivery
+	if _, err := uc.CreateOrder(ctx, input); !errors.Is(err, ErrInvalidInput) || !errors.Is(err, orders.ErrUnknownPaymentMethod) {
+		t.Errorf("expected unknown payment method, got %v", err)
+	}
+	input.PaymentMethod, input.Tip = "", common.NewMoney(-5)
+	if _, err := uc.CreateOrder(ctx, input); !errors.Is(err, orders.ErrInvalidTip) {
+		t.Errorf("expected ErrInvalidTip, got %v", err)
+	}
+}
+
+func TestOrderUseCase_OrderPolicy(t *testing.T) {
+	policy := &orders.OrderPolicy{MaxItems: 3, BlockedCustomers: map[string]bool{"fraudster": true}}
+	uc := NewOrderUseCase(NewMockRepo(), defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), alwaysOpen(), repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), policy)
+	ctx := context.Background()
+
+	input := CreateOrderInput{
+		CustomerID: "cust1",
+		Address:    orders.DeliveryAddress{City: "Moscow", Street: "Red Square"},
+		Items:      []OrderItemInput{{ProductID: "p1", Quantity: 2}, {ProductID: "p1", Quantity: 2}},
+	}
+	if _, err := uc.CreateOrder(ctx, input); !errors.Is(err, orders.ErrTooManyItems) {
+		t.Errorf("expected ErrTooManyItems, got %v", err)
+	}
+
+	input.Items = input.Items[:1]
+	order, err := uc.CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := uc.AddItem(ctx, order.ID(), OrderItemInput{ProductID: "p1", Quantity: 2}); !errors.Is(err, orders.ErrTooManyItems) {
+		t.Errorf("expected ErrTooManyItems on cart edit, got %v", err)
+	}
+
+	blocked := input
+	blocked.CustomerID = "fraudster"
+	if _, err := uc.CreateOrder(ctx, blocked); !errors.Is(err, orders.ErrCustomerBlocked) {
+		t.Errorf("expected ErrCustomerBlocked, got %v", err)
+	}
+
+	// Клиента заблокировали, когда корзина уже создана
+	policy.BlockedCustomers["cust1"] = true
+	if _, err := uc.AddItem(ctx, order.ID(), OrderItemInput{ProductID: "p1", Quantity: 1}); !errors.Is(err, orders.ErrCustomerBlocked) {
+		t.Errorf("expected ErrCustomerBlocked on cart edit, got %v", err)
+	}
+
+	closed := &orders.StoreSchedule{Hours: map[time.Weekday]orders.WorkingHours{}}
+	uc = NewOrderUseCase(NewMockRepo(), defaultPricer(), repository.NewInMemoryPromoRepository(), freeDelivery(), closed, repository.NewInMemoryAddressBook(), orders.HalfPricingMax, singleStore(), noFees(), noLimits())
+	if _, err := uc.CreateOrder(ctx, input); !errors.Is(err, orders.ErrStoreClosed) {
+		t.Errorf("expected ErrStoreClosed, got %v", err)
+	}
+}
@@ -81,6 +81,102 @@ func (x *PaymentRequest) GetMethod() int32 {
 	return 0
 }
 
+type ConfirmPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPaymentRequest) Reset() {
+	*x = ConfirmPaymentRequest{}
+	mi := &file_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPaymentRequest) ProtoMessage() {}
+
+func (x *ConfirmPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfirmPaymentRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ConfirmPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type PaymentLookupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentLookupRequest) Reset() {
+	*x = PaymentLookupRequest{}
+	mi := &file_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentLookupRequest) ProtoMessage() {}
+
+func (x *PaymentLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentLookupRequest.ProtoReflect.Descriptor instead.
+func (*PaymentLookupRequest) Descriptor() ([]byte, []int) {
+	return file_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PaymentLookupRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
 type PaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
@@ -91,7 +187,7 @@ type PaymentResponse struct {
 
 func (x *PaymentResponse) Reset() {
 	*x = PaymentResponse{}
-	mi := &file_payment_proto_msgTypes[1]
+	mi := &file_payment_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -103,7 +199,7 @@ func (x *PaymentResponse) String() string {
 func (*PaymentResponse) ProtoMessage() {}
 
 func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_proto_msgTypes[1]
+	mi := &file_payment_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,7 +212,7 @@ func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentResponse.ProtoReflect.Descriptor instead.
 func (*PaymentResponse) Descriptor() ([]byte, []int) {
-	return file_payment_proto_rawDescGZIP(), []int{1}
+	return file_payment_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PaymentResponse) GetPaymentId() string {
@@ -141,13 +237,23 @@ const file_payment_proto_rawDesc = "" +
 	"\x0ePaymentRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x16\n" +
-	"\x06method\x18\x03 \x01(\x05R\x06method\"H\n" +
+	"\x06method\x18\x03 \x01(\x05R\x06method\"Y\n" +
+	"\x15ConfirmPaymentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\"1\n" +
+	"\x14PaymentLookupRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"H\n" +
 	"\x0fPaymentResponse\x12\x1d\n" +
 	"\n" +
 	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status2]\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status2\xa5\x03\n" +
 	"\x0ePaymentService\x12K\n" +
-	"\x0eProcessPayment\x12\x1b.treasury.v1.PaymentRequest\x1a\x1c.treasury.v1.PaymentResponseB\x12Z\x10./pb;treasury_pbb\x06proto3"
+	"\x0eProcessPayment\x12\x1b.treasury.v1.PaymentRequest\x1a\x1c.treasury.v1.PaymentResponse\x12R\n" +
+	"\x0eConfirmPayment\x12\".treasury.v1.ConfirmPaymentRequest\x1a\x1c.treasury.v1.PaymentResponse\x12Q\n" +
+	"\x0eDeclinePayment\x12!.treasury.v1.PaymentLookupRequest\x1a\x1c.treasury.v1.PaymentResponse\x12M\n" +
+	"\n" +
+	"GetPayment\x12!.treasury.v1.PaymentLookupRequest\x1a\x1c.treasury.v1.PaymentResponse\x12P\n" +
+	"\rRefundPayment\x12!.treasury.v1.PaymentLookupRequest\x1a\x1c.treasury.v1.PaymentResponseB\x12Z\x10./pb;treasury_pbb\x06proto3"
 
 var (
 	file_payment_proto_rawDescOnce sync.Once
@@ -161,16 +267,26 @@ func file_payment_proto_rawDescGZIP() []byte {
 	return file_payment_proto_rawDescData
 }
 
-var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_payment_proto_goTypes = []any{
-	(*PaymentRequest)(nil),  // 0: treasury.v1.PaymentRequest
-	(*PaymentResponse)(nil), // 1: treasury.v1.PaymentResponse
+	(*PaymentRequest)(nil),        // 0: treasury.v1.PaymentRequest
+	(*ConfirmPaymentRequest)(nil), // 1: treasury.v1.ConfirmPaymentRequest
+	(*PaymentLookupRequest)(nil),  // 2: treasury.v1.PaymentLookupRequest
+	(*PaymentResponse)(nil),       // 3: treasury.v1.PaymentResponse
 }
 var file_payment_proto_depIdxs = []int32{
 	0, // 0: treasury.v1.PaymentService.ProcessPayment:input_type -> treasury.v1.PaymentRequest
-	1, // 1: treasury.v1.PaymentService.ProcessPayment:output_type -> treasury.v1.PaymentResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	1, // 1: treasury.v1.PaymentService.ConfirmPayment:input_type -> treasury.v1.ConfirmPaymentRequest
+	2, // 2: treasury.v1.PaymentService.DeclinePayment:input_type -> treasury.v1.PaymentLookupRequest
+	2, // 3: treasury.v1.PaymentService.GetPayment:input_type -> treasury.v1.PaymentLookupRequest
+	2, // 4: treasury.v1.PaymentService.RefundPayment:input_type -> treasury.v1.PaymentLookupRequest
+	3, // 5: treasury.v1.PaymentService.ProcessPayment:output_type -> treasury.v1.PaymentResponse
+	3, // 6: treasury.v1.PaymentService.ConfirmPayment:output_type -> treasury.v1.PaymentResponse
+	3, // 7: treasury.v1.PaymentService.DeclinePayment:output_type -> treasury.v1.PaymentResponse
+	3, // 8: treasury.v1.PaymentService.GetPayment:output_type -> treasury.v1.PaymentResponse
+	3, // 9: treasury.v1.PaymentService.RefundPayment:output_type -> treasury.v1.PaymentResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -187,7 +303,7 @@ func file_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_proto_rawDesc), len(file_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
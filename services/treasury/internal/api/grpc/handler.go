@@ -29,8 +29,42 @@ func (h *TreasuryHandler) ProcessPayment(ctx context.Context, req *treasury_pb.P
 		return nil, err
 	}
 
+	return toPaymentResponse(payment), nil
+}
+
+func (h *TreasuryHandler) ConfirmPayment(ctx context.Context, req *treasury_pb.ConfirmPaymentRequest) (*treasury_pb.PaymentResponse, error) {
+	if err := h.uc.ConfirmPayment(ctx, req.OrderId, req.TransactionId); err != nil {
+		return nil, err
+	}
+	return h.GetPayment(ctx, &treasury_pb.PaymentLookupRequest{OrderId: req.OrderId})
+}
+
+func (h *TreasuryHandler) DeclinePayment(ctx context.Context, req *treasury_pb.PaymentLookupRequest) (*treasury_pb.PaymentResponse, error) {
+	if err := h.uc.DeclinePayment(ctx, req.OrderId); err != nil {
+		return nil, err
+	}
+	return h.GetPayment(ctx, req)
+}
+
+func (h *TreasuryHandler) GetPayment(ctx context.Context, req *treasury_pb.PaymentLookupRequest) (*treasury_pb.PaymentResponse, error) {
+	payment, err := h.uc.GetPayment(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentResponse(payment), nil
+}
+
+func (h *TreasuryHandler) RefundPayment(ctx context.Context, req *treasury_pb.PaymentLookupRequest) (*treasury_pb.PaymentResponse, error) {
+	payment, err := h.uc.RefundPayment(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	return toPaymentResponse(payment), nil
+}
+
+func toPaymentResponse(p *treasury.Payment) *treasury_pb.PaymentResponse {
 	return &treasury_pb.PaymentResponse{
-		PaymentId: payment.ID(),
-		Status:    payment.Status().String(),
-	}, nil
+		PaymentId: p.ID(),
+		Status:    p.Status().String(),
+	}
 }
\ No newline at end of file
@@ -29,6 +29,14 @@ func (uc *TreasuryUseCase) InitiatePayment(ctx context.Context, orderID string,
 		return nil, fmt.Errorf("%w: payment amount must be positive", ErrInvalidInput)
 	}
 
+	// Повторный запрос по заказу (ретрай оркестратора) возвращает уже начатый платеж
+	if existing, err := uc.repo.FindByOrderID(ctx, orderID); err == nil {
+		switch existing.Status() {
+		case treasury.PayStatusWaiting, treasury.PayStatusSuccess:
+			return existing, nil
+		}
+	}
+
 	payment := treasury.NewPayment(orderID, amount, method)
 
 	if err := uc.repo.Save(ctx, payment); err != nil {
@@ -57,4 +65,62 @@ func (uc *TreasuryUseCase) ConfirmPayment(ctx context.Context, orderID string, t
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func (uc *TreasuryUseCase) DeclinePayment(ctx context.Context, orderID string) error {
+	if orderID == "" {
+		return fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+	}
+
+	payment, err := uc.repo.FindByOrderID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("payment record for order %s not found: %w", orderID, err)
+	}
+
+	if err := payment.Decline(); err != nil {
+		return fmt.Errorf("domain logic error while declining payment: %w", err)
+	}
+
+	if err := uc.repo.Save(ctx, payment); err != nil {
+		return fmt.Errorf("failed to persist payment decline: %w", err)
+	}
+
+	return nil
+}
+
+// RefundPayment - возврат оплаты заказа. Повторный возврат не считается ошибкой.
+func (uc *TreasuryUseCase) RefundPayment(ctx context.Context, orderID string) (*treasury.Payment, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+	}
+
+	payment, err := uc.repo.FindByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("payment record for order %s not found: %w", orderID, err)
+	}
+	if payment.Status() == treasury.PayStatusRefund {
+		return payment, nil
+	}
+
+	if err := payment.Refund(); err != nil {
+		return nil, fmt.Errorf("domain logic error while refunding payment: %w", err)
+	}
+
+	if err := uc.repo.Save(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to persist payment refund: %w", err)
+	}
+
+	return payment, nil
+}
+
+func (uc *TreasuryUseCase) GetPayment(ctx context.Context, orderID string) (*treasury.Payment, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: order ID is required", ErrInvalidInput)
+	}
+
+	payment, err := uc.repo.FindByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("payment record for order %s not found: %w", orderID, err)
+	}
+	return payment, nil
+}
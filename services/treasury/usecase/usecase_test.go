@@ -45,4 +45,35 @@ func TestTreasuryUseCase_PaymentFlow(t *testing.T) {
 	if saved.Status() != treasury.PayStatusSuccess {
 		t.Error("should be success")
 	}
-}
\ No newline at end of file
+}
+func TestTreasuryUseCase_Refund(t *testing.T) {
+	repo := &MockTreasuryRepo{store: make(map[string]*treasury.Payment)}
+	uc := NewTreasuryUseCase(repo)
+	ctx := context.Background()
+
+	first, err := uc.InitiatePayment(ctx, "ord-1", common.NewMoney(1000), treasury.MethodCard)
+	if err != nil {
+		t.Fatalf("init failed: %v", err)
+	}
+	retry, err := uc.InitiatePayment(ctx, "ord-1", common.NewMoney(1000), treasury.MethodCard)
+	if err != nil || retry.ID() != first.ID() {
+		t.Fatalf("repeated init should return pending payment: %v", err)
+	}
+
+	if _, err := uc.RefundPayment(ctx, "ord-1"); err == nil {
+		t.Error("unconfirmed payment must not be refunded")
+	}
+
+	if err := uc.ConfirmPayment(ctx, "ord-1", "trans-xyz"); err != nil {
+		t.Fatalf("confirm failed: %v", err)
+	}
+	for range 2 {
+		p, err := uc.RefundPayment(ctx, "ord-1")
+		if err != nil {
+			t.Fatalf("refund failed: %v", err)
+		}
+		if p.Status() != treasury.PayStatusRefund {
+			t.Errorf("expected refund status, got %s", p.Status())
+		}
+	}
+}